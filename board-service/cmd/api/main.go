@@ -6,6 +6,7 @@ import (
 	"board-service/internal/database"
 	"board-service/internal/middleware"
 	"board-service/pkg/logger"
+	"context"
 	"os"
 
 	"github.com/gin-gonic/gin"
@@ -73,6 +74,15 @@ func main() {
 		log.Fatal("Failed to initialize application", zap.Error(err))
 	}
 
+	// Start the real-time event broker (Redis pub/sub -> SSE clients)
+	go app.EventBroker.Run(context.Background())
+
+	// Start the outbox relay (domain_events -> Redis stream)
+	go app.OutboxRelay.Run(context.Background())
+
+	// Start the webhook deliverer (webhook_deliveries -> subscriber URLs)
+	go app.WebhookDeliverer.Run(context.Background())
+
 	// 6. Configure Gin mode
 	if cfg.Server.Env == "prod" {
 		gin.SetMode(gin.ReleaseMode)
@@ -9,8 +9,13 @@ import (
 	"board-service/internal/config"
 	"board-service/internal/handler"
 	"board-service/internal/middleware"
+	"board-service/internal/outbox"
+	"board-service/internal/realtime"
 	"board-service/internal/repository"
 	"board-service/internal/service"
+	"board-service/internal/storage"
+	"board-service/internal/uow"
+	"board-service/internal/webhook"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
@@ -32,6 +37,13 @@ var repositorySet = wire.NewSet(
 	repository.NewFieldOptionRepository,
 	repository.NewBoardOrderRepository,
 	repository.NewViewRepository,
+	repository.NewBoardActivityRepository,
+	repository.NewMentionRepository,
+	repository.NewNotificationRepository,
+	repository.NewWebhookRepository,
+	repository.NewBoardLinkRepository,
+	repository.NewChecklistRepository,
+	repository.NewAttachmentRepository,
 )
 
 // cacheSet은 모든 cache providers를 포함합니다
@@ -54,6 +66,41 @@ var serviceSet = wire.NewSet(
 	service.NewFieldService,
 	service.NewFieldValueService,
 	service.NewViewService,
+	service.NewMentionService,
+	service.NewNotificationService,
+	service.NewEventStreamService,
+	service.NewWebhookService,
+	service.NewBoardLinkService,
+	service.NewChecklistService,
+	service.NewAttachmentLimits,
+	service.NewAttachmentService,
+)
+
+// realtimeSet은 프로젝트 실시간 이벤트 broker를 포함합니다
+var realtimeSet = wire.NewSet(
+	realtime.NewBroker,
+	wire.Bind(new(realtime.Publisher), new(*realtime.Broker)),
+	wire.Bind(new(realtime.Subscriber), new(*realtime.Broker)),
+)
+
+// outboxSet은 transactional outbox relay와 publisher를 포함합니다
+var outboxSet = wire.NewSet(
+	uow.NewUnitOfWork,
+	outbox.NewRedisStreamPublisher,
+	provideOutboxPublisher,
+	outbox.NewRelay,
+)
+
+// webhookSet은 webhook 전송 worker를 포함합니다
+var webhookSet = wire.NewSet(
+	provideWebhookURLPolicy,
+	webhook.NewOutboxPublisher,
+	webhook.NewDeliverer,
+)
+
+// storageSet은 첨부파일 storage provider를 포함합니다
+var storageSet = wire.NewSet(
+	provideStorage,
 )
 
 // handlerSet은 모든 handler providers를 포함합니다
@@ -64,6 +111,13 @@ var handlerSet = wire.NewSet(
 	handler.NewCommentHandler,
 	handler.NewFieldHandler,
 	handler.NewViewHandler,
+	handler.NewMentionHandler,
+	handler.NewNotificationHandler,
+	handler.NewEventHandler,
+	handler.NewWebhookHandler,
+	handler.NewBoardLinkHandler,
+	handler.NewChecklistHandler,
+	handler.NewAttachmentHandler,
 )
 
 // ==================== Provider Functions ====================
@@ -73,6 +127,30 @@ func provideUserClient(cfg *config.Config) client.UserClient {
 	return client.NewUserClient(cfg.UserService.URL)
 }
 
+// provideOutboxPublisher는 outbox 이벤트를 Redis stream과 webhook 전송 기록 양쪽으로 발행하는 Publisher를 생성합니다
+func provideOutboxPublisher(stream *outbox.RedisStreamPublisher, webhooks *webhook.OutboxPublisher) outbox.Publisher {
+	return outbox.MultiPublisher{stream, webhooks}
+}
+
+// provideWebhookURLPolicy는 webhook을 보낼 수 있는 주소 정책을 생성합니다
+func provideWebhookURLPolicy(cfg *config.Config) webhook.URLPolicy {
+	return webhook.URLPolicy{AllowPrivate: cfg.Webhook.AllowPrivateURLs}
+}
+
+// provideStorage는 STORAGE_DRIVER 설정에 따라 첨부파일 Storage를 생성합니다
+func provideStorage(cfg *config.Config) (storage.Storage, error) {
+	if cfg.Storage.Driver == "s3" {
+		return storage.NewS3Storage(storage.S3Config{
+			Endpoint:  cfg.Storage.S3.Endpoint,
+			Region:    cfg.Storage.S3.Region,
+			Bucket:    cfg.Storage.S3.Bucket,
+			AccessKey: cfg.Storage.S3.AccessKey,
+			SecretKey: cfg.Storage.S3.SecretKey,
+		})
+	}
+	return storage.NewLocalStorage(cfg.Storage.LocalPath)
+}
+
 // ==================== Wire Injectors ====================
 
 // InitializeApplication은 전체 애플리케이션을 초기화합니다
@@ -88,6 +166,10 @@ func InitializeApplication(
 		repositorySet,
 		cacheSet,
 		clientSet,
+		realtimeSet,
+		outboxSet,
+		webhookSet,
+		storageSet,
 		serviceSet,
 		handlerSet,
 
@@ -101,12 +183,28 @@ func InitializeApplication(
 
 // Application은 모든 핸들러를 포함하는 구조체입니다
 type Application struct {
-	HealthHandler  *handler.HealthHandler
-	ProjectHandler *handler.ProjectHandler
-	BoardHandler   *handler.BoardHandler
-	CommentHandler *handler.CommentHandler
-	FieldHandler   *handler.FieldHandler
-	ViewHandler    *handler.ViewHandler
+	HealthHandler       *handler.HealthHandler
+	ProjectHandler      *handler.ProjectHandler
+	BoardHandler        *handler.BoardHandler
+	CommentHandler      *handler.CommentHandler
+	FieldHandler        *handler.FieldHandler
+	ViewHandler         *handler.ViewHandler
+	MentionHandler      *handler.MentionHandler
+	NotificationHandler *handler.NotificationHandler
+	EventHandler        *handler.EventHandler
+	WebhookHandler      *handler.WebhookHandler
+	BoardLinkHandler    *handler.BoardLinkHandler
+	ChecklistHandler    *handler.ChecklistHandler
+	AttachmentHandler   *handler.AttachmentHandler
+
+	// EventBroker must be started with Run to deliver real-time events
+	EventBroker *realtime.Broker
+
+	// OutboxRelay must be started with Run to publish domain events
+	OutboxRelay *outbox.Relay
+
+	// WebhookDeliverer must be started with Run to send webhook deliveries
+	WebhookDeliverer *webhook.Deliverer
 }
 
 // NewApplication은 Application을 생성합니다
@@ -118,14 +216,34 @@ func NewApplication(
 	commentHandler *handler.CommentHandler,
 	fieldHandler *handler.FieldHandler,
 	viewHandler *handler.ViewHandler,
+	mentionHandler *handler.MentionHandler,
+	notificationHandler *handler.NotificationHandler,
+	eventHandler *handler.EventHandler,
+	webhookHandler *handler.WebhookHandler,
+	boardLinkHandler *handler.BoardLinkHandler,
+	checklistHandler *handler.ChecklistHandler,
+	attachmentHandler *handler.AttachmentHandler,
+	eventBroker *realtime.Broker,
+	outboxRelay *outbox.Relay,
+	webhookDeliverer *webhook.Deliverer,
 ) *Application {
 	return &Application{
-		HealthHandler:  healthHandler,
-		ProjectHandler: projectHandler,
-		BoardHandler:   boardHandler,
-		CommentHandler: commentHandler,
-		FieldHandler:   fieldHandler,
-		ViewHandler:    viewHandler,
+		HealthHandler:       healthHandler,
+		ProjectHandler:      projectHandler,
+		BoardHandler:        boardHandler,
+		CommentHandler:      commentHandler,
+		FieldHandler:        fieldHandler,
+		ViewHandler:         viewHandler,
+		MentionHandler:      mentionHandler,
+		NotificationHandler: notificationHandler,
+		EventHandler:        eventHandler,
+		WebhookHandler:      webhookHandler,
+		BoardLinkHandler:    boardLinkHandler,
+		ChecklistHandler:    checklistHandler,
+		AttachmentHandler:   attachmentHandler,
+		EventBroker:         eventBroker,
+		OutboxRelay:         outboxRelay,
+		WebhookDeliverer:    webhookDeliverer,
 	}
 }
 
@@ -134,6 +252,10 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 	// Health check (no authentication required)
 	handler.RegisterRoutes(r, app.HealthHandler)
 
+	// Project event stream (SSE)
+	// Registered outside the api group so EventSource clients can pass the token as a query parameter
+	r.GET("/api/projects/:projectId/events", middleware.StreamAuthMiddleware(cfg.JWT.Secret), app.EventHandler.StreamProjectEvents)
+
 	// API routes group (authentication required)
 	api := r.Group("/api")
 	api.Use(middleware.AuthMiddleware(cfg.JWT.Secret))
@@ -166,6 +288,17 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 
 			// Project views
 			projects.GET("/:projectId/views", app.ViewHandler.GetViewsByProject)
+
+			// Board query (text query language)
+			projects.GET("/:projectId/boards/query", app.ViewHandler.QueryBoards)
+
+			// Notification preferences (current user)
+			projects.GET("/:projectId/notification-preferences", app.NotificationHandler.GetNotificationPreference)
+			projects.PUT("/:projectId/notification-preferences", app.NotificationHandler.UpdateNotificationPreference)
+
+			// Project webhooks
+			projects.POST("/:projectId/webhooks", app.WebhookHandler.CreateWebhook)
+			projects.GET("/:projectId/webhooks", app.WebhookHandler.GetWebhooks)
 		}
 
 		// Board routes
@@ -177,6 +310,21 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 			boards.PUT("/:boardId", app.BoardHandler.UpdateBoard)
 			boards.DELETE("/:boardId", app.BoardHandler.DeleteBoard)
 			boards.PUT("/:boardId/move", app.BoardHandler.MoveBoard)
+			boards.PATCH("/:boardId/schedule", app.BoardHandler.RescheduleBoard)
+			boards.GET("/:boardId/activity", app.BoardHandler.GetBoardActivities)
+
+			// Board links (dependencies)
+			boards.POST("/:boardId/links", app.BoardLinkHandler.CreateBoardLink)
+			boards.GET("/:boardId/links", app.BoardLinkHandler.GetBoardLinks)
+			boards.DELETE("/:boardId/links/:linkId", app.BoardLinkHandler.DeleteBoardLink)
+
+			// Board checklist (subtasks)
+			boards.POST("/:boardId/checklist", app.ChecklistHandler.CreateChecklistItem)
+			boards.GET("/:boardId/checklist", app.ChecklistHandler.GetChecklist)
+
+			// Board attachments
+			boards.POST("/:boardId/attachments", app.AttachmentHandler.UploadBoardAttachment)
+			boards.GET("/:boardId/attachments", app.AttachmentHandler.GetBoardAttachments)
 
 			// Board field values
 			boards.GET("/:boardId/field-values", app.FieldHandler.GetBoardFieldValues)
@@ -190,6 +338,10 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 			comments.GET("", app.CommentHandler.GetCommentsByBoardID)
 			comments.PUT("/:commentId", app.CommentHandler.UpdateComment)
 			comments.DELETE("/:commentId", app.CommentHandler.DeleteComment)
+			comments.POST("/:commentId/replies", app.CommentHandler.CreateReply)
+			comments.GET("/:commentId/replies", app.CommentHandler.GetReplies)
+			comments.POST("/:commentId/attachments", app.AttachmentHandler.UploadCommentAttachment)
+			comments.GET("/:commentId/attachments", app.AttachmentHandler.GetCommentAttachments)
 		}
 
 		// Custom Fields routes
@@ -197,12 +349,18 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 		api.GET("/fields/:fieldId", app.FieldHandler.GetField)
 		api.PATCH("/fields/:fieldId", app.FieldHandler.UpdateField)
 		api.DELETE("/fields/:fieldId", app.FieldHandler.DeleteField)
+		api.PUT("/fields/:fieldId/edit-roles", app.FieldHandler.UpdateFieldEditRoles)
+		api.POST("/fields/:fieldId/conversion/preview", app.FieldHandler.PreviewFieldConversion)
+		api.POST("/fields/:fieldId/conversion", app.FieldHandler.ConvertFieldType)
 
 		// Field Options
 		api.POST("/field-options", app.FieldHandler.CreateOption)
 		api.GET("/fields/:fieldId/options", app.FieldHandler.GetOptionsByField)
 		api.PATCH("/field-options/:optionId", app.FieldHandler.UpdateOption)
 		api.DELETE("/field-options/:optionId", app.FieldHandler.DeleteOption)
+		api.POST("/field-options/:optionId/archive", app.FieldHandler.ArchiveOption)
+		api.POST("/field-options/:optionId/unarchive", app.FieldHandler.UnarchiveOption)
+		api.POST("/field-options/:optionId/merge", app.FieldHandler.MergeOption)
 		api.PUT("/fields/:fieldId/options/order", app.FieldHandler.UpdateOptionOrder)
 
 		// Board Field Values
@@ -215,6 +373,41 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 		api.PATCH("/views/:viewId", app.ViewHandler.UpdateView)
 		api.DELETE("/views/:viewId", app.ViewHandler.DeleteView)
 		api.GET("/views/:viewId/boards", app.ViewHandler.ApplyView)
+		api.GET("/views/:viewId/groups/:groupValue/boards", app.ViewHandler.GetGroupBoards)
+		api.GET("/views/:viewId/calendar", app.ViewHandler.GetCalendar)
+		api.PATCH("/views/:viewId/calendar/boards/:boardId", app.ViewHandler.MoveCalendarBoard)
+		api.GET("/views/:viewId/timeline", app.ViewHandler.GetTimeline)
+		api.PUT("/views/:viewId/table-settings/me", app.ViewHandler.UpdateMyTableSettings)
+		api.DELETE("/views/:viewId/table-settings/me", app.ViewHandler.ResetMyTableSettings)
 		api.PUT("/view-board-orders", app.ViewHandler.UpdateBoardOrder)
+
+		// Checklist items
+		api.PATCH("/checklist-items/:itemId", app.ChecklistHandler.UpdateChecklistItem)
+		api.PUT("/checklist-items/:itemId/move", app.ChecklistHandler.MoveChecklistItem)
+		api.DELETE("/checklist-items/:itemId", app.ChecklistHandler.DeleteChecklistItem)
+
+		// Attachments
+		api.GET("/attachments/:attachmentId/download", app.AttachmentHandler.DownloadAttachment)
+		api.DELETE("/attachments/:attachmentId", app.AttachmentHandler.DeleteAttachment)
+
+		// Webhooks
+		api.PATCH("/webhooks/:webhookId", app.WebhookHandler.UpdateWebhook)
+		api.DELETE("/webhooks/:webhookId", app.WebhookHandler.DeleteWebhook)
+		api.GET("/webhooks/:webhookId/deliveries", app.WebhookHandler.GetWebhookDeliveries)
+		api.POST("/webhooks/:webhookId/deliveries/:deliveryId/redeliver", app.WebhookHandler.RedeliverWebhook)
+
+		// Current user routes
+		me := api.Group("/me")
+		{
+			// Mention inbox
+			me.GET("/mentions", app.MentionHandler.GetMyMentions)
+			me.PUT("/mentions/read-all", app.MentionHandler.MarkAllMentionsAsRead)
+			me.PATCH("/mentions/:mentionId/read", app.MentionHandler.MarkMentionAsRead)
+
+			// Notifications
+			me.GET("/notifications", app.NotificationHandler.GetMyNotifications)
+			me.PUT("/notifications/read-all", app.NotificationHandler.MarkAllNotificationsAsRead)
+			me.PATCH("/notifications/:notificationId/read", app.NotificationHandler.MarkNotificationAsRead)
+		}
 	}
 }
@@ -12,8 +12,13 @@ import (
 	"board-service/internal/config"
 	"board-service/internal/handler"
 	"board-service/internal/middleware"
+	"board-service/internal/outbox"
+	"board-service/internal/realtime"
 	"board-service/internal/repository"
 	"board-service/internal/service"
+	"board-service/internal/storage"
+	"board-service/internal/uow"
+	"board-service/internal/webhook"
 	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
 	"github.com/redis/go-redis/v9"
@@ -42,27 +47,61 @@ func InitializeApplication(cfg *config.Config, log *zap.Logger, db *gorm.DB, rdb
 	userClient := provideUserClient(cfg)
 	workspaceCache := cache.NewWorkspaceCache(rdb)
 	userInfoCache := cache.NewUserInfoCache(rdb)
-	projectService := service.NewProjectService(projectRepository, roleRepository, fieldRepository, boardRepository, projectFieldRepository, fieldOptionRepository, boardOrderRepository, viewRepository, userClient, workspaceCache, userInfoCache, log, db)
+	notificationRepository := repository.NewNotificationRepository(db)
+	notificationService := service.NewNotificationService(notificationRepository, projectRepository, userClient, log)
+	broker := realtime.NewBroker(rdb, log)
+	webhookRepository := repository.NewWebhookRepository(db)
+	urlPolicy := provideWebhookURLPolicy(cfg)
+	webhookService := service.NewWebhookService(webhookRepository, projectRepository, roleRepository, urlPolicy, log)
+	projectService := service.NewProjectService(projectRepository, roleRepository, fieldRepository, boardRepository, projectFieldRepository, fieldOptionRepository, boardOrderRepository, viewRepository, notificationService, broker, userClient, workspaceCache, userInfoCache, log, db)
 	projectHandler := handler.NewProjectHandler(projectService)
 	commentRepository := repository.NewCommentRepository(db)
-	boardService := service.NewBoardService(boardRepository, projectRepository, roleRepository, fieldRepository, commentRepository, userClient, userInfoCache, log, db)
+	boardActivityRepository := repository.NewBoardActivityRepository(db)
+	mentionRepository := repository.NewMentionRepository(db)
+	mentionService := service.NewMentionService(mentionRepository, boardRepository, projectRepository, notificationService, userClient, log)
+	boardLinkRepository := repository.NewBoardLinkRepository(db)
+	checklistRepository := repository.NewChecklistRepository(db)
+	storageStorage, err := provideStorage(cfg)
+	if err != nil {
+		return nil, err
+	}
+	boardService := service.NewBoardService(boardRepository, projectRepository, roleRepository, fieldRepository, commentRepository, boardActivityRepository, boardLinkRepository, checklistRepository, storageStorage, mentionService, notificationService, broker, userClient, userInfoCache, log, db)
 	boardHandler := handler.NewBoardHandler(boardService)
-	commentService := service.NewCommentService(commentRepository, boardRepository, projectRepository, userClient, userInfoCache, log, db)
+	unitOfWork := uow.NewUnitOfWork(db)
+	commentService := service.NewCommentService(commentRepository, boardRepository, projectRepository, userClient, userInfoCache, mentionService, notificationService, broker, unitOfWork, log, db)
 	commentHandler := handler.NewCommentHandler(commentService)
 	fieldCache := cache.NewFieldCache(rdb)
-	fieldService := service.NewFieldService(fieldRepository, projectRepository, fieldCache, log, db)
-	fieldValueService := service.NewFieldValueService(fieldRepository, boardRepository, projectRepository, fieldCache, log, db)
+	fieldService := service.NewFieldService(fieldRepository, projectRepository, roleRepository, fieldCache, log, db)
+	fieldValueService := service.NewFieldValueService(fieldRepository, boardRepository, projectRepository, roleRepository, broker, fieldCache, log, db)
 	fieldHandler := handler.NewFieldHandler(fieldService, fieldValueService)
-	viewService := service.NewViewService(fieldRepository, boardRepository, projectRepository, fieldCache, log, db)
+	viewService := service.NewViewService(fieldRepository, boardRepository, projectRepository, checklistRepository, boardService, fieldCache, log, db)
 	viewHandler := handler.NewViewHandler(viewService)
-	application := NewApplication(healthHandler, projectHandler, boardHandler, commentHandler, fieldHandler, viewHandler)
+	mentionHandler := handler.NewMentionHandler(mentionService)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+	eventStreamService := service.NewEventStreamService(projectRepository, broker, log)
+	eventHandler := handler.NewEventHandler(eventStreamService)
+	webhookHandler := handler.NewWebhookHandler(webhookService)
+	boardLinkService := service.NewBoardLinkService(boardLinkRepository, boardRepository, projectRepository, roleRepository, broker, unitOfWork, log)
+	boardLinkHandler := handler.NewBoardLinkHandler(boardLinkService)
+	checklistService := service.NewChecklistService(checklistRepository, boardRepository, projectRepository, roleRepository, log)
+	checklistHandler := handler.NewChecklistHandler(checklistService)
+	attachmentRepository := repository.NewAttachmentRepository(db)
+	attachmentLimits := service.NewAttachmentLimits(cfg)
+	attachmentService := service.NewAttachmentService(attachmentRepository, boardRepository, commentRepository, projectRepository, roleRepository, storageStorage, attachmentLimits, log)
+	attachmentHandler := handler.NewAttachmentHandler(attachmentService)
+	redisStreamPublisher := outbox.NewRedisStreamPublisher(rdb)
+	outboxPublisher := webhook.NewOutboxPublisher(webhookRepository)
+	publisher := provideOutboxPublisher(redisStreamPublisher, outboxPublisher)
+	relay := outbox.NewRelay(unitOfWork, publisher, log)
+	deliverer := webhook.NewDeliverer(webhookRepository, urlPolicy, log)
+	application := NewApplication(healthHandler, projectHandler, boardHandler, commentHandler, fieldHandler, viewHandler, mentionHandler, notificationHandler, eventHandler, webhookHandler, boardLinkHandler, checklistHandler, attachmentHandler, broker, relay, deliverer)
 	return application, nil
 }
 
 // wire.go:
 
 // repositorySet은 모든 repository providers를 포함합니다
-var repositorySet = wire.NewSet(repository.NewRoleRepository, repository.NewProjectRepository, repository.NewBoardRepository, repository.NewCommentRepository, repository.NewFieldRepository, repository.NewProjectFieldRepository, repository.NewFieldOptionRepository, repository.NewBoardOrderRepository, repository.NewViewRepository)
+var repositorySet = wire.NewSet(repository.NewRoleRepository, repository.NewProjectRepository, repository.NewBoardRepository, repository.NewCommentRepository, repository.NewFieldRepository, repository.NewProjectFieldRepository, repository.NewFieldOptionRepository, repository.NewBoardOrderRepository, repository.NewViewRepository, repository.NewBoardActivityRepository, repository.NewMentionRepository, repository.NewNotificationRepository, repository.NewWebhookRepository, repository.NewBoardLinkRepository, repository.NewChecklistRepository, repository.NewAttachmentRepository)
 
 // cacheSet은 모든 cache providers를 포함합니다
 var cacheSet = wire.NewSet(cache.NewWorkspaceCache, cache.NewUserInfoCache, cache.NewFieldCache)
@@ -73,24 +112,78 @@ var clientSet = wire.NewSet(
 )
 
 // serviceSet은 모든 service providers를 포함합니다
-var serviceSet = wire.NewSet(service.NewBoardService, service.NewProjectService, service.NewCommentService, service.NewFieldService, service.NewFieldValueService, service.NewViewService)
+var serviceSet = wire.NewSet(service.NewBoardService, service.NewProjectService, service.NewCommentService, service.NewFieldService, service.NewFieldValueService, service.NewViewService, service.NewMentionService, service.NewNotificationService, service.NewEventStreamService, service.NewWebhookService, service.NewBoardLinkService, service.NewChecklistService, service.NewAttachmentLimits, service.NewAttachmentService)
+
+// realtimeSet은 프로젝트 실시간 이벤트 broker를 포함합니다
+var realtimeSet = wire.NewSet(realtime.NewBroker, wire.Bind(new(realtime.Publisher), new(*realtime.Broker)), wire.Bind(new(realtime.Subscriber), new(*realtime.Broker)))
+
+// outboxSet은 transactional outbox relay와 publisher를 포함합니다
+var outboxSet = wire.NewSet(uow.NewUnitOfWork, outbox.NewRedisStreamPublisher, provideOutboxPublisher, outbox.NewRelay)
+
+// webhookSet은 webhook 전송 worker를 포함합니다
+var webhookSet = wire.NewSet(provideWebhookURLPolicy, webhook.NewOutboxPublisher, webhook.NewDeliverer)
+
+// storageSet은 첨부파일 storage provider를 포함합니다
+var storageSet = wire.NewSet(
+	provideStorage,
+)
 
 // handlerSet은 모든 handler providers를 포함합니다
-var handlerSet = wire.NewSet(handler.NewHealthHandler, handler.NewProjectHandler, handler.NewBoardHandler, handler.NewCommentHandler, handler.NewFieldHandler, handler.NewViewHandler)
+var handlerSet = wire.NewSet(handler.NewHealthHandler, handler.NewProjectHandler, handler.NewBoardHandler, handler.NewCommentHandler, handler.NewFieldHandler, handler.NewViewHandler, handler.NewMentionHandler, handler.NewNotificationHandler, handler.NewEventHandler, handler.NewWebhookHandler, handler.NewBoardLinkHandler, handler.NewChecklistHandler, handler.NewAttachmentHandler)
 
 // provideUserClient는 UserClient를 생성합니다
 func provideUserClient(cfg *config.Config) client.UserClient {
 	return client.NewUserClient(cfg.UserService.URL)
 }
 
+// provideOutboxPublisher는 outbox 이벤트를 Redis stream과 webhook 전송 기록 양쪽으로 발행하는 Publisher를 생성합니다
+func provideOutboxPublisher(stream *outbox.RedisStreamPublisher, webhooks *webhook.OutboxPublisher) outbox.Publisher {
+	return outbox.MultiPublisher{stream, webhooks}
+}
+
+// provideWebhookURLPolicy는 webhook을 보낼 수 있는 주소 정책을 생성합니다
+func provideWebhookURLPolicy(cfg *config.Config) webhook.URLPolicy {
+	return webhook.URLPolicy{AllowPrivate: cfg.Webhook.AllowPrivateURLs}
+}
+
+// provideStorage는 STORAGE_DRIVER 설정에 따라 첨부파일 Storage를 생성합니다
+func provideStorage(cfg *config.Config) (storage.Storage, error) {
+	if cfg.Storage.Driver == "s3" {
+		return storage.NewS3Storage(storage.S3Config{
+			Endpoint:  cfg.Storage.S3.Endpoint,
+			Region:    cfg.Storage.S3.Region,
+			Bucket:    cfg.Storage.S3.Bucket,
+			AccessKey: cfg.Storage.S3.AccessKey,
+			SecretKey: cfg.Storage.S3.SecretKey,
+		})
+	}
+	return storage.NewLocalStorage(cfg.Storage.LocalPath)
+}
+
 // Application은 모든 핸들러를 포함하는 구조체입니다
 type Application struct {
-	HealthHandler  *handler.HealthHandler
-	ProjectHandler *handler.ProjectHandler
-	BoardHandler   *handler.BoardHandler
-	CommentHandler *handler.CommentHandler
-	FieldHandler   *handler.FieldHandler
-	ViewHandler    *handler.ViewHandler
+	HealthHandler       *handler.HealthHandler
+	ProjectHandler      *handler.ProjectHandler
+	BoardHandler        *handler.BoardHandler
+	CommentHandler      *handler.CommentHandler
+	FieldHandler        *handler.FieldHandler
+	ViewHandler         *handler.ViewHandler
+	MentionHandler      *handler.MentionHandler
+	NotificationHandler *handler.NotificationHandler
+	EventHandler        *handler.EventHandler
+	WebhookHandler      *handler.WebhookHandler
+	BoardLinkHandler    *handler.BoardLinkHandler
+	ChecklistHandler    *handler.ChecklistHandler
+	AttachmentHandler   *handler.AttachmentHandler
+
+	// EventBroker must be started with Run to deliver real-time events
+	EventBroker *realtime.Broker
+
+	// OutboxRelay must be started with Run to publish domain events
+	OutboxRelay *outbox.Relay
+
+	// WebhookDeliverer must be started with Run to send webhook deliveries
+	WebhookDeliverer *webhook.Deliverer
 }
 
 // NewApplication은 Application을 생성합니다
@@ -102,14 +195,34 @@ func NewApplication(
 	commentHandler *handler.CommentHandler,
 	fieldHandler *handler.FieldHandler,
 	viewHandler *handler.ViewHandler,
+	mentionHandler *handler.MentionHandler,
+	notificationHandler *handler.NotificationHandler,
+	eventHandler *handler.EventHandler,
+	webhookHandler *handler.WebhookHandler,
+	boardLinkHandler *handler.BoardLinkHandler,
+	checklistHandler *handler.ChecklistHandler,
+	attachmentHandler *handler.AttachmentHandler,
+	eventBroker *realtime.Broker,
+	outboxRelay *outbox.Relay,
+	webhookDeliverer *webhook.Deliverer,
 ) *Application {
 	return &Application{
-		HealthHandler:  healthHandler,
-		ProjectHandler: projectHandler,
-		BoardHandler:   boardHandler,
-		CommentHandler: commentHandler,
-		FieldHandler:   fieldHandler,
-		ViewHandler:    viewHandler,
+		HealthHandler:       healthHandler,
+		ProjectHandler:      projectHandler,
+		BoardHandler:        boardHandler,
+		CommentHandler:      commentHandler,
+		FieldHandler:        fieldHandler,
+		ViewHandler:         viewHandler,
+		MentionHandler:      mentionHandler,
+		NotificationHandler: notificationHandler,
+		EventHandler:        eventHandler,
+		WebhookHandler:      webhookHandler,
+		BoardLinkHandler:    boardLinkHandler,
+		ChecklistHandler:    checklistHandler,
+		AttachmentHandler:   attachmentHandler,
+		EventBroker:         eventBroker,
+		OutboxRelay:         outboxRelay,
+		WebhookDeliverer:    webhookDeliverer,
 	}
 }
 
@@ -117,6 +230,8 @@ func NewApplication(
 func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 	handler.RegisterRoutes(r, app.HealthHandler)
 
+	r.GET("/api/projects/:projectId/events", middleware.StreamAuthMiddleware(cfg.JWT.Secret), app.EventHandler.StreamProjectEvents)
+
 	api := r.Group("/api")
 	api.Use(middleware.AuthMiddleware(cfg.JWT.Secret))
 	{
@@ -144,6 +259,12 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 			projects.PUT("/:projectId/fields/order", app.FieldHandler.UpdateFieldOrder)
 
 			projects.GET("/:projectId/views", app.ViewHandler.GetViewsByProject)
+			projects.GET("/:projectId/boards/query", app.ViewHandler.QueryBoards)
+
+			projects.GET("/:projectId/notification-preferences", app.NotificationHandler.GetNotificationPreference)
+			projects.PUT("/:projectId/notification-preferences", app.NotificationHandler.UpdateNotificationPreference)
+			projects.POST("/:projectId/webhooks", app.WebhookHandler.CreateWebhook)
+			projects.GET("/:projectId/webhooks", app.WebhookHandler.GetWebhooks)
 		}
 
 		boards := api.Group("/boards")
@@ -154,6 +275,15 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 			boards.PUT("/:boardId", app.BoardHandler.UpdateBoard)
 			boards.DELETE("/:boardId", app.BoardHandler.DeleteBoard)
 			boards.PUT("/:boardId/move", app.BoardHandler.MoveBoard)
+			boards.PATCH("/:boardId/schedule", app.BoardHandler.RescheduleBoard)
+			boards.GET("/:boardId/activity", app.BoardHandler.GetBoardActivities)
+			boards.POST("/:boardId/links", app.BoardLinkHandler.CreateBoardLink)
+			boards.GET("/:boardId/links", app.BoardLinkHandler.GetBoardLinks)
+			boards.DELETE("/:boardId/links/:linkId", app.BoardLinkHandler.DeleteBoardLink)
+			boards.POST("/:boardId/checklist", app.ChecklistHandler.CreateChecklistItem)
+			boards.GET("/:boardId/checklist", app.ChecklistHandler.GetChecklist)
+			boards.POST("/:boardId/attachments", app.AttachmentHandler.UploadBoardAttachment)
+			boards.GET("/:boardId/attachments", app.AttachmentHandler.GetBoardAttachments)
 
 			boards.GET("/:boardId/field-values", app.FieldHandler.GetBoardFieldValues)
 			api.DELETE("/boards/:boardId/field-values/:fieldId", app.FieldHandler.DeleteFieldValue)
@@ -165,17 +295,27 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 			comments.GET("", app.CommentHandler.GetCommentsByBoardID)
 			comments.PUT("/:commentId", app.CommentHandler.UpdateComment)
 			comments.DELETE("/:commentId", app.CommentHandler.DeleteComment)
+			comments.POST("/:commentId/replies", app.CommentHandler.CreateReply)
+			comments.GET("/:commentId/replies", app.CommentHandler.GetReplies)
+			comments.POST("/:commentId/attachments", app.AttachmentHandler.UploadCommentAttachment)
+			comments.GET("/:commentId/attachments", app.AttachmentHandler.GetCommentAttachments)
 		}
 
 		api.POST("/fields", app.FieldHandler.CreateField)
 		api.GET("/fields/:fieldId", app.FieldHandler.GetField)
 		api.PATCH("/fields/:fieldId", app.FieldHandler.UpdateField)
 		api.DELETE("/fields/:fieldId", app.FieldHandler.DeleteField)
+		api.PUT("/fields/:fieldId/edit-roles", app.FieldHandler.UpdateFieldEditRoles)
+		api.POST("/fields/:fieldId/conversion/preview", app.FieldHandler.PreviewFieldConversion)
+		api.POST("/fields/:fieldId/conversion", app.FieldHandler.ConvertFieldType)
 
 		api.POST("/field-options", app.FieldHandler.CreateOption)
 		api.GET("/fields/:fieldId/options", app.FieldHandler.GetOptionsByField)
 		api.PATCH("/field-options/:optionId", app.FieldHandler.UpdateOption)
 		api.DELETE("/field-options/:optionId", app.FieldHandler.DeleteOption)
+		api.POST("/field-options/:optionId/archive", app.FieldHandler.ArchiveOption)
+		api.POST("/field-options/:optionId/unarchive", app.FieldHandler.UnarchiveOption)
+		api.POST("/field-options/:optionId/merge", app.FieldHandler.MergeOption)
 		api.PUT("/fields/:fieldId/options/order", app.FieldHandler.UpdateOptionOrder)
 
 		api.POST("/board-field-values", app.FieldHandler.SetFieldValue)
@@ -186,6 +326,34 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 		api.PATCH("/views/:viewId", app.ViewHandler.UpdateView)
 		api.DELETE("/views/:viewId", app.ViewHandler.DeleteView)
 		api.GET("/views/:viewId/boards", app.ViewHandler.ApplyView)
+		api.GET("/views/:viewId/groups/:groupValue/boards", app.ViewHandler.GetGroupBoards)
+		api.GET("/views/:viewId/calendar", app.ViewHandler.GetCalendar)
+		api.PATCH("/views/:viewId/calendar/boards/:boardId", app.ViewHandler.MoveCalendarBoard)
+		api.GET("/views/:viewId/timeline", app.ViewHandler.GetTimeline)
+		api.PUT("/views/:viewId/table-settings/me", app.ViewHandler.UpdateMyTableSettings)
+		api.DELETE("/views/:viewId/table-settings/me", app.ViewHandler.ResetMyTableSettings)
 		api.PUT("/view-board-orders", app.ViewHandler.UpdateBoardOrder)
+
+		api.PATCH("/checklist-items/:itemId", app.ChecklistHandler.UpdateChecklistItem)
+		api.PUT("/checklist-items/:itemId/move", app.ChecklistHandler.MoveChecklistItem)
+		api.DELETE("/checklist-items/:itemId", app.ChecklistHandler.DeleteChecklistItem)
+
+		api.GET("/attachments/:attachmentId/download", app.AttachmentHandler.DownloadAttachment)
+		api.DELETE("/attachments/:attachmentId", app.AttachmentHandler.DeleteAttachment)
+
+		api.PATCH("/webhooks/:webhookId", app.WebhookHandler.UpdateWebhook)
+		api.DELETE("/webhooks/:webhookId", app.WebhookHandler.DeleteWebhook)
+		api.GET("/webhooks/:webhookId/deliveries", app.WebhookHandler.GetWebhookDeliveries)
+		api.POST("/webhooks/:webhookId/deliveries/:deliveryId/redeliver", app.WebhookHandler.RedeliverWebhook)
+
+		me := api.Group("/me")
+		{
+			me.GET("/mentions", app.MentionHandler.GetMyMentions)
+			me.PUT("/mentions/read-all", app.MentionHandler.MarkAllMentionsAsRead)
+			me.PATCH("/mentions/:mentionId/read", app.MentionHandler.MarkMentionAsRead)
+			me.GET("/notifications", app.NotificationHandler.GetMyNotifications)
+			me.PUT("/notifications/read-all", app.NotificationHandler.MarkAllNotificationsAsRead)
+			me.PATCH("/notifications/:notificationId/read", app.NotificationHandler.MarkNotificationAsRead)
+		}
 	}
 }
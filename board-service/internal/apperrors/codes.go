@@ -16,6 +16,10 @@ const (
 	ErrCodeWorkspaceAccessDenied     = "WORKSPACE_ACCESS_DENIED"
 	ErrCodeWorkspaceNotFound         = "WORKSPACE_NOT_FOUND"
 	ErrCodeNotImplemented            = "NOT_IMPLEMENTED"
+	ErrCodePayloadTooLarge           = "PAYLOAD_TOO_LARGE"
+	ErrCodeUnsupportedMediaType      = "UNSUPPORTED_MEDIA_TYPE"
+	ErrCodeQuerySyntax               = "QUERY_SYNTAX_ERROR"
+	ErrCodeFieldEditForbidden        = "FIELD_EDIT_FORBIDDEN"
 )
 
 // Predefined errors
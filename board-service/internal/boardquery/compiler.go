@@ -0,0 +1,376 @@
+package boardquery
+
+import (
+	"board-service/internal/domain"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Schema is what field and option names are resolved against
+type Schema struct {
+	Fields []domain.ProjectField
+	// Options returns the options of a select field; called at most once per field
+	Options func(fieldID uuid.UUID) ([]domain.FieldOption, error)
+}
+
+// Env provides the values of me(), now() and today()
+type Env struct {
+	UserID uuid.UUID
+	Now    time.Time
+}
+
+// Compiled is a query translated to saved view filters
+type Compiled struct {
+	Filter  *domain.FilterNode // nil when the query has no conditions
+	OrderBy []OrderKey
+}
+
+// OrderKey is a resolved ORDER BY key; Field is a built-in filter key or a custom field ID
+type OrderKey struct {
+	Field string
+	Desc  bool
+}
+
+type valueKind int
+
+const (
+	kindText valueKind = iota
+	kindNumber
+	kindTime
+	kindSelect
+	kindUser
+	kindMultiSelect
+	kindMultiUser
+	kindBool
+)
+
+// resolvedField is a field name resolved to a filter key
+type resolvedField struct {
+	key   string // Built-in filter key or custom field ID
+	name  string
+	kind  valueKind
+	field *domain.ProjectField // nil for built-in fields
+}
+
+// builtInFields maps the built-in field names (lower case) to filter keys
+var builtInFields = map[string]resolvedField{
+	"title":       {key: "title", kind: kindText},
+	"description": {key: "description", kind: kindText},
+	"assignee":    {key: "assignee_id", kind: kindUser},
+	"author":      {key: "created_by", kind: kindUser},
+	"creator":     {key: "created_by", kind: kindUser},
+	"created_by":  {key: "created_by", kind: kindUser},
+	"due":         {key: "due_date", kind: kindTime},
+	"due_date":    {key: "due_date", kind: kindTime},
+	"start":       {key: "start_date", kind: kindTime},
+	"start_date":  {key: "start_date", kind: kindTime},
+	"created":     {key: "created_at", kind: kindTime},
+	"created_at":  {key: "created_at", kind: kindTime},
+	"updated":     {key: "updated_at", kind: kindTime},
+	"updated_at":  {key: "updated_at", kind: kindTime},
+}
+
+var fieldKinds = map[domain.FieldType]valueKind{
+	domain.FieldTypeText:         kindText,
+	domain.FieldTypeURL:          kindText,
+	domain.FieldTypeNumber:       kindNumber,
+	domain.FieldTypeDate:         kindTime,
+	domain.FieldTypeDateTime:     kindTime,
+	domain.FieldTypeSingleSelect: kindSelect,
+	domain.FieldTypeSingleUser:   kindUser,
+	domain.FieldTypeMultiSelect:  kindMultiSelect,
+	domain.FieldTypeMultiUser:    kindMultiUser,
+	domain.FieldTypeCheckbox:     kindBool,
+}
+
+// operatorMapping is the filter operator for a query operator; negate wraps the condition in a not group
+type operatorMapping struct {
+	op     string
+	negate bool
+}
+
+var operatorsByKind = map[valueKind]map[string]operatorMapping{
+	kindText: {
+		"=": {op: domain.FilterOpEq}, "!=": {op: domain.FilterOpNe},
+		"~": {op: domain.FilterOpContains}, "!~": {op: domain.FilterOpContains, negate: true},
+		"in": {op: domain.FilterOpIn}, "not in": {op: domain.FilterOpNotIn},
+	},
+	kindNumber: {
+		"=": {op: domain.FilterOpEq}, "!=": {op: domain.FilterOpNe},
+		">": {op: domain.FilterOpGt}, ">=": {op: domain.FilterOpGte}, "<": {op: domain.FilterOpLt}, "<=": {op: domain.FilterOpLte},
+		"in": {op: domain.FilterOpIn}, "not in": {op: domain.FilterOpNotIn},
+	},
+	kindTime: {
+		"=": {op: domain.FilterOpEq}, "!=": {op: domain.FilterOpNe},
+		">": {op: domain.FilterOpGt}, ">=": {op: domain.FilterOpGte}, "<": {op: domain.FilterOpLt}, "<=": {op: domain.FilterOpLte},
+	},
+	kindSelect:      refOperators,
+	kindUser:        refOperators,
+	kindMultiSelect: multiOperators,
+	kindMultiUser:   multiOperators,
+	kindBool: {
+		"=": {op: domain.FilterOpEq}, "!=": {op: domain.FilterOpNe},
+	},
+}
+
+var refOperators = map[string]operatorMapping{
+	"=": {op: domain.FilterOpEq}, "!=": {op: domain.FilterOpNe},
+	"in": {op: domain.FilterOpIn}, "not in": {op: domain.FilterOpNotIn},
+}
+
+// A multi-value field "= X" means it has X, "!= X" means it does not
+var multiOperators = map[string]operatorMapping{
+	"=": {op: domain.FilterOpContains}, "!=": {op: domain.FilterOpNotIn},
+	"in": {op: domain.FilterOpIn}, "not in": {op: domain.FilterOpNotIn},
+}
+
+// Compile resolves field names, option labels and functions and translates the query to a filter tree
+func Compile(query *Query, schema Schema, env Env) (*Compiled, error) {
+	c := &compiler{schema: schema, env: env, options: make(map[uuid.UUID][]domain.FieldOption)}
+
+	compiled := &Compiled{}
+	if query.Where != nil {
+		node, err := c.expr(query.Where)
+		if err != nil {
+			return nil, err
+		}
+		if !node.IsGroup() {
+			node = &domain.FilterNode{Op: domain.FilterGroupAnd, Conditions: []domain.FilterNode{*node}}
+		}
+		compiled.Filter = node
+	}
+
+	for _, term := range query.OrderBy {
+		field, err := c.resolveField(term.Field)
+		if err != nil {
+			return nil, err
+		}
+		if field.kind == kindMultiSelect || field.kind == kindMultiUser {
+			return nil, errorAt(term.Field.Pos, "다중 값 필드 '%s'(으)로는 정렬할 수 없습니다", term.Field.Text)
+		}
+		compiled.OrderBy = append(compiled.OrderBy, OrderKey{Field: field.key, Desc: term.Desc})
+	}
+
+	return compiled, nil
+}
+
+type compiler struct {
+	schema  Schema
+	env     Env
+	options map[uuid.UUID][]domain.FieldOption
+}
+
+func (c *compiler) expr(expr Expr) (*domain.FilterNode, error) {
+	switch e := expr.(type) {
+	case *LogicalExpr:
+		node := &domain.FilterNode{Op: e.Op, Conditions: make([]domain.FilterNode, 0, len(e.Operands))}
+		for _, operand := range e.Operands {
+			child, err := c.expr(operand)
+			if err != nil {
+				return nil, err
+			}
+			node.Conditions = append(node.Conditions, *child)
+		}
+		return node, nil
+
+	case *NotExpr:
+		child, err := c.expr(e.Operand)
+		if err != nil {
+			return nil, err
+		}
+		return &domain.FilterNode{Op: domain.FilterGroupNot, Conditions: []domain.FilterNode{*child}}, nil
+
+	default:
+		return c.condition(expr.(*Condition))
+	}
+}
+
+func (c *compiler) condition(cond *Condition) (*domain.FilterNode, error) {
+	field, err := c.resolveField(cond.Field)
+	if err != nil {
+		return nil, err
+	}
+	node := &domain.FilterNode{Field: field.key}
+
+	switch cond.Operator {
+	case "is empty":
+		node.Operator = domain.FilterOpIsNull
+		return node, nil
+	case "is not empty":
+		node.Operator = domain.FilterOpIsNotNull
+		return node, nil
+	}
+
+	mapping, ok := operatorsByKind[field.kind][cond.Operator]
+	if !ok {
+		return nil, errorAt(cond.OpPos, "'%s' 연산자는 '%s' 필드에 사용할 수 없습니다", strings.ToUpper(cond.Operator), cond.Field.Text)
+	}
+	node.Operator = mapping.op
+
+	values := make([]interface{}, 0, len(cond.Values))
+	for _, v := range cond.Values {
+		value, err := c.value(field, v)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	if mapping.op == domain.FilterOpIn || mapping.op == domain.FilterOpNotIn ||
+		(mapping.op == domain.FilterOpContains && field.kind != kindText) {
+		node.Value = values
+	} else {
+		node.Value = values[0]
+	}
+
+	if mapping.negate {
+		return &domain.FilterNode{Op: domain.FilterGroupNot, Conditions: []domain.FilterNode{*node}}, nil
+	}
+	return node, nil
+}
+
+// resolveField looks up built-in fields first, then project fields by name (case-insensitive)
+func (c *compiler) resolveField(name Name) (resolvedField, error) {
+	if builtIn, ok := builtInFields[strings.ToLower(name.Text)]; ok {
+		builtIn.name = name.Text
+		return builtIn, nil
+	}
+
+	var found *domain.ProjectField
+	for i := range c.schema.Fields {
+		field := &c.schema.Fields[i]
+		if !strings.EqualFold(strings.TrimSpace(field.Name), strings.TrimSpace(name.Text)) {
+			continue
+		}
+		if found != nil {
+			return resolvedField{}, errorAt(name.Pos, "'%s' 이름의 필드가 여러 개입니다", name.Text)
+		}
+		found = field
+	}
+	if found == nil {
+		return resolvedField{}, errorAt(name.Pos, "알 수 없는 필드입니다: '%s'", name.Text)
+	}
+
+	kind, ok := fieldKinds[found.FieldType]
+	if !ok {
+		return resolvedField{}, errorAt(name.Pos, "'%s' 필드는 검색할 수 없습니다", name.Text)
+	}
+	return resolvedField{key: found.ID.String(), name: name.Text, kind: kind, field: found}, nil
+}
+
+// value converts a query value to the filter value for the field
+func (c *compiler) value(field resolvedField, v Value) (interface{}, error) {
+	if v.Kind == ValueFunc {
+		return c.function(field, v)
+	}
+
+	switch field.kind {
+	case kindNumber:
+		if v.Kind != ValueNumber {
+			return nil, errorAt(v.Pos, "'%s' 필드에는 숫자가 필요합니다", field.name)
+		}
+		return v.Number, nil
+
+	case kindTime:
+		if v.Kind != ValueString {
+			return nil, errorAt(v.Pos, "'%s' 필드에는 \"YYYY-MM-DD\" 형식의 날짜나 now(), today()가 필요합니다", field.name)
+		}
+		if _, err := time.Parse("2006-01-02", v.Text); err != nil {
+			if _, err := time.Parse(time.RFC3339, v.Text); err != nil {
+				return nil, errorAt(v.Pos, "날짜 형식이 올바르지 않습니다: %s (YYYY-MM-DD 또는 RFC3339)", v.Text)
+			}
+		}
+		return v.Text, nil
+
+	case kindSelect, kindMultiSelect:
+		return c.option(field, v)
+
+	case kindUser, kindMultiUser:
+		if id, err := uuid.Parse(v.Text); err == nil && v.Kind != ValueNumber {
+			return id.String(), nil
+		}
+		return nil, errorAt(v.Pos, "'%s' 필드의 사용자는 me() 또는 따옴표로 감싼 사용자 ID로 지정하세요", field.name)
+
+	case kindBool:
+		if v.Kind == ValueWord && (strings.EqualFold(v.Text, "true") || strings.EqualFold(v.Text, "false")) {
+			return strings.EqualFold(v.Text, "true"), nil
+		}
+		return nil, errorAt(v.Pos, "'%s' 필드에는 true 또는 false가 필요합니다", field.name)
+
+	default:
+		return v.Text, nil
+	}
+}
+
+// option resolves an option label (case-insensitive) or option ID of a select field
+func (c *compiler) option(field resolvedField, v Value) (interface{}, error) {
+	options, ok := c.options[field.field.ID]
+	if !ok {
+		var err error
+		if options, err = c.schema.Options(field.field.ID); err != nil {
+			return nil, err
+		}
+		c.options[field.field.ID] = options
+	}
+
+	for _, option := range options {
+		if strings.EqualFold(strings.TrimSpace(option.Label), strings.TrimSpace(v.Text)) || option.ID.String() == strings.ToLower(v.Text) {
+			return option.ID.String(), nil
+		}
+	}
+
+	labels := make([]string, 0, len(options))
+	for _, option := range options {
+		labels = append(labels, option.Label)
+	}
+	return nil, errorAt(v.Pos, "'%s' 필드에 '%s' 옵션이 없습니다 (사용 가능: %s)", field.name, v.Text, strings.Join(labels, ", "))
+}
+
+// function evaluates me(), now() and today() with an optional offset
+func (c *compiler) function(field resolvedField, v Value) (interface{}, error) {
+	switch v.Text {
+	case "me":
+		if field.kind != kindUser && field.kind != kindMultiUser {
+			return nil, errorAt(v.Pos, "me()는 사용자 필드에만 사용할 수 있습니다")
+		}
+		if v.Offset != nil {
+			return nil, errorAt(v.Pos, "me()에는 기간을 더할 수 없습니다")
+		}
+		return c.env.UserID.String(), nil
+
+	case "now", "today":
+		if field.kind != kindTime {
+			return nil, errorAt(v.Pos, "%s()는 날짜 필드에만 사용할 수 있습니다", v.Text)
+		}
+		now := c.env.Now.UTC()
+		if v.Text == "now" {
+			return shift(now, v.Offset).Format(time.RFC3339), nil
+		}
+
+		// today() is a whole day unless it is shifted by hours or minutes
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		if v.Offset != nil && (v.Offset.Unit == 'h' || v.Offset.Unit == 'm') {
+			return shift(today, v.Offset).Format(time.RFC3339), nil
+		}
+		return shift(today, v.Offset).Format("2006-01-02"), nil
+	}
+
+	return nil, errorAt(v.Pos, "알 수 없는 함수입니다: %s() (me, now, today)", v.Text)
+}
+
+func shift(t time.Time, offset *Offset) time.Time {
+	if offset == nil {
+		return t
+	}
+	switch offset.Unit {
+	case 'd':
+		return t.AddDate(0, 0, offset.Amount)
+	case 'w':
+		return t.AddDate(0, 0, 7*offset.Amount)
+	case 'h':
+		return t.Add(time.Duration(offset.Amount) * time.Hour)
+	default:
+		return t.Add(time.Duration(offset.Amount) * time.Minute)
+	}
+}
@@ -0,0 +1,135 @@
+package boardquery
+
+import (
+	"board-service/internal/domain"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSchema struct {
+	schema     Schema
+	stage      domain.ProjectField
+	importance domain.ProjectField
+	labels     domain.ProjectField
+	options    map[string]domain.FieldOption
+	loads      int
+}
+
+func newTestSchema() *testSchema {
+	newField := func(name string, fieldType domain.FieldType) domain.ProjectField {
+		return domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, Name: name, FieldType: fieldType}
+	}
+	ts := &testSchema{
+		stage:      newField("Stage", domain.FieldTypeSingleSelect),
+		importance: newField("importance", domain.FieldTypeNumber),
+		labels:     newField("labels", domain.FieldTypeMultiSelect),
+		options:    make(map[string]domain.FieldOption),
+	}
+
+	optionsByField := map[uuid.UUID][]domain.FieldOption{}
+	for fieldID, labels := range map[uuid.UUID][]string{ts.stage.ID: {"진행중", "완료"}, ts.labels.ID: {"bug", "ui"}} {
+		for _, label := range labels {
+			option := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldID: fieldID, Label: label}
+			optionsByField[fieldID] = append(optionsByField[fieldID], option)
+			ts.options[label] = option
+		}
+	}
+
+	ts.schema = Schema{
+		Fields: []domain.ProjectField{ts.stage, ts.importance, ts.labels},
+		Options: func(fieldID uuid.UUID) ([]domain.FieldOption, error) {
+			ts.loads++
+			return optionsByField[fieldID], nil
+		},
+	}
+	return ts
+}
+
+func compileQuery(t *testing.T, ts *testSchema, env Env, q string) (*Compiled, error) {
+	t.Helper()
+	query, err := Parse(q)
+	require.NoError(t, err)
+	return Compile(query, ts.schema, env)
+}
+
+func TestCompile_ExampleQuery(t *testing.T) {
+	ts := newTestSchema()
+	me := uuid.New()
+	env := Env{UserID: me, Now: time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)}
+
+	compiled, err := compileQuery(t, ts, env, `stage = "진행중" AND assignee = me() AND due < now() + 7d ORDER BY importance DESC`)
+	require.NoError(t, err)
+
+	assert.Equal(t, &domain.FilterNode{
+		Op: domain.FilterGroupAnd,
+		Conditions: []domain.FilterNode{
+			{Field: ts.stage.ID.String(), Operator: domain.FilterOpEq, Value: ts.options["진행중"].ID.String()},
+			{Field: "assignee_id", Operator: domain.FilterOpEq, Value: me.String()},
+			{Field: "due_date", Operator: domain.FilterOpLt, Value: "2026-10-23T09:30:00Z"},
+		},
+	}, compiled.Filter)
+	assert.Equal(t, []OrderKey{{Field: ts.importance.ID.String(), Desc: true}}, compiled.OrderBy)
+}
+
+func TestCompile_ValuesPerFieldKind(t *testing.T) {
+	ts := newTestSchema()
+	env := Env{UserID: uuid.New(), Now: time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)}
+
+	compiled, err := compileQuery(t, ts, env,
+		`STAGE in (완료, "진행중") or labels = BUG or labels != ui or title !~ "wip" or created >= today() - 1w or importance > 3`)
+	require.NoError(t, err)
+
+	conditions := compiled.Filter.Conditions
+	require.Len(t, conditions, 6)
+	assert.Equal(t, domain.FilterGroupOr, compiled.Filter.Op)
+
+	// Option labels are matched case-insensitively and loaded once per field
+	assert.Equal(t, []interface{}{ts.options["완료"].ID.String(), ts.options["진행중"].ID.String()}, conditions[0].Value)
+	assert.Equal(t, domain.FilterNode{Field: ts.labels.ID.String(), Operator: domain.FilterOpContains, Value: []interface{}{ts.options["bug"].ID.String()}}, conditions[1])
+	assert.Equal(t, domain.FilterNode{Field: ts.labels.ID.String(), Operator: domain.FilterOpNotIn, Value: []interface{}{ts.options["ui"].ID.String()}}, conditions[2])
+	assert.Equal(t, 2, ts.loads)
+
+	// !~ is a negated contains
+	assert.Equal(t, domain.FilterNode{
+		Op:         domain.FilterGroupNot,
+		Conditions: []domain.FilterNode{{Field: "title", Operator: domain.FilterOpContains, Value: "wip"}},
+	}, conditions[3])
+
+	// today() is a whole day
+	assert.Equal(t, domain.FilterNode{Field: "created_at", Operator: domain.FilterOpGte, Value: "2026-10-09"}, conditions[4])
+	assert.Equal(t, domain.FilterNode{Field: ts.importance.ID.String(), Operator: domain.FilterOpGt, Value: 3.0}, conditions[5])
+}
+
+func TestCompile_NameErrorPositions(t *testing.T) {
+	ts := newTestSchema()
+	env := Env{UserID: uuid.New(), Now: time.Now()}
+
+	tests := []struct {
+		query string
+		pos   int
+	}{
+		{`priority = high`, 1},            // Unknown field
+		{`stage = 보류`, 9},                 // Unknown option
+		{`stage > 완료`, 7},                 // Operator not supported by the field
+		{`importance = high`, 14},         // Number expected
+		{`assignee = bob`, 12},            // Users are me() or IDs
+		{`due < "next week"`, 7},          // Bad date
+		{`due < me()`, 7},                 // me() on a date field
+		{`due < later()`, 7},              // Unknown function
+		{`title = a ORDER BY labels`, 20}, // Multi-value sort
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := compileQuery(t, ts, env, tt.query)
+			var queryErr *Error
+			require.True(t, errors.As(err, &queryErr), "expected a query error, got %v", err)
+			assert.Equal(t, tt.pos, queryErr.Pos, queryErr.Message)
+		})
+	}
+}
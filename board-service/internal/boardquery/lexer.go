@@ -0,0 +1,160 @@
+package boardquery
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokEOF      tokenKind = iota
+	tokIdent              // Bare word: field name, option label, keyword or function name
+	tokString             // "quoted" or 'quoted' text
+	tokNumber             // 3, 1.5
+	tokDuration           // 7d, 2w, 3h, 30m
+	tokOperator           // = != > >= < <= ~ !~
+	tokLParen
+	tokRParen
+	tokComma
+	tokPlus
+	tokMinus
+)
+
+type token struct {
+	kind tokenKind
+	text string // Unquoted text for strings, source text otherwise
+	pos  int    // 1-based character position in the query
+}
+
+// is reports whether the token is the given keyword (case-insensitive)
+func (t token) is(keyword string) bool {
+	return t.kind == tokIdent && strings.EqualFold(t.text, keyword)
+}
+
+// keywords cannot be used as bare field names or values
+var keywords = map[string]bool{
+	"AND": true, "OR": true, "NOT": true, "IN": true, "IS": true,
+	"EMPTY": true, "NULL": true, "ORDER": true, "BY": true, "ASC": true, "DESC": true,
+}
+
+func isKeyword(text string) bool {
+	return keywords[strings.ToUpper(text)]
+}
+
+// tokenize splits the query into tokens; the last token is always tokEOF
+func tokenize(input string) ([]token, error) {
+	runes := []rune(input)
+	var tokens []token
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '"' || r == '\'':
+			text, next, ok := scanString(runes, i)
+			if !ok {
+				return nil, errorAt(pos, "따옴표가 닫히지 않았습니다")
+			}
+			tokens = append(tokens, token{kind: tokString, text: text, pos: pos})
+			i = next
+
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			kind := tokNumber
+			if i < len(runes) && isIdentRune(runes[i]) {
+				unit := i
+				for i < len(runes) && isIdentRune(runes[i]) {
+					i++
+				}
+				if i-unit != 1 || !strings.ContainsRune("dwhm", runes[unit]) {
+					return nil, errorAt(pos, "잘못된 숫자입니다: %s (기간은 7d, 2w, 3h, 30m 형식, ID와 공백이 있는 값은 따옴표로 감싸세요)", string(runes[start:i]))
+				}
+				kind = tokDuration
+			}
+			if strings.Count(string(runes[start:i]), ".") > 1 {
+				return nil, errorAt(pos, "잘못된 숫자입니다: %s", string(runes[start:i]))
+			}
+			tokens = append(tokens, token{kind: kind, text: string(runes[start:i]), pos: pos})
+
+		case isIdentStart(r):
+			start := i
+			for i < len(runes) && isIdentRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: string(runes[start:i]), pos: pos})
+
+		case r == '=' || r == '~':
+			tokens = append(tokens, token{kind: tokOperator, text: string(r), pos: pos})
+			i++
+
+		case r == '!' || r == '>' || r == '<':
+			op := string(r)
+			if i+1 < len(runes) && (runes[i+1] == '=' || (r == '!' && runes[i+1] == '~')) {
+				op += string(runes[i+1])
+			}
+			if op == "!" {
+				return nil, errorAt(pos, "'!' 다음에는 '=' 또는 '~'가 와야 합니다")
+			}
+			tokens = append(tokens, token{kind: tokOperator, text: op, pos: pos})
+			i += len([]rune(op))
+
+		case r == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "(", pos: pos})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")", pos: pos})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokComma, text: ",", pos: pos})
+			i++
+		case r == '+':
+			tokens = append(tokens, token{kind: tokPlus, text: "+", pos: pos})
+			i++
+		case r == '-':
+			tokens = append(tokens, token{kind: tokMinus, text: "-", pos: pos})
+			i++
+
+		default:
+			return nil, errorAt(pos, "알 수 없는 문자입니다: %q", r)
+		}
+	}
+
+	return append(tokens, token{kind: tokEOF, pos: len(runes) + 1}), nil
+}
+
+// scanString reads a quoted string starting at runes[start]; backslash escapes the next character
+func scanString(runes []rune, start int) (string, int, bool) {
+	quote := runes[start]
+	var sb strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				sb.WriteRune(runes[i])
+			}
+		case quote:
+			return sb.String(), i + 1, true
+		default:
+			sb.WriteRune(runes[i])
+		}
+	}
+	return "", 0, false
+}
+
+func isIdentStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_'
+}
+
+// isIdentRune allows letters, digits, '_', '-' and '.' inside bare words (e.g. in-progress, v1.2)
+func isIdentRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '.'
+}
@@ -0,0 +1,410 @@
+// Package boardquery parses the board query language and compiles it to saved view filters.
+//
+//	stage = "진행중" AND assignee = me() AND due < now() + 7d ORDER BY importance DESC
+//
+// Conditions are combined with AND, OR, NOT and parentheses. Operators are = != > >= < <= ~ (contains)
+// !~ (does not contain), IN (...), NOT IN (...), IS EMPTY and IS NOT EMPTY. Values are quoted strings,
+// bare words, numbers, true/false and the functions me(), now() and today(), optionally shifted by a
+// duration (now() - 2w). Field and option names are resolved against the project fields by Compile.
+package boardquery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxQueryLength is the max number of characters in a query
+const MaxQueryLength = 2000
+
+// Error is a syntax or name resolution error at a 1-based character position of the query
+type Error struct {
+	Pos     int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d번째 문자: %s", e.Pos, e.Message)
+}
+
+func errorAt(pos int, format string, args ...interface{}) *Error {
+	return &Error{Pos: pos, Message: fmt.Sprintf(format, args...)}
+}
+
+// ==================== AST ====================
+
+// Query is a parsed query; Where is nil when the query only sorts
+type Query struct {
+	Where   Expr
+	OrderBy []OrderTerm
+}
+
+// Expr is a node of the condition tree: *LogicalExpr, *NotExpr or *Condition
+type Expr interface {
+	exprNode()
+}
+
+// LogicalExpr combines two or more operands with "and" or "or"
+type LogicalExpr struct {
+	Op       string
+	Operands []Expr
+}
+
+// NotExpr negates its operand
+type NotExpr struct {
+	Operand Expr
+}
+
+// Condition compares a field with values
+// Operator is one of = != > >= < <= ~ !~ "in" "not in" "is empty" "is not empty"
+type Condition struct {
+	Field    Name
+	Operator string
+	OpPos    int
+	Values   []Value // One value, a list for in/not in, none for is (not) empty
+}
+
+func (*LogicalExpr) exprNode() {}
+func (*NotExpr) exprNode()     {}
+func (*Condition) exprNode()   {}
+
+// Name is a field name as written in the query
+type Name struct {
+	Text string
+	Pos  int
+}
+
+// ValueKind tells how a value was written
+type ValueKind int
+
+const (
+	ValueString ValueKind = iota // "quoted"
+	ValueWord                    // bare word
+	ValueNumber                  // 3, -1.5
+	ValueFunc                    // me(), now() + 7d
+)
+
+// Value is a literal or function call in a condition
+type Value struct {
+	Kind   ValueKind
+	Text   string  // String/word text, number source text or function name (lower case)
+	Number float64 // ValueNumber only
+	Offset *Offset // ValueFunc only: optional "+ 7d" / "- 2w"
+	Pos    int
+}
+
+// Offset is a signed duration added to a function value, e.g. -2w
+type Offset struct {
+	Amount int
+	Unit   byte // 'd', 'w', 'h' or 'm'
+}
+
+// OrderTerm is one ORDER BY key
+type OrderTerm struct {
+	Field Name
+	Desc  bool
+}
+
+// ==================== Parser ====================
+
+// Parse parses a query
+//
+//	query     := [or] [ORDER BY term {"," term}]
+//	or        := and {OR and}
+//	and       := not {AND not}
+//	not       := NOT not | "(" or ")" | condition
+//	condition := field (op value | [NOT] IN "(" value {"," value} ")" | IS [NOT] (EMPTY|NULL))
+func Parse(input string) (*Query, error) {
+	if utf8.RuneCountInString(input) > MaxQueryLength {
+		return nil, errorAt(MaxQueryLength+1, "쿼리는 %d자를 초과할 수 없습니다", MaxQueryLength)
+	}
+
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	query := &Query{}
+	if !p.peek().is("ORDER") && p.peek().kind != tokEOF {
+		if query.Where, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().is("ORDER") {
+		p.next()
+		if !p.peek().is("BY") {
+			return nil, p.unexpected("ORDER 다음에는 BY가 필요합니다")
+		}
+		p.next()
+		for {
+			field, err := p.parseFieldName()
+			if err != nil {
+				return nil, err
+			}
+			term := OrderTerm{Field: field}
+			if p.peek().is("DESC") {
+				term.Desc = true
+				p.next()
+			} else if p.peek().is("ASC") {
+				p.next()
+			}
+			query.OrderBy = append(query.OrderBy, term)
+
+			if p.peek().kind != tokComma {
+				break
+			}
+			p.next()
+		}
+	}
+
+	if p.peek().kind != tokEOF {
+		return nil, p.unexpected("AND, OR 또는 ORDER BY가 필요합니다")
+	}
+	return query, nil
+}
+
+type parser struct {
+	tokens []token
+	index  int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.index]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.index]
+	if t.kind != tokEOF {
+		p.index++
+	}
+	return t
+}
+
+// unexpected reports the current token with a hint about what was expected
+func (p *parser) unexpected(hint string) *Error {
+	t := p.peek()
+	if t.kind == tokEOF {
+		return errorAt(t.pos, "쿼리가 예상보다 일찍 끝났습니다: %s", hint)
+	}
+	return errorAt(t.pos, "예상하지 못한 '%s': %s", t.text, hint)
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	return p.parseLogical("OR", "or", p.parseAnd)
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	return p.parseLogical("AND", "and", p.parseNot)
+}
+
+// parseLogical parses operand {keyword operand} into one flat LogicalExpr
+func (p *parser) parseLogical(keyword, op string, operand func() (Expr, error)) (Expr, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	if !p.peek().is(keyword) {
+		return first, nil
+	}
+
+	expr := &LogicalExpr{Op: op, Operands: []Expr{first}}
+	for p.peek().is(keyword) {
+		p.next()
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		expr.Operands = append(expr.Operands, next)
+	}
+	return expr, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if p.peek().is("NOT") {
+		p.next()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{Operand: operand}, nil
+	}
+
+	if p.peek().kind == tokLParen {
+		open := p.next()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().kind != tokRParen {
+			return nil, errorAt(p.peek().pos, "%d번째 문자의 '('가 닫히지 않았습니다", open.pos)
+		}
+		p.next()
+		return expr, nil
+	}
+
+	return p.parseCondition()
+}
+
+func (p *parser) parseCondition() (Expr, error) {
+	field, err := p.parseFieldName()
+	if err != nil {
+		return nil, err
+	}
+	condition := &Condition{Field: field, OpPos: p.peek().pos}
+
+	switch t := p.peek(); {
+	case t.kind == tokOperator:
+		p.next()
+		condition.Operator = t.text
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		condition.Values = []Value{value}
+
+	case t.is("IN") || t.is("NOT"):
+		p.next()
+		condition.Operator = "in"
+		if t.is("NOT") {
+			if !p.peek().is("IN") {
+				return nil, p.unexpected("NOT 다음에는 IN이 필요합니다")
+			}
+			p.next()
+			condition.Operator = "not in"
+		}
+		if condition.Values, err = p.parseValueList(); err != nil {
+			return nil, err
+		}
+
+	case t.is("IS"):
+		p.next()
+		condition.Operator = "is empty"
+		if p.peek().is("NOT") {
+			p.next()
+			condition.Operator = "is not empty"
+		}
+		if !p.peek().is("EMPTY") && !p.peek().is("NULL") {
+			return nil, p.unexpected("IS 다음에는 EMPTY 또는 NOT EMPTY가 필요합니다")
+		}
+		p.next()
+
+	default:
+		return nil, p.unexpected(fmt.Sprintf("'%s' 다음에는 연산자(=, !=, >, <, ~, IN, IS)가 필요합니다", field.Text))
+	}
+
+	// field = EMPTY is the same as field IS EMPTY
+	if (condition.Operator == "=" || condition.Operator == "!=") && condition.Values[0].Kind == ValueWord &&
+		(strings.EqualFold(condition.Values[0].Text, "EMPTY") || strings.EqualFold(condition.Values[0].Text, "NULL")) {
+		if condition.Operator == "=" {
+			condition.Operator = "is empty"
+		} else {
+			condition.Operator = "is not empty"
+		}
+		condition.Values = nil
+	}
+
+	return condition, nil
+}
+
+// parseFieldName reads a bare or quoted field name
+func (p *parser) parseFieldName() (Name, error) {
+	t := p.peek()
+	if t.kind == tokString || (t.kind == tokIdent && !isKeyword(t.text)) {
+		p.next()
+		return Name{Text: t.text, Pos: t.pos}, nil
+	}
+	return Name{}, p.unexpected("필드 이름이 필요합니다")
+}
+
+func (p *parser) parseValueList() ([]Value, error) {
+	if p.peek().kind != tokLParen {
+		return nil, p.unexpected("IN 다음에는 (값, ...) 목록이 필요합니다")
+	}
+	open := p.next()
+
+	var values []Value
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		if p.peek().kind == tokComma {
+			p.next()
+			continue
+		}
+		if p.peek().kind != tokRParen {
+			return nil, errorAt(p.peek().pos, "%d번째 문자의 '('가 닫히지 않았습니다", open.pos)
+		}
+		p.next()
+		return values, nil
+	}
+}
+
+func (p *parser) parseValue() (Value, error) {
+	t := p.peek()
+
+	switch t.kind {
+	case tokString:
+		p.next()
+		return Value{Kind: ValueString, Text: t.text, Pos: t.pos}, nil
+
+	case tokNumber, tokMinus:
+		p.next()
+		sign := 1.0
+		text := t.text
+		if t.kind == tokMinus {
+			if p.peek().kind != tokNumber {
+				return Value{}, p.unexpected("'-' 다음에는 숫자가 필요합니다")
+			}
+			sign = -1
+			text = "-" + p.next().text
+		}
+		n, err := strconv.ParseFloat(strings.TrimPrefix(text, "-"), 64)
+		if err != nil {
+			return Value{}, errorAt(t.pos, "잘못된 숫자입니다: %s", text)
+		}
+		return Value{Kind: ValueNumber, Text: text, Number: sign * n, Pos: t.pos}, nil
+
+	case tokIdent:
+		p.next()
+		if p.peek().kind != tokLParen {
+			if isKeyword(t.text) && !t.is("EMPTY") && !t.is("NULL") {
+				return Value{}, errorAt(t.pos, "'%s' 자리에 값이 필요합니다 (키워드와 같은 값은 따옴표로 감싸세요)", t.text)
+			}
+			return Value{Kind: ValueWord, Text: t.text, Pos: t.pos}, nil
+		}
+
+		// Function call: name() [+|- duration]
+		p.next()
+		if p.peek().kind != tokRParen {
+			return Value{}, p.unexpected("함수는 인자를 받지 않습니다: " + t.text + "()")
+		}
+		p.next()
+		value := Value{Kind: ValueFunc, Text: strings.ToLower(t.text), Pos: t.pos}
+
+		if sign := p.peek(); sign.kind == tokPlus || sign.kind == tokMinus {
+			p.next()
+			duration := p.peek()
+			if duration.kind != tokDuration {
+				return Value{}, p.unexpected("기간이 필요합니다 (예: 7d, 2w, 3h, 30m)")
+			}
+			p.next()
+			amount, err := strconv.Atoi(duration.text[:len(duration.text)-1])
+			if err != nil {
+				return Value{}, errorAt(duration.pos, "잘못된 기간입니다: %s", duration.text)
+			}
+			if sign.kind == tokMinus {
+				amount = -amount
+			}
+			value.Offset = &Offset{Amount: amount, Unit: duration.text[len(duration.text)-1]}
+		}
+		return value, nil
+	}
+
+	return Value{}, p.unexpected("값이 필요합니다")
+}
@@ -0,0 +1,108 @@
+package boardquery
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_PrecedenceAndOrderBy(t *testing.T) {
+	query, err := Parse(`stage = "진행중" AND (assignee = me() OR NOT title ~ bug) OR due < now() + 7d ORDER BY importance DESC, title`)
+	require.NoError(t, err)
+
+	// OR binds looser than AND
+	or, ok := query.Where.(*LogicalExpr)
+	require.True(t, ok)
+	assert.Equal(t, "or", or.Op)
+	require.Len(t, or.Operands, 2)
+
+	and := or.Operands[0].(*LogicalExpr)
+	assert.Equal(t, "and", and.Op)
+	stage := and.Operands[0].(*Condition)
+	assert.Equal(t, "stage", stage.Field.Text)
+	assert.Equal(t, "=", stage.Operator)
+	assert.Equal(t, Value{Kind: ValueString, Text: "진행중", Pos: 9}, stage.Values[0])
+
+	inner := and.Operands[1].(*LogicalExpr)
+	assert.Equal(t, "or", inner.Op)
+	_, isNot := inner.Operands[1].(*NotExpr)
+	assert.True(t, isNot)
+
+	due := or.Operands[1].(*Condition)
+	assert.Equal(t, "<", due.Operator)
+	assert.Equal(t, ValueFunc, due.Values[0].Kind)
+	assert.Equal(t, "now", due.Values[0].Text)
+	assert.Equal(t, &Offset{Amount: 7, Unit: 'd'}, due.Values[0].Offset)
+
+	require.Len(t, query.OrderBy, 2)
+	assert.Equal(t, OrderTerm{Field: Name{Text: "importance", Pos: 85}, Desc: true}, query.OrderBy[0])
+	assert.False(t, query.OrderBy[1].Desc)
+}
+
+func TestParse_ListsAndEmpty(t *testing.T) {
+	query, err := Parse(`labels not in ("a b", c) and "Due Date" is not empty and owner = EMPTY and score >= -1.5`)
+	require.NoError(t, err)
+
+	operands := query.Where.(*LogicalExpr).Operands
+	require.Len(t, operands, 4)
+
+	labels := operands[0].(*Condition)
+	assert.Equal(t, "not in", labels.Operator)
+	require.Len(t, labels.Values, 2)
+	assert.Equal(t, "a b", labels.Values[0].Text)
+	assert.Equal(t, ValueWord, labels.Values[1].Kind)
+
+	dueDate := operands[1].(*Condition)
+	assert.Equal(t, "Due Date", dueDate.Field.Text)
+	assert.Equal(t, "is not empty", dueDate.Operator)
+
+	owner := operands[2].(*Condition)
+	assert.Equal(t, "is empty", owner.Operator)
+	assert.Empty(t, owner.Values)
+
+	score := operands[3].(*Condition)
+	assert.Equal(t, -1.5, score.Values[0].Number)
+}
+
+func TestParse_OrderByOnly(t *testing.T) {
+	query, err := Parse("ORDER BY due")
+	require.NoError(t, err)
+	assert.Nil(t, query.Where)
+	assert.Len(t, query.OrderBy, 1)
+
+	query, err = Parse("   ")
+	require.NoError(t, err)
+	assert.Nil(t, query.Where)
+	assert.Empty(t, query.OrderBy)
+}
+
+func TestParse_SyntaxErrorPositions(t *testing.T) {
+	tests := []struct {
+		query string
+		pos   int
+	}{
+		{`stage = "진행중`, 9},                // Unterminated string
+		{`stage = `, 9},                    // Missing value
+		{`stage "a"`, 7},                   // Missing operator
+		{`(stage = a AND b = c`, 21},       // Unclosed parenthesis
+		{`stage = a AND`, 14},              // Missing condition after AND
+		{`stage = a b = c`, 11},            // Missing AND/OR
+		{`stage in a`, 10},                 // IN without a list
+		{`stage is full`, 10},              // IS without EMPTY
+		{`due < now() + 7x`, 15},           // Bad duration
+		{`stage = a ORDER importance`, 17}, // ORDER without BY
+		{`stage # a`, 7},                   // Unknown character
+		{`AND = a`, 1},                     // Keyword as field
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := Parse(tt.query)
+			var queryErr *Error
+			require.True(t, errors.As(err, &queryErr), "expected a query error, got %v", err)
+			assert.Equal(t, tt.pos, queryErr.Pos, queryErr.Message)
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"board-service/internal/domain"
 	"board-service/internal/repository"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -32,6 +34,9 @@ type ProjectAuthorizer interface {
 
 	// CanDelete checks if user can delete a resource (is author OR has ADMIN+ role)
 	CanDelete(userID, projectID, authorID uuid.UUID) (bool, error)
+
+	// RequireFieldEditor checks if user is a member allowed to edit the field's values
+	RequireFieldEditor(userID uuid.UUID, field *domain.ProjectField) (*domain.ProjectMember, error)
 }
 
 type projectAuthorizer struct {
@@ -184,6 +189,20 @@ func (a *projectAuthorizer) CanDelete(userID, projectID, authorID uuid.UUID) (bo
 	return member.Role.Level >= 50, nil
 }
 
+// RequireFieldEditor checks if user is a member whose role may edit the field's values
+func (a *projectAuthorizer) RequireFieldEditor(userID uuid.UUID, field *domain.ProjectField) (*domain.ProjectMember, error) {
+	member, err := a.RequireMember(userID, field.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckFieldEditor(member.Role, field); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
 // ==================== Role Level Constants ====================
 
 const (
@@ -209,6 +228,19 @@ func IsOwner(role *domain.Role) bool {
 	return role != nil && role.Name == "OWNER"
 }
 
+// CheckFieldEditor checks a role against the roles allowed to edit a field's values
+// Owners can always edit; the error names the protected field.
+func CheckFieldEditor(role *domain.Role, field *domain.ProjectField) error {
+	if IsOwner(role) || field.AllowsEditBy(role) {
+		return nil
+	}
+	return apperrors.New(
+		apperrors.ErrCodeFieldEditForbidden,
+		fmt.Sprintf("'%s' 필드를 수정할 권한이 없습니다 (허용 역할: %s)", field.Name, strings.Join(field.EditRoles(), ", ")),
+		403,
+	)
+}
+
 // IsMember checks if a role is at least MEMBER
 func IsMember(role *domain.Role) bool {
 	return role != nil && role.Level >= RoleLevelMember
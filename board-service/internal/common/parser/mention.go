@@ -0,0 +1,32 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// mentionPattern matches "@token" at the start of the text or after a non-word character,
+// so e-mail addresses (user@example.com) are not treated as mentions
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@.])@([\p{L}\p{N}_.\-]+)`)
+
+// ExtractMentions returns the unique @mention tokens in text, in order of appearance
+// A token is either a nickname (@홍길동, @john.doe) or a user UUID (@3fa85f64-...)
+func ExtractMentions(text string) []string {
+	matches := mentionPattern.FindAllStringSubmatch(text, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(matches))
+	tokens := make([]string, 0, len(matches))
+	for _, m := range matches {
+		// Trailing punctuation belongs to the sentence, not the nickname ("@john." / "@john-")
+		token := strings.TrimRight(m[1], ".-")
+		if token == "" || seen[token] {
+			continue
+		}
+		seen[token] = true
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
@@ -0,0 +1,30 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractMentions(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{"no mentions", "그냥 댓글입니다", nil},
+		{"single nickname", "@john 확인 부탁드려요", []string{"john"}},
+		{"korean nickname", "리뷰 부탁 @홍길동", []string{"홍길동"}},
+		{"uuid token", "cc @3fa85f64-5717-4562-b3fc-2c963f66afa6", []string{"3fa85f64-5717-4562-b3fc-2c963f66afa6"}},
+		{"trailing punctuation", "thanks @john.doe.", []string{"john.doe"}},
+		{"duplicates removed", "@john @jane @john", []string{"john", "jane"}},
+		{"email is not a mention", "mail me at john@example.com", nil},
+		{"inside parentheses", "(@jane) please", []string{"jane"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ExtractMentions(tt.text))
+		})
+	}
+}
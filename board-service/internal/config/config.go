@@ -32,6 +32,24 @@ type Config struct {
 	Log struct {
 		Level string // debug, info, warn, error
 	}
+	Storage struct {
+		Driver    string // local, s3
+		LocalPath string // Root directory of the local driver
+		S3        struct {
+			Endpoint  string // https://s3.ap-northeast-2.amazonaws.com, http://minio:9000
+			Region    string
+			Bucket    string
+			AccessKey string
+			SecretKey string
+		}
+	}
+	Webhook struct {
+		AllowPrivateURLs bool // Allow webhooks to loopback/private addresses (local test receivers only)
+	}
+	Attachment struct {
+		MaxSize      int64    // Max upload size in bytes
+		AllowedTypes []string // Allowed MIME types (detected from the content)
+	}
 }
 
 // Load loads configuration from environment variables
@@ -44,6 +62,12 @@ func Load() (*Config, error) {
 	v.SetDefault("USE_AUTO_MIGRATE", true)
 	v.SetDefault("LOG_LEVEL", "info")
 	v.SetDefault("CORS_ORIGINS", "http://localhost:3000")
+	v.SetDefault("STORAGE_DRIVER", "local")
+	v.SetDefault("STORAGE_LOCAL_PATH", "./data/attachments")
+	v.SetDefault("S3_REGION", "us-east-1")
+	v.SetDefault("WEBHOOK_ALLOW_PRIVATE_URLS", false)
+	v.SetDefault("ATTACHMENT_MAX_SIZE", 10<<20)
+	v.SetDefault("ATTACHMENT_ALLOWED_TYPES", "image/png,image/jpeg,image/gif,image/webp,application/pdf,text/plain,application/zip")
 
 	// Bind environment variables only (no .env file loading)
 	v.AutomaticEnv()
@@ -90,5 +114,28 @@ func Load() (*Config, error) {
 	// Logging
 	cfg.Log.Level = v.GetString("LOG_LEVEL")
 
+	// Attachment storage
+	cfg.Storage.Driver = v.GetString("STORAGE_DRIVER")
+	cfg.Storage.LocalPath = v.GetString("STORAGE_LOCAL_PATH")
+	cfg.Storage.S3.Endpoint = v.GetString("S3_ENDPOINT")
+	cfg.Storage.S3.Region = v.GetString("S3_REGION")
+	cfg.Storage.S3.Bucket = v.GetString("S3_BUCKET")
+	cfg.Storage.S3.AccessKey = v.GetString("S3_ACCESS_KEY")
+	cfg.Storage.S3.SecretKey = v.GetString("S3_SECRET_KEY")
+	if cfg.Storage.Driver != "local" && cfg.Storage.Driver != "s3" {
+		return nil, fmt.Errorf("STORAGE_DRIVER must be local or s3")
+	}
+
+	// Webhooks
+	cfg.Webhook.AllowPrivateURLs = v.GetBool("WEBHOOK_ALLOW_PRIVATE_URLS")
+
+	// Attachments
+	cfg.Attachment.MaxSize = v.GetInt64("ATTACHMENT_MAX_SIZE")
+	for _, t := range strings.Split(v.GetString("ATTACHMENT_ALLOWED_TYPES"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			cfg.Attachment.AllowedTypes = append(cfg.Attachment.AllowedTypes, t)
+		}
+	}
+
 	return cfg, nil
 }
@@ -83,7 +83,18 @@ func autoMigrateAll(db *gorm.DB, logger *zap.Logger) error {
 		&domain.FieldOption{},
 		&domain.BoardFieldValue{},
 		&domain.SavedView{},
-		&domain.UserBoardOrder{}, // Fractional indexing for board ordering in views
+		&domain.UserBoardOrder{},        // Fractional indexing for board ordering in views
+		&domain.SavedViewUserSettings{}, // Per-user table settings of views
+		&domain.BoardActivity{},         // Board activity history (audit trail)
+		&domain.Mention{},               // @mentions inbox
+		&domain.Notification{},          // In-app notifications
+		&domain.NotificationPreference{},
+		&domain.DomainEvent{}, // Transactional outbox
+		&domain.Webhook{},     // Project webhooks
+		&domain.WebhookDelivery{},
+		&domain.BoardLink{},     // Board dependencies (blocks, relates_to, duplicates)
+		&domain.ChecklistItem{}, // Board checklists (subtasks)
+		&domain.Attachment{},    // Board and comment file metadata
 	}
 
 	return db.AutoMigrate(models...)
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// attachmentFileNameMaxLength is the max number of characters kept from an uploaded file name
+const attachmentFileNameMaxLength = 255
+
+// Attachment is a file uploaded to a board or to one of its comments
+// The content lives in object storage under StorageKey; this row only holds metadata.
+type Attachment struct {
+	BaseModel
+	ProjectID   uuid.UUID  `gorm:"type:uuid;not null;index" json:"project_id"`
+	BoardID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"board_id"`
+	CommentID   *uuid.UUID `gorm:"type:uuid;index" json:"comment_id"` // nil: attached to the board itself
+	FileName    string     `gorm:"type:varchar(255);not null" json:"file_name"`
+	ContentType string     `gorm:"type:varchar(100);not null" json:"content_type"`
+	Size        int64      `gorm:"not null" json:"size"`
+	StorageKey  string     `gorm:"type:varchar(500);not null;uniqueIndex:idx_attachments_storage_key" json:"storage_key"`
+	UploadedBy  uuid.UUID  `gorm:"type:uuid;not null" json:"uploaded_by"`
+}
+
+func (Attachment) TableName() string {
+	return "attachments"
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// NewAttachment creates attachment metadata for a board (commentID nil) or a comment of the board
+// The ID is assigned up front because it is part of the storage key.
+func NewAttachment(board *Board, commentID *uuid.UUID, fileName, contentType string, size int64, uploadedBy uuid.UUID) (*Attachment, error) {
+	name := sanitizeFileName(fileName)
+	if name == "" {
+		return nil, NewValidationError("file", "파일 이름이 필요합니다")
+	}
+	if size <= 0 {
+		return nil, NewValidationError("file", "빈 파일은 업로드할 수 없습니다")
+	}
+
+	attachment := &Attachment{
+		BaseModel:   BaseModel{ID: uuid.New()},
+		ProjectID:   board.ProjectID,
+		BoardID:     board.ID,
+		CommentID:   commentID,
+		FileName:    name,
+		ContentType: contentType,
+		Size:        size,
+		UploadedBy:  uploadedBy,
+	}
+	attachment.StorageKey = fmt.Sprintf("projects/%s/boards/%s/%s", board.ProjectID, board.ID, attachment.ID)
+	return attachment, nil
+}
+
+// sanitizeFileName keeps only the base name without control characters, truncated to 255 characters
+func sanitizeFileName(fileName string) string {
+	name := path.Base(strings.ReplaceAll(fileName, "\\", "/"))
+	if name == "." || name == "/" {
+		return ""
+	}
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+
+	if utf8.RuneCountInString(name) > attachmentFileNameMaxLength {
+		name = string([]rune(name)[:attachmentFileNameMaxLength])
+	}
+	return name
+}
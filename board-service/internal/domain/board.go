@@ -14,6 +14,7 @@ type Board struct {
 	AssigneeID         *uuid.UUID `gorm:"type:uuid;index" json:"assignee_id"`
 	CreatedBy          uuid.UUID  `gorm:"type:uuid;not null;index" json:"created_by"`
 	DueDate            *time.Time `gorm:"index" json:"due_date"`
+	StartDate          *time.Time `gorm:"index" json:"start_date"` // When work starts; boards with both dates span a period on the timeline
 
 	// Custom fields cache (JSONB for fast filtering with GIN index)
 	// All custom fields (stages, roles, importance, etc.) are stored here
@@ -82,6 +83,42 @@ func (b *Board) ClearDueDate() {
 	b.UpdatedAt = time.Now()
 }
 
+// SetStartDate sets the start date for the board
+func (b *Board) SetStartDate(startDate time.Time) {
+	b.StartDate = &startDate
+	b.UpdatedAt = time.Now()
+}
+
+// ClearStartDate removes the start date from the board
+func (b *Board) ClearStartDate() {
+	b.StartDate = nil
+	b.UpdatedAt = time.Now()
+}
+
+// ValidateSchedule checks that the board does not start after it is due
+func (b *Board) ValidateSchedule() error {
+	if b.StartDate != nil && b.DueDate != nil && b.StartDate.After(*b.DueDate) {
+		return NewValidationError("startDate", "시작일은 마감일보다 늦을 수 없습니다")
+	}
+	return nil
+}
+
+// Reschedule moves the board to start at start, keeping its duration
+// A board with only a due date (a milestone) moves its due date instead.
+func (b *Board) Reschedule(start time.Time) {
+	switch {
+	case b.StartDate == nil && b.DueDate != nil:
+		b.DueDate = &start
+	default:
+		if b.StartDate != nil && b.DueDate != nil {
+			due := b.DueDate.Add(start.Sub(*b.StartDate))
+			b.DueDate = &due
+		}
+		b.StartDate = &start
+	}
+	b.UpdatedAt = time.Now()
+}
+
 // IsCreatedBy returns true if the board was created by the given user
 func (b *Board) IsCreatedBy(userID uuid.UUID) bool {
 	return b.CreatedBy == userID
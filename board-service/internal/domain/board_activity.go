@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ActivityAction represents the kind of mutation recorded in a board's history
+type ActivityAction string
+
+const (
+	ActivityBoardUpdated      ActivityAction = "board.updated"
+	ActivityBoardMoved        ActivityAction = "board.moved"
+	ActivityBoardDeleted      ActivityAction = "board.deleted"
+	ActivityFieldValueChanged ActivityAction = "field_value.changed"
+	ActivityLinkAdded         ActivityAction = "link.added"
+	ActivityLinkRemoved       ActivityAction = "link.removed"
+)
+
+// BoardActivity is an immutable audit trail entry for a board
+// One row per mutation; per-field diffs are stored in Changes (JSON array of ActivityChange)
+type BoardActivity struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	BoardID   uuid.UUID      `gorm:"type:uuid;not null;index" json:"board_id"`
+	ProjectID uuid.UUID      `gorm:"type:uuid;not null;index" json:"project_id"`
+	ActorID   uuid.UUID      `gorm:"type:uuid;not null;index" json:"actor_id"`
+	Action    ActivityAction `gorm:"type:varchar(50);not null" json:"action"`
+	Changes   string         `gorm:"type:jsonb;default:'[]'" json:"changes"`
+	CreatedAt time.Time      `gorm:"autoCreateTime;index" json:"created_at"`
+}
+
+func (BoardActivity) TableName() string {
+	return "board_activities"
+}
+
+// BeforeCreate generates UUID before creating a record (no BaseModel: activities are append-only)
+func (a *BoardActivity) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
+
+// ActivityChange describes a single field change within an activity
+// Field is a built-in column name (title, content, assignee, dueDate) or a custom field ID
+type ActivityChange struct {
+	Field     string      `json:"field"`
+	FieldName string      `json:"fieldName,omitempty"`
+	OldValue  interface{} `json:"oldValue"`
+	NewValue  interface{} `json:"newValue"`
+}
+
+// ActivityOptionValue is how select option values are recorded, so history keeps the label
+// even after the option is renamed or deleted
+type ActivityOptionValue struct {
+	OptionID string `json:"optionId"`
+	Label    string `json:"label"`
+}
+
+// NewBoardActivity creates an activity entry for the given board
+func NewBoardActivity(board *Board, actorID uuid.UUID, action ActivityAction, changes []ActivityChange) (*BoardActivity, error) {
+	if changes == nil {
+		changes = []ActivityChange{}
+	}
+
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoardActivity{
+		BoardID:   board.ID,
+		ProjectID: board.ProjectID,
+		ActorID:   actorID,
+		Action:    action,
+		Changes:   string(changesJSON),
+	}, nil
+}
+
+// GetChanges parses the stored per-field diffs
+func (a *BoardActivity) GetChanges() ([]ActivityChange, error) {
+	var changes []ActivityChange
+	if a.Changes == "" {
+		return changes, nil
+	}
+	if err := json.Unmarshal([]byte(a.Changes), &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// DiffBoard compares the built-in columns of two board snapshots
+// Returns an empty slice if nothing changed
+func DiffBoard(before, after *Board) []ActivityChange {
+	changes := []ActivityChange{}
+
+	if before.Title != after.Title {
+		changes = append(changes, ActivityChange{Field: "title", OldValue: before.Title, NewValue: after.Title})
+	}
+	if before.Description != after.Description {
+		changes = append(changes, ActivityChange{Field: "content", OldValue: before.Description, NewValue: after.Description})
+	}
+	if !sameUUID(before.AssigneeID, after.AssigneeID) {
+		changes = append(changes, ActivityChange{Field: "assignee", OldValue: uuidValue(before.AssigneeID), NewValue: uuidValue(after.AssigneeID)})
+	}
+	if !sameTime(before.DueDate, after.DueDate) {
+		changes = append(changes, ActivityChange{Field: "dueDate", OldValue: timeValue(before.DueDate), NewValue: timeValue(after.DueDate)})
+	}
+	if !sameTime(before.StartDate, after.StartDate) {
+		changes = append(changes, ActivityChange{Field: "startDate", OldValue: timeValue(before.StartDate), NewValue: timeValue(after.StartDate)})
+	}
+
+	return changes
+}
+
+func sameUUID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+func uuidValue(id *uuid.UUID) interface{} {
+	if id == nil {
+		return nil
+	}
+	return id.String()
+}
+
+func timeValue(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// BoardLinkType is the kind of relationship between two boards
+// Only blocks, relates_to and duplicates are stored; the "is_*_by" types are
+// the same links seen from the target board.
+type BoardLinkType string
+
+const (
+	BoardLinkBlocks         BoardLinkType = "blocks"
+	BoardLinkIsBlockedBy    BoardLinkType = "is_blocked_by"
+	BoardLinkRelatesTo      BoardLinkType = "relates_to"
+	BoardLinkDuplicates     BoardLinkType = "duplicates"
+	BoardLinkIsDuplicatedBy BoardLinkType = "is_duplicated_by"
+)
+
+// IsValid returns true if the type can be used to create a link
+func (t BoardLinkType) IsValid() bool {
+	switch t {
+	case BoardLinkBlocks, BoardLinkIsBlockedBy, BoardLinkRelatesTo, BoardLinkDuplicates, BoardLinkIsDuplicatedBy:
+		return true
+	}
+	return false
+}
+
+// Inverse returns the type as seen from the other board
+func (t BoardLinkType) Inverse() BoardLinkType {
+	switch t {
+	case BoardLinkBlocks:
+		return BoardLinkIsBlockedBy
+	case BoardLinkIsBlockedBy:
+		return BoardLinkBlocks
+	case BoardLinkDuplicates:
+		return BoardLinkIsDuplicatedBy
+	case BoardLinkIsDuplicatedBy:
+		return BoardLinkDuplicates
+	}
+	return t
+}
+
+// BoardLink is a typed, directed relationship between two boards of the same project
+// "SourceBoardID <LinkType> TargetBoardID", e.g. A blocks B
+type BoardLink struct {
+	BaseModel
+	ProjectID     uuid.UUID     `gorm:"type:uuid;not null;index" json:"project_id"`
+	SourceBoardID uuid.UUID     `gorm:"type:uuid;not null;uniqueIndex:idx_board_link_pair" json:"source_board_id"`
+	TargetBoardID uuid.UUID     `gorm:"type:uuid;not null;index;uniqueIndex:idx_board_link_pair" json:"target_board_id"`
+	LinkType      BoardLinkType `gorm:"type:varchar(20);not null;uniqueIndex:idx_board_link_pair" json:"link_type"`
+	CreatedBy     uuid.UUID     `gorm:"type:uuid;not null" json:"created_by"`
+}
+
+func (BoardLink) TableName() string {
+	return "board_links"
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// NewBoardLink creates a link from board to other as requested by the user
+// Inverse types are normalized so that "B is_blocked_by A" is stored as "A blocks B".
+func NewBoardLink(board, other *Board, linkType BoardLinkType, createdBy uuid.UUID) (*BoardLink, error) {
+	if !linkType.IsValid() {
+		return nil, NewValidationError("linkType", "지원하지 않는 링크 유형입니다")
+	}
+	if board.ID == other.ID {
+		return nil, NewValidationError("targetBoardId", "보드 자신과는 링크할 수 없습니다")
+	}
+	if board.ProjectID != other.ProjectID {
+		return nil, NewValidationError("targetBoardId", "같은 프로젝트의 보드만 링크할 수 있습니다")
+	}
+
+	source, target := board.ID, other.ID
+	if linkType == BoardLinkIsBlockedBy || linkType == BoardLinkIsDuplicatedBy {
+		source, target = target, source
+		linkType = linkType.Inverse()
+	}
+
+	return &BoardLink{
+		ProjectID:     board.ProjectID,
+		SourceBoardID: source,
+		TargetBoardID: target,
+		LinkType:      linkType,
+		CreatedBy:     createdBy,
+	}, nil
+}
+
+// Involves returns true if the board is either end of the link
+func (l *BoardLink) Involves(boardID uuid.UUID) bool {
+	return l.SourceBoardID == boardID || l.TargetBoardID == boardID
+}
+
+// OtherBoardID returns the board on the other end of the link
+func (l *BoardLink) OtherBoardID(boardID uuid.UUID) uuid.UUID {
+	if l.SourceBoardID == boardID {
+		return l.TargetBoardID
+	}
+	return l.SourceBoardID
+}
+
+// TypeFor returns the link type as seen from the given board
+func (l *BoardLink) TypeFor(boardID uuid.UUID) BoardLinkType {
+	if l.SourceBoardID == boardID {
+		return l.LinkType
+	}
+	return l.LinkType.Inverse()
+}
+
+// SameAs returns true if both links describe the same relationship
+// relates_to is symmetric, so A relates_to B equals B relates_to A.
+func (l *BoardLink) SameAs(other *BoardLink) bool {
+	if l.LinkType != other.LinkType {
+		return false
+	}
+	if l.SourceBoardID == other.SourceBoardID && l.TargetBoardID == other.TargetBoardID {
+		return true
+	}
+	return l.LinkType == BoardLinkRelatesTo &&
+		l.SourceBoardID == other.TargetBoardID && l.TargetBoardID == other.SourceBoardID
+}
+
+// FindBlockingCycle checks whether adding link would close a cycle in the blocking graph
+// blocking holds the existing "blocks" links of the project. When a cycle is found the
+// returned path starts and ends at the link's source board, e.g. [C, A, B, C] for
+// "A blocks B", "B blocks C" and the new "C blocks A".
+func FindBlockingCycle(blocking []BoardLink, link *BoardLink) []uuid.UUID {
+	if link.LinkType != BoardLinkBlocks {
+		return nil
+	}
+
+	next := make(map[uuid.UUID][]uuid.UUID)
+	for _, l := range blocking {
+		if l.LinkType == BoardLinkBlocks {
+			next[l.SourceBoardID] = append(next[l.SourceBoardID], l.TargetBoardID)
+		}
+	}
+
+	// Breadth-first search from the new target back to the new source
+	start, goal := link.TargetBoardID, link.SourceBoardID
+	parent := map[uuid.UUID]uuid.UUID{start: start}
+	queue := []uuid.UUID{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current == goal {
+			path := []uuid.UUID{goal}
+			for node := goal; node != start; node = parent[node] {
+				path = append(path, parent[node])
+			}
+			// path is goal <- ... <- start; reverse and prepend the new link's source
+			cycle := []uuid.UUID{link.SourceBoardID}
+			for i := len(path) - 1; i >= 0; i-- {
+				cycle = append(cycle, path[i])
+			}
+			return cycle
+		}
+
+		for _, n := range next[current] {
+			if _, seen := parent[n]; !seen {
+				parent[n] = current
+				queue = append(queue, n)
+			}
+		}
+	}
+
+	return nil
+}
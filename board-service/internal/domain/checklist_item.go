@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// checklistTitleMaxLength is the max number of characters of a checklist item title
+const checklistTitleMaxLength = 500
+
+// ChecklistItem is a small step (subtask) inside a board card
+// Items are ordered by Position (fractional indexing, see util.GeneratePositionBetween)
+type ChecklistItem struct {
+	BaseModel
+	BoardID     uuid.UUID  `gorm:"type:uuid;not null;index:idx_checklist_board_position" json:"board_id"`
+	ProjectID   uuid.UUID  `gorm:"type:uuid;not null;index" json:"project_id"`
+	Title       string     `gorm:"type:varchar(500);not null" json:"title"`
+	IsDone      bool       `gorm:"not null" json:"is_done"`
+	AssigneeID  *uuid.UUID `gorm:"type:uuid;index" json:"assignee_id"`
+	Position    string     `gorm:"type:varchar(255);not null;index:idx_checklist_board_position" json:"position"`
+	CreatedBy   uuid.UUID  `gorm:"type:uuid;not null" json:"created_by"`
+	CompletedAt *time.Time `json:"completed_at"`
+	CompletedBy *uuid.UUID `gorm:"type:uuid" json:"completed_by"`
+}
+
+func (ChecklistItem) TableName() string {
+	return "checklist_items"
+}
+
+// ChecklistProgress is the done/total summary of a board's checklist
+type ChecklistProgress struct {
+	Done  int
+	Total int
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// NewChecklistItem creates an open checklist item at the given position
+func NewChecklistItem(board *Board, title string, assigneeID *uuid.UUID, position string, createdBy uuid.UUID) (*ChecklistItem, error) {
+	item := &ChecklistItem{
+		BoardID:    board.ID,
+		ProjectID:  board.ProjectID,
+		AssigneeID: assigneeID,
+		Position:   position,
+		CreatedBy:  createdBy,
+	}
+	if err := item.UpdateTitle(title); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// UpdateTitle updates the item title with validation
+func (i *ChecklistItem) UpdateTitle(title string) error {
+	if title == "" {
+		return NewValidationError("title", "체크리스트 항목 제목은 필수입니다")
+	}
+	if utf8.RuneCountInString(title) > checklistTitleMaxLength {
+		return NewValidationError("title", "체크리스트 항목 제목은 500자를 초과할 수 없습니다")
+	}
+	i.Title = title
+	return nil
+}
+
+// SetDone marks the item as done or reopens it (idempotent)
+func (i *ChecklistItem) SetDone(done bool, userID uuid.UUID) {
+	if i.IsDone == done {
+		return
+	}
+	i.IsDone = done
+	if done {
+		now := time.Now()
+		i.CompletedAt = &now
+		i.CompletedBy = &userID
+	} else {
+		i.CompletedAt = nil
+		i.CompletedBy = nil
+	}
+}
+
+// Assign sets or clears (nil) the assignee of the item
+func (i *ChecklistItem) Assign(assigneeID *uuid.UUID) {
+	i.AssigneeID = assigneeID
+}
+
+// MoveTo changes the position of the item within the checklist
+func (i *ChecklistItem) MoveTo(position string) {
+	i.Position = position
+}
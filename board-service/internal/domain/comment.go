@@ -1,18 +1,25 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// MaxCommentDepth is the deepest nesting level allowed for replies (root comment = 0)
+const MaxCommentDepth = 2
+
 // Comment represents a comment on a Board card.
+// Replies reference their parent comment via ParentID (nil for root comments).
 type Comment struct {
 	BaseModel // ID, CreatedAt, UpdatedAt, IsDeleted 포함
-	Content   string    `gorm:"type:text;not null"`
-	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
-	BoardID   uuid.UUID `gorm:"type:uuid;not null;index"`
-	Board     Board     `gorm:"foreignKey:BoardID"`
+	Content   string     `gorm:"type:text;not null"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index"`
+	BoardID   uuid.UUID  `gorm:"type:uuid;not null;index"`
+	ParentID  *uuid.UUID `gorm:"type:uuid;index"`
+	Depth     int        `gorm:"not null;default:0"`
+	Board     Board      `gorm:"foreignKey:BoardID"`
 }
 
 // TableName specifies the table name for the Comment model.
@@ -32,6 +39,29 @@ func (c *Comment) BelongsToBoard(boardID uuid.UUID) bool {
 	return c.BoardID == boardID
 }
 
+// IsReply returns true if the comment is a reply to another comment
+func (c *Comment) IsReply() bool {
+	return c.ParentID != nil
+}
+
+// CanBeRepliedTo returns true if a reply to this comment stays within MaxCommentDepth
+func (c *Comment) CanBeRepliedTo() bool {
+	return c.Depth < MaxCommentDepth
+}
+
+// ReplyTo attaches the comment to a parent comment on the same board
+func (c *Comment) ReplyTo(parent *Comment) error {
+	if !parent.BelongsToBoard(c.BoardID) {
+		return NewValidationError("parentId", "부모 댓글이 같은 보드에 속하지 않습니다")
+	}
+	if !parent.CanBeRepliedTo() {
+		return NewValidationError("parentId", fmt.Sprintf("답글은 최대 %d단계까지만 작성할 수 있습니다", MaxCommentDepth))
+	}
+	c.ParentID = &parent.ID
+	c.Depth = parent.Depth + 1
+	return nil
+}
+
 // UpdateContent updates the comment content with validation
 func (c *Comment) UpdateContent(content string) error {
 	if content == "" {
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DomainEventStatus is the delivery state of an outbox entry
+type DomainEventStatus string
+
+const (
+	DomainEventPending   DomainEventStatus = "pending"   // Waiting to be published (or retried)
+	DomainEventPublished DomainEventStatus = "published" // Delivered to the publisher
+	DomainEventFailed    DomainEventStatus = "failed"    // Gave up after the max number of attempts
+)
+
+// Aggregate types recorded in the outbox
+const (
+	AggregateBoard   = "board"
+	AggregateProject = "project"
+)
+
+// Domain event types published to other services
+const (
+	EventTypeBoardCreated   = "board.created"
+	EventTypeBoardUpdated   = "board.updated"
+	EventTypeBoardMoved     = "board.moved"
+	EventTypeBoardDeleted   = "board.deleted"
+	EventTypeProjectCreated = "project.created"
+)
+
+// DomainEvent is a transactional outbox entry
+// It is written in the same transaction as the change it describes and published
+// afterwards by the outbox relay (at-least-once: consumers must dedupe by ID).
+type DomainEvent struct {
+	ID            uuid.UUID         `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	AggregateType string            `gorm:"type:varchar(50);not null;index:idx_domain_events_aggregate" json:"aggregate_type"`
+	AggregateID   uuid.UUID         `gorm:"type:uuid;not null;index:idx_domain_events_aggregate" json:"aggregate_id"`
+	EventType     string            `gorm:"type:varchar(100);not null" json:"event_type"`
+	ProjectID     uuid.UUID         `gorm:"type:uuid;not null" json:"project_id"`
+	ActorID       uuid.UUID         `gorm:"type:uuid;not null" json:"actor_id"`
+	Payload       string            `gorm:"type:jsonb;not null" json:"payload"`
+	Status        DomainEventStatus `gorm:"type:varchar(20);not null;index:idx_domain_events_pending" json:"status"`
+	Attempts      int               `gorm:"not null" json:"attempts"`
+	LastError     string            `gorm:"type:text" json:"last_error"`
+	NextAttemptAt time.Time         `gorm:"not null;index:idx_domain_events_pending" json:"next_attempt_at"`
+	PublishedAt   *time.Time        `json:"published_at"`
+	CreatedAt     time.Time         `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (DomainEvent) TableName() string {
+	return "domain_events"
+}
+
+// BeforeCreate generates UUID before creating a record (no BaseModel: outbox rows are never soft deleted)
+func (e *DomainEvent) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// NewDomainEvent creates a pending outbox entry with the payload serialized as JSON
+func NewDomainEvent(aggregateType string, aggregateID, projectID, actorID uuid.UUID, eventType string, payload interface{}) (*DomainEvent, error) {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DomainEvent{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		ProjectID:     projectID,
+		ActorID:       actorID,
+		Payload:       string(payloadJSON),
+		Status:        DomainEventPending,
+		NextAttemptAt: time.Now(),
+	}, nil
+}
+
+// NewBoardDomainEvent creates an outbox entry for a board change with the board snapshot as payload
+func NewBoardDomainEvent(eventType string, board *Board, actorID uuid.UUID) (*DomainEvent, error) {
+	return NewDomainEvent(AggregateBoard, board.ID, board.ProjectID, actorID, eventType, board)
+}
+
+// MarkAsPublished records a successful delivery
+func (e *DomainEvent) MarkAsPublished(now time.Time) {
+	e.Status = DomainEventPublished
+	e.Attempts++
+	e.LastError = ""
+	e.PublishedAt = &now
+}
+
+// MarkAsFailed records a failed delivery and schedules the next attempt
+// Once maxAttempts is reached the event is parked as failed and no longer retried.
+func (e *DomainEvent) MarkAsFailed(err error, now time.Time, backoff time.Duration, maxAttempts int) {
+	e.Attempts++
+	e.LastError = err.Error()
+	e.NextAttemptAt = now.Add(backoff)
+	if e.Attempts >= maxAttempts {
+		e.Status = DomainEventFailed
+	}
+}
@@ -10,6 +10,7 @@ type FieldOption struct {
 	Color        string    `gorm:"type:varchar(7)" json:"color"` // HEX color: #RRGGBB
 	Description  string    `gorm:"type:text" json:"description"`
 	DisplayOrder int       `gorm:"not null;default:0" json:"display_order"`
+	IsArchived   bool      `gorm:"not null;default:false" json:"is_archived"` // Hidden from pickers and kanban columns; existing values keep it
 }
 
 func (FieldOption) TableName() string {
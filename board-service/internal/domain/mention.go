@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// MentionSourceType identifies where a mention was written
+type MentionSourceType string
+
+const (
+	MentionSourceComment MentionSourceType = "comment"
+	MentionSourceBoard   MentionSourceType = "board"
+)
+
+// mentionExcerptLength is the max number of characters kept from the source text
+const mentionExcerptLength = 200
+
+// Mention records that a user was @mentioned in a comment or board description
+// One row per (source, mentioned user); editing the source does not duplicate mentions
+type Mention struct {
+	BaseModel
+	ProjectID       uuid.UUID         `gorm:"type:uuid;not null;index" json:"project_id"`
+	BoardID         uuid.UUID         `gorm:"type:uuid;not null;index" json:"board_id"`
+	SourceType      MentionSourceType `gorm:"type:varchar(20);not null" json:"source_type"`
+	SourceID        uuid.UUID         `gorm:"type:uuid;not null;uniqueIndex:idx_mention_source_user" json:"source_id"`
+	MentionedUserID uuid.UUID         `gorm:"type:uuid;not null;index;uniqueIndex:idx_mention_source_user" json:"mentioned_user_id"`
+	MentionedBy     uuid.UUID         `gorm:"type:uuid;not null" json:"mentioned_by"`
+	Excerpt         string            `gorm:"type:text" json:"excerpt"`
+	IsRead          bool              `gorm:"default:false;index" json:"is_read"`
+	ReadAt          *time.Time        `json:"read_at"`
+}
+
+func (Mention) TableName() string {
+	return "mentions"
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// NewMention creates an unread mention with an excerpt of the source text
+func NewMention(board *Board, sourceType MentionSourceType, sourceID, mentionedUserID, mentionedBy uuid.UUID, text string) *Mention {
+	return &Mention{
+		ProjectID:       board.ProjectID,
+		BoardID:         board.ID,
+		SourceType:      sourceType,
+		SourceID:        sourceID,
+		MentionedUserID: mentionedUserID,
+		MentionedBy:     mentionedBy,
+		Excerpt:         excerpt(text, mentionExcerptLength),
+	}
+}
+
+// IsFor returns true if the mention belongs to the given user's inbox
+func (m *Mention) IsFor(userID uuid.UUID) bool {
+	return m.MentionedUserID == userID
+}
+
+// MarkAsRead marks the mention as read (idempotent)
+func (m *Mention) MarkAsRead() {
+	if m.IsRead {
+		return
+	}
+	now := time.Now()
+	m.IsRead = true
+	m.ReadAt = &now
+}
+
+func excerpt(text string, maxLen int) string {
+	if utf8.RuneCountInString(text) <= maxLen {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:maxLen]) + "…"
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationType identifies what triggered a notification
+type NotificationType string
+
+const (
+	NotificationBoardAssigned       NotificationType = "board.assigned"
+	NotificationBoardDueDateChanged NotificationType = "board.due_date_changed"
+	NotificationCommentCreated      NotificationType = "comment.created"
+	NotificationMentioned           NotificationType = "mention"
+	NotificationJoinRequestDecided  NotificationType = "join_request.decided"
+	NotificationMemberRoleChanged   NotificationType = "member.role_changed"
+)
+
+// notificationDetailLength is the max number of characters kept in the detail text
+const notificationDetailLength = 200
+
+// Notification is an in-app notification delivered to a single recipient
+type Notification struct {
+	BaseModel
+	UserID     uuid.UUID        `gorm:"type:uuid;not null;index" json:"user_id"` // Recipient
+	ProjectID  uuid.UUID        `gorm:"type:uuid;not null;index" json:"project_id"`
+	BoardID    *uuid.UUID       `gorm:"type:uuid;index" json:"board_id"`
+	Type       NotificationType `gorm:"type:varchar(50);not null" json:"type"`
+	ActorID    uuid.UUID        `gorm:"type:uuid;not null" json:"actor_id"`
+	ResourceID *uuid.UUID       `gorm:"type:uuid" json:"resource_id"` // comment, join request or member ID
+	Title      string           `gorm:"type:varchar(255)" json:"title"`
+	Detail     string           `gorm:"type:text" json:"detail"`
+	IsRead     bool             `gorm:"default:false;index" json:"is_read"`
+	ReadAt     *time.Time       `json:"read_at"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// NotificationPreference holds a user's notification settings for a project
+// A missing row means every notification type is enabled
+type NotificationPreference struct {
+	BaseModel
+	UserID       uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_notification_pref_user_project" json:"user_id"`
+	ProjectID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_notification_pref_user_project" json:"project_id"`
+	Assignments  bool      `gorm:"not null" json:"assignments"`
+	DueDates     bool      `gorm:"not null" json:"due_dates"`
+	Comments     bool      `gorm:"not null" json:"comments"`
+	Mentions     bool      `gorm:"not null" json:"mentions"`
+	JoinRequests bool      `gorm:"not null" json:"join_requests"`
+	RoleChanges  bool      `gorm:"not null" json:"role_changes"`
+}
+
+func (NotificationPreference) TableName() string {
+	return "notification_preferences"
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// NewNotification creates an unread notification for a recipient
+func NewNotification(userID, projectID, actorID uuid.UUID, notificationType NotificationType, title string) *Notification {
+	return &Notification{
+		UserID:    userID,
+		ProjectID: projectID,
+		ActorID:   actorID,
+		Type:      notificationType,
+		Title:     title,
+	}
+}
+
+// NewBoardChangeNotification returns the assignee's notification for a new assignment or a due date change
+// before is nil for a newly created board; returns nil if there is nothing to notify
+func NewBoardChangeNotification(before, after *Board, actorID uuid.UUID) *Notification {
+	if !after.IsAssigned() {
+		return nil
+	}
+
+	var n *Notification
+	switch {
+	case before == nil || !sameUUID(before.AssigneeID, after.AssigneeID):
+		n = NewNotification(*after.AssigneeID, after.ProjectID, actorID, NotificationBoardAssigned, after.Title)
+	case !sameTime(before.DueDate, after.DueDate):
+		n = NewNotification(*after.AssigneeID, after.ProjectID, actorID, NotificationBoardDueDateChanged, after.Title)
+		if after.DueDate != nil {
+			n.SetDetail(after.DueDate.Format("2006-01-02"))
+		}
+	default:
+		return nil
+	}
+
+	n.BoardID = &after.ID
+	return n
+}
+
+// SetDetail sets the detail text (e.g. comment excerpt, new role name), truncating long text
+func (n *Notification) SetDetail(text string) {
+	n.Detail = excerpt(text, notificationDetailLength)
+}
+
+// IsFor returns true if the notification belongs to the given user
+func (n *Notification) IsFor(userID uuid.UUID) bool {
+	return n.UserID == userID
+}
+
+// MarkAsRead marks the notification as read (idempotent)
+func (n *Notification) MarkAsRead() {
+	if n.IsRead {
+		return
+	}
+	now := time.Now()
+	n.IsRead = true
+	n.ReadAt = &now
+}
+
+// DefaultNotificationPreference returns the preference used when a user has not configured the project
+func DefaultNotificationPreference(userID, projectID uuid.UUID) *NotificationPreference {
+	return &NotificationPreference{
+		UserID:       userID,
+		ProjectID:    projectID,
+		Assignments:  true,
+		DueDates:     true,
+		Comments:     true,
+		Mentions:     true,
+		JoinRequests: true,
+		RoleChanges:  true,
+	}
+}
+
+// Allows returns true if the given notification type is enabled
+func (p *NotificationPreference) Allows(notificationType NotificationType) bool {
+	switch notificationType {
+	case NotificationBoardAssigned:
+		return p.Assignments
+	case NotificationBoardDueDateChanged:
+		return p.DueDates
+	case NotificationCommentCreated:
+		return p.Comments
+	case NotificationMentioned:
+		return p.Mentions
+	case NotificationJoinRequestDecided:
+		return p.JoinRequests
+	case NotificationMemberRoleChanged:
+		return p.RoleChanges
+	default:
+		return true
+	}
+}
@@ -1,6 +1,11 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
 
 // FieldType represents the data type of a custom field
 type FieldType string
@@ -36,6 +41,55 @@ func (ProjectField) TableName() string {
 	return "project_fields"
 }
 
+// EditRoles returns the roles allowed to edit the field's values; nil when every member may
+func (f *ProjectField) EditRoles() []string {
+	if f.CanEditRoles == nil || *f.CanEditRoles == "" {
+		return nil
+	}
+	return strings.Split(*f.CanEditRoles, ",")
+}
+
+// SetEditRoles stores the roles allowed to edit the field's values; no roles lets every member edit
+func (f *ProjectField) SetEditRoles(roles []string) {
+	if len(roles) == 0 {
+		f.CanEditRoles = nil
+		return
+	}
+	joined := strings.Join(roles, ",")
+	f.CanEditRoles = &joined
+}
+
+// AllowsEditBy reports whether a member with the role may edit the field's values
+// An entry of CanEditRoles is a role name (case-insensitive) or a minimum role level such as "50".
+func (f *ProjectField) AllowsEditBy(role *Role) bool {
+	roles := f.EditRoles()
+	if len(roles) == 0 {
+		return true
+	}
+	if role == nil {
+		return false
+	}
+	for _, entry := range roles {
+		entry = strings.TrimSpace(entry)
+		if level, err := strconv.Atoi(entry); err == nil {
+			if role.Level >= level {
+				return true
+			}
+			continue
+		}
+		if strings.EqualFold(entry, role.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Relative default values resolved when a board is created
+const (
+	DefaultValueToday   = "today"   // Date fields: the creation date
+	DefaultValueCreator = "creator" // User fields: the board's author
+)
+
 // FieldConfig represents type-specific configuration
 // This is parsed from/to the Config JSON string
 type FieldConfig struct {
@@ -58,8 +112,10 @@ type FieldConfig struct {
 	MaxUsers             *int  `json:"max_users,omitempty"`
 	ProjectMembersOnly   *bool `json:"project_members_only,omitempty"`
 
-	// Checkbox
-	DefaultValue *bool `json:"default_value,omitempty"`
+	// Default value of new boards, in the shape field values are set with
+	// (checkbox: bool, number: number, multi-value types: array). Date fields accept
+	// DefaultValueToday and user fields DefaultValueCreator.
+	DefaultValue interface{} `json:"default_value,omitempty"`
 
 	// URL
 	EnablePreview *bool `json:"enable_preview,omitempty"`
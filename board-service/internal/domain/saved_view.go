@@ -1,6 +1,10 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
 
 // SavedView represents a user-defined view with filters, sorting, and grouping
 type SavedView struct {
@@ -14,18 +18,262 @@ type SavedView struct {
 	Filters        string     `gorm:"type:text;default:'{}'" json:"filters"`       // JSON stored as string
 	SortBy         *string    `gorm:"type:varchar(255)" json:"sort_by"`
 	SortDirection  string     `gorm:"type:varchar(4);default:'asc'" json:"sort_direction"` // 'asc' or 'desc'
+	Sorts          string     `gorm:"type:text;not null;default:'[]'" json:"sorts"`         // JSON []SortKey; views saved before multi-key sorts only have SortBy
 	GroupByFieldID *uuid.UUID `gorm:"type:uuid" json:"group_by_field_id"`
+	GroupBy        string     `gorm:"type:varchar(64);not null;default:''" json:"group_by"`        // Group key: custom field ID or built-in key; views saved before only have GroupByFieldID
+	GroupByBucket  string     `gorm:"type:varchar(10);not null;default:''" json:"group_by_bucket"` // 'day', 'week' or 'month' for date grouping
+	SwimlaneBy     string     `gorm:"type:varchar(64);not null;default:''" json:"swimlane_by"`     // Secondary grouping into rows (same keys as GroupBy)
+	SwimlaneBucket string     `gorm:"type:varchar(10);not null;default:''" json:"swimlane_bucket"` // Date bucket of SwimlaneBy
+	CalendarField  string     `gorm:"type:varchar(64);not null;default:''" json:"calendar_field"`  // Calendar date: '' for due_date, start_date or a date/datetime field ID
+	CalendarStart  string     `gorm:"type:varchar(64);not null;default:''" json:"calendar_start"`  // start_date or a date/datetime field ID where multi-day spans start
+	ViewType       string     `gorm:"type:varchar(16);not null;default:'kanban'" json:"view_type"` // Layout: 'kanban', 'table', 'calendar' or 'timeline'
+	TableSettings  string     `gorm:"type:text;not null;default:'{}'" json:"table_settings"`       // JSON TableSettings
 }
 
 func (SavedView) TableName() string {
 	return "saved_views"
 }
 
+// SortKey is one sort key of a view
+// Field is a built-in sort key, a custom field ID or SortFieldPosition (the user's manual order in the view).
+type SortKey struct {
+	Field     string `json:"field"`
+	Direction string `json:"direction"` // 'asc' (default) or 'desc'
+}
+
+const (
+	SortDirectionAsc  = "asc"
+	SortDirectionDesc = "desc"
+
+	// SortFieldPosition sorts by the user's UserBoardOrder position in the view
+	SortFieldPosition = "position"
+
+	// MaxViewSortKeys is the max number of sort keys of a view
+	MaxViewSortKeys = 5
+)
+
+// IsDesc reports whether the key sorts in descending order
+func (k SortKey) IsDesc() bool {
+	return k.Direction == SortDirectionDesc
+}
+
+// SortKeys returns the sort keys of the view, falling back to SortBy/SortDirection for older views
+func (v *SavedView) SortKeys() ([]SortKey, error) {
+	if v.Sorts != "" && v.Sorts != "[]" {
+		var keys []SortKey
+		if err := json.Unmarshal([]byte(v.Sorts), &keys); err != nil {
+			return nil, err
+		}
+		return keys, nil
+	}
+	if v.SortBy != nil && *v.SortBy != "" {
+		return []SortKey{{Field: *v.SortBy, Direction: v.SortDirection}}, nil
+	}
+	return nil, nil
+}
+
+// SetSortKeys stores the sort keys; SortBy/SortDirection mirror the first key for older clients
+func (v *SavedView) SetSortKeys(keys []SortKey) error {
+	if keys == nil {
+		keys = []SortKey{}
+	}
+	sorts, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	v.Sorts = string(sorts)
+
+	if len(keys) == 0 {
+		v.SortBy = nil
+		v.SortDirection = SortDirectionAsc
+		return nil
+	}
+	sortBy := keys[0].Field
+	v.SortBy = &sortBy
+	v.SortDirection = SortDirectionAsc
+	if keys[0].IsDesc() {
+		v.SortDirection = SortDirectionDesc
+	}
+	return nil
+}
+
+// ViewGrouping is how a view groups its boards
+type ViewGrouping struct {
+	Key    string // Custom field ID or built-in key (assignee, author, due_date, created_at, updated_at)
+	Bucket string // Date bucket: 'day', 'week' or 'month' (date fields only)
+}
+
+// Date buckets for grouping by a date
+const (
+	GroupBucketDay   = "day"
+	GroupBucketWeek  = "week"
+	GroupBucketMonth = "month"
+)
+
+// Grouping returns how the view groups boards, or nil when it does not
+func (v *SavedView) Grouping() *ViewGrouping {
+	if v.GroupBy != "" {
+		return &ViewGrouping{Key: v.GroupBy, Bucket: v.GroupByBucket}
+	}
+	if v.GroupByFieldID != nil {
+		return &ViewGrouping{Key: v.GroupByFieldID.String()}
+	}
+	return nil
+}
+
+// SetGrouping stores the grouping; GroupByFieldID mirrors custom field keys for older clients
+func (v *SavedView) SetGrouping(grouping *ViewGrouping) {
+	v.GroupBy, v.GroupByBucket, v.GroupByFieldID = "", "", nil
+	if grouping == nil {
+		return
+	}
+	v.GroupBy = grouping.Key
+	v.GroupByBucket = grouping.Bucket
+	if fieldID, err := uuid.Parse(grouping.Key); err == nil {
+		v.GroupByFieldID = &fieldID
+	}
+}
+
+// Swimlanes returns how the view groups boards into rows, or nil when it has no swimlanes
+func (v *SavedView) Swimlanes() *ViewGrouping {
+	if v.SwimlaneBy == "" {
+		return nil
+	}
+	return &ViewGrouping{Key: v.SwimlaneBy, Bucket: v.SwimlaneBucket}
+}
+
+// SetSwimlanes stores the secondary grouping
+func (v *SavedView) SetSwimlanes(swimlanes *ViewGrouping) {
+	v.SwimlaneBy, v.SwimlaneBucket = "", ""
+	if swimlanes != nil {
+		v.SwimlaneBy, v.SwimlaneBucket = swimlanes.Key, swimlanes.Bucket
+	}
+}
+
+// CalendarDueDate is the calendar date of views that do not name a date field
+const CalendarDueDate = "due_date"
+
+// CalendarDateKey returns the date a calendar places boards on: CalendarDueDate or a date/datetime field ID
+func (v *SavedView) CalendarDateKey() string {
+	if v.CalendarField == "" {
+		return CalendarDueDate
+	}
+	return v.CalendarField
+}
+
+// View types (layouts) of a saved view
+const (
+	ViewTypeKanban   = "kanban"
+	ViewTypeTable    = "table"
+	ViewTypeCalendar = "calendar"
+	ViewTypeTimeline = "timeline"
+)
+
+// Row densities of a table view
+const (
+	TableDensityCompact     = "compact"
+	TableDensityDefault     = "default"
+	TableDensityComfortable = "comfortable"
+)
+
+// TableSettings is the table layout of a view
+// A user's override only replaces the parts it sets (see Merge).
+type TableSettings struct {
+	Columns       []TableColumn `json:"columns,omitempty"`        // Visible columns in display order
+	FrozenColumns *int          `json:"frozen_columns,omitempty"` // Leading columns that stay in place when scrolling
+	Density       string        `json:"density,omitempty"`        // 'compact', 'default' or 'comfortable'
+}
+
+// TableColumn is a visible column of a table view
+type TableColumn struct {
+	Key   string `json:"key"`             // Built-in key or custom field ID
+	Width int    `json:"width,omitempty"` // Pixels; 0 keeps the client's default width
+}
+
+// Merge returns the settings with the parts override sets replacing them
+func (t TableSettings) Merge(override TableSettings) TableSettings {
+	if len(override.Columns) > 0 {
+		t.Columns = override.Columns
+	}
+	if override.FrozenColumns != nil {
+		t.FrozenColumns = override.FrozenColumns
+	}
+	if override.Density != "" {
+		t.Density = override.Density
+	}
+	return t
+}
+
+// ParseTableSettings reads table settings stored as JSON; empty input is empty settings
+func ParseTableSettings(raw string) (TableSettings, error) {
+	var settings TableSettings
+	if raw == "" {
+		return settings, nil
+	}
+	err := json.Unmarshal([]byte(raw), &settings)
+	return settings, err
+}
+
+// Table returns the table settings of the view
+func (v *SavedView) Table() (TableSettings, error) {
+	return ParseTableSettings(v.TableSettings)
+}
+
+// SetTable stores the table settings of the view
+func (v *SavedView) SetTable(settings TableSettings) error {
+	raw, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+	v.TableSettings = string(raw)
+	return nil
+}
+
 // ViewFilters represents filter configuration
 // This is parsed from/to the Filters JSON string
 type ViewFilters map[string]FilterCondition
 
 type FilterCondition struct {
-	Operator string      `json:"operator"` // 'eq', 'ne', 'in', 'not_in', 'contains', 'gt', 'gte', 'lt', 'lte', 'is_null', 'is_not_null'
+	Operator string      `json:"operator"` // 'eq', 'ne', 'in', 'not_in', 'contains', 'gt', 'gte', 'lt', 'lte', 'between', 'is_null', 'is_not_null'
 	Value    interface{} `json:"value"`
 }
+
+// FilterNode is a node of a boolean filter expression stored in SavedView.Filters
+// A group node has Op ('and', 'or', 'not') and Conditions; a condition node has Field, Operator and Value.
+// Views saved before groups existed store a flat ViewFilters map, which is read as an 'and' group.
+type FilterNode struct {
+	Op         string       `json:"op,omitempty"`
+	Conditions []FilterNode `json:"conditions,omitempty"`
+	Field      string       `json:"field,omitempty"` // Built-in column key or custom field ID
+	Operator   string       `json:"operator,omitempty"`
+	Value      interface{}  `json:"value,omitempty"`
+}
+
+// Filter group operators
+const (
+	FilterGroupAnd = "and"
+	FilterGroupOr  = "or"
+	FilterGroupNot = "not" // Exactly one condition
+)
+
+// IsGroup reports whether the node combines other nodes
+func (n *FilterNode) IsGroup() bool {
+	return n.Op != ""
+}
+
+// Filter operators
+// Which operators a filter accepts depends on the field type (see viewService filter compilation)
+const (
+	FilterOpEq        = "eq"
+	FilterOpNe        = "ne"
+	FilterOpIn        = "in"     // Any of the values (multi-value fields: has any of them)
+	FilterOpNotIn     = "not_in" // None of the values
+	FilterOpContains  = "contains"
+	FilterOpGt        = "gt"
+	FilterOpGte       = "gte"
+	FilterOpLt        = "lt"
+	FilterOpLte       = "lte"
+	FilterOpBetween   = "between" // Inclusive range, value is [from, to]
+	FilterOpIsNull    = "is_null"
+	FilterOpIsNotNull = "is_not_null"
+)
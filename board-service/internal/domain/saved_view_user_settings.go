@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SavedViewUserSettings is one user's override of a view's table settings
+// The view's settings apply to everything the override leaves unset.
+type SavedViewUserSettings struct {
+	ID            uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	ViewID        uuid.UUID `gorm:"type:uuid;not null;index;uniqueIndex:idx_view_user_settings" json:"view_id"`
+	UserID        uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_view_user_settings" json:"user_id"`
+	TableSettings string    `gorm:"type:text;not null;default:'{}'" json:"table_settings"` // JSON TableSettings
+	UpdatedAt     time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (SavedViewUserSettings) TableName() string {
+	return "saved_view_user_settings"
+}
+
+// Table returns the overridden table settings
+func (s *SavedViewUserSettings) Table() (TableSettings, error) {
+	return ParseTableSettings(s.TableSettings)
+}
+
+// SetTable stores the overridden table settings
+func (s *SavedViewUserSettings) SetTable(settings TableSettings) error {
+	raw, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+	s.TableSettings = string(raw)
+	return nil
+}
@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Webhook is a project-level subscription that receives signed event payloads
+type Webhook struct {
+	BaseModel
+	ProjectID uuid.UUID `gorm:"type:uuid;not null;index" json:"project_id"`
+	URL       string    `gorm:"type:varchar(2048);not null" json:"url"`
+	Secret    string    `gorm:"type:varchar(255);not null" json:"-"`   // HMAC-SHA256 signing key
+	Events    string    `gorm:"type:jsonb;default:'[]'" json:"events"` // JSON array of event filters, empty = all events
+	IsActive  bool      `gorm:"not null" json:"is_active"`
+	CreatedBy uuid.UUID `gorm:"type:uuid;not null" json:"created_by"`
+}
+
+func (Webhook) TableName() string {
+	return "webhooks"
+}
+
+// EventFilter returns the subscribed event types (empty means every event)
+func (w *Webhook) EventFilter() []string {
+	var events []string
+	if w.Events == "" {
+		return events
+	}
+	if err := json.Unmarshal([]byte(w.Events), &events); err != nil {
+		return nil
+	}
+	return events
+}
+
+// SetEventFilter replaces the subscribed event types
+func (w *Webhook) SetEventFilter(events []string) error {
+	if events == nil {
+		events = []string{}
+	}
+	eventsJSON, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	w.Events = string(eventsJSON)
+	return nil
+}
+
+// Subscribes reports whether the webhook wants the given event type
+// Filters match exactly ("board.created") or by prefix wildcard ("board.*"); "*" matches everything.
+func (w *Webhook) Subscribes(eventType string) bool {
+	if !w.IsActive || w.IsDeleted {
+		return false
+	}
+
+	filters := w.EventFilter()
+	if len(filters) == 0 {
+		return true
+	}
+
+	for _, filter := range filters {
+		if filter == "*" || filter == eventType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(filter, "*"); ok && strings.HasPrefix(eventType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus is the state of a single webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed" // Gave up after the max number of attempts
+)
+
+// webhookResponseBodyLength is the max number of bytes of the receiver's response kept in the log
+const webhookResponseBodyLength = 1024
+
+// WebhookDelivery is the delivery log of one event to one webhook (retried until it succeeds or gives up)
+type WebhookDelivery struct {
+	ID             uuid.UUID             `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	WebhookID      uuid.UUID             `gorm:"type:uuid;not null;index;uniqueIndex:idx_webhook_deliveries_event,where:redelivery_of IS NULL" json:"webhook_id"`
+	ProjectID      uuid.UUID             `gorm:"type:uuid;not null" json:"project_id"`
+	EventID        string                `gorm:"type:varchar(64);not null;uniqueIndex:idx_webhook_deliveries_event,where:redelivery_of IS NULL" json:"event_id"`
+	EventType      string                `gorm:"type:varchar(100);not null" json:"event_type"`
+	Payload        string                `gorm:"type:jsonb;not null" json:"payload"`
+	Status         WebhookDeliveryStatus `gorm:"type:varchar(20);not null;index:idx_webhook_deliveries_pending" json:"status"`
+	Attempts       int                   `gorm:"not null" json:"attempts"`
+	ResponseStatus int                   `json:"response_status"`
+	ResponseBody   string                `gorm:"type:text" json:"response_body"`
+	LastError      string                `gorm:"type:text" json:"last_error"`
+	DurationMs     int64                 `json:"duration_ms"`
+	NextAttemptAt  time.Time             `gorm:"not null;index:idx_webhook_deliveries_pending" json:"next_attempt_at"`
+	DeliveredAt    *time.Time            `json:"delivered_at"`
+	RedeliveryOf   *uuid.UUID            `gorm:"type:uuid" json:"redelivery_of"`
+	CreatedAt      time.Time             `gorm:"autoCreateTime;index" json:"created_at"`
+	UpdatedAt      time.Time             `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// BeforeCreate generates UUID before creating a record (no BaseModel: delivery logs are never soft deleted)
+func (d *WebhookDelivery) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// NewWebhookDelivery creates a pending delivery of an event payload to the webhook
+func NewWebhookDelivery(webhook *Webhook, eventID, eventType, payload string) *WebhookDelivery {
+	return &WebhookDelivery{
+		WebhookID:     webhook.ID,
+		ProjectID:     webhook.ProjectID,
+		EventID:       eventID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        WebhookDeliveryPending,
+		NextAttemptAt: time.Now(),
+	}
+}
+
+// Redeliver creates a new pending delivery with the same event and payload
+func (d *WebhookDelivery) Redeliver() *WebhookDelivery {
+	original := d.ID
+	return &WebhookDelivery{
+		WebhookID:     d.WebhookID,
+		ProjectID:     d.ProjectID,
+		EventID:       d.EventID,
+		EventType:     d.EventType,
+		Payload:       d.Payload,
+		Status:        WebhookDeliveryPending,
+		NextAttemptAt: time.Now(),
+		RedeliveryOf:  &original,
+	}
+}
+
+// RecordResponse stores the receiver's response of the latest attempt
+func (d *WebhookDelivery) RecordResponse(status int, body []byte, duration time.Duration) {
+	if len(body) > webhookResponseBodyLength {
+		body = body[:webhookResponseBodyLength]
+	}
+	d.ResponseStatus = status
+	d.ResponseBody = string(body)
+	d.DurationMs = duration.Milliseconds()
+}
+
+// MarkAsSucceeded records a successful attempt
+func (d *WebhookDelivery) MarkAsSucceeded(now time.Time) {
+	d.Status = WebhookDeliverySucceeded
+	d.Attempts++
+	d.LastError = ""
+	d.DeliveredAt = &now
+}
+
+// MarkAsFailed records a failed attempt and schedules the next one
+// Once maxAttempts is reached the delivery is given up and only a manual redelivery sends it again.
+func (d *WebhookDelivery) MarkAsFailed(err error, now time.Time, backoff time.Duration, maxAttempts int) {
+	d.Attempts++
+	d.LastError = err.Error()
+	d.NextAttemptAt = now.Add(backoff)
+	if d.Attempts >= maxAttempts {
+		d.Status = WebhookDeliveryFailed
+	}
+}
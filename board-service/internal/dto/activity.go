@@ -0,0 +1,38 @@
+package dto
+
+import "time"
+
+// ==================== Board Activity DTOs ====================
+
+// GetBoardActivitiesRequest represents pagination params for a board's activity history
+type GetBoardActivitiesRequest struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ActivityChangeResponse represents a single field diff
+// Field is a built-in column (title, content, assignee, dueDate, startDate) or a custom field ID
+type ActivityChangeResponse struct {
+	Field     string      `json:"field"`
+	FieldName string      `json:"fieldName,omitempty"`
+	OldValue  interface{} `json:"oldValue"`
+	NewValue  interface{} `json:"newValue"`
+}
+
+// BoardActivityResponse represents one entry of a board's activity history
+type BoardActivityResponse struct {
+	ActivityID string                   `json:"activityId"`
+	BoardID    string                   `json:"boardId"`
+	Action     string                   `json:"action"` // board.updated, board.moved, board.deleted, field_value.changed
+	Actor      UserInfo                 `json:"actor"`
+	Changes    []ActivityChangeResponse `json:"changes"`
+	CreatedAt  time.Time                `json:"createdAt"`
+}
+
+// PaginatedBoardActivitiesResponse represents a page of board activities (newest first)
+type PaginatedBoardActivitiesResponse struct {
+	Activities []BoardActivityResponse `json:"activities"`
+	Total      int64                   `json:"total"`
+	Page       int                     `json:"page"`
+	Limit      int                     `json:"limit"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// ==================== Attachment DTOs ====================
+
+// AttachmentResponse represents the metadata of an uploaded file
+type AttachmentResponse struct {
+	AttachmentID string    `json:"attachmentId"`
+	BoardID      string    `json:"boardId"`
+	CommentID    *string   `json:"commentId"` // null when attached to the board itself
+	FileName     string    `json:"fileName"`
+	ContentType  string    `json:"contentType"`
+	Size         int64     `json:"size"`
+	UploadedBy   string    `json:"uploadedBy"`
+	DownloadURL  string    `json:"downloadUrl"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
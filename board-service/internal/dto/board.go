@@ -16,6 +16,11 @@ type CreateBoardRequest struct {
 
 	AssigneeID   *string  `json:"assigneeId" binding:"omitempty,uuid"`
 	DueDate      *string  `json:"dueDate" binding:"omitempty"` // ISO 8601 format
+	StartDate    *string  `json:"startDate" binding:"omitempty"` // ISO 8601 format, not after dueDate
+
+	// Initial custom field values by field ID, as single values or arrays for multi-value fields.
+	// Fields left out get their configured default.
+	FieldValues map[string]interface{} `json:"fieldValues"`
 }
 
 type UpdateBoardRequest struct {
@@ -29,6 +34,15 @@ type UpdateBoardRequest struct {
 
 	AssigneeID   *string  `json:"assigneeId" binding:"omitempty,uuid"`
 	DueDate      *string  `json:"dueDate" binding:"omitempty"`
+	StartDate    *string  `json:"startDate" binding:"omitempty"`
+
+	// Custom field values to replace by field ID; null or an empty array clears a field
+	FieldValues map[string]interface{} `json:"fieldValues"`
+}
+
+// RescheduleBoardRequest moves a board on the timeline; start and due date shift together
+type RescheduleBoardRequest struct {
+	StartDate string `json:"startDate" binding:"required"` // ISO 8601; a board with only a due date moves its due date here
 }
 
 type GetBoardsRequest struct {
@@ -52,11 +66,14 @@ type BoardResponse struct {
 	Assignee      *UserInfo                  `json:"assignee"`
 	Author        UserInfo                   `json:"author"`
 	DueDate       *time.Time                 `json:"dueDate"`
+	StartDate     *time.Time                 `json:"startDate"`
 	CreatedAt     time.Time                  `json:"createdAt"`
 	UpdatedAt     time.Time                  `json:"updatedAt"`
 	CustomFields  map[string]interface{}     `json:"customFields,omitempty"`  // Parsed custom_fields_cache (legacy)
 	FieldValues   []FieldValueWithInfo       `json:"fieldValues,omitempty"`   // Field values with field metadata
 	Position      string                     `json:"position,omitempty"`       // Board position in view
+	Links         []BoardLinkSummary         `json:"links,omitempty"`          // Links to other boards, seen from this board
+	Checklist     *ChecklistProgress         `json:"checklist,omitempty"`      // Checklist progress (only when the board has items)
 }
 
 type UserInfo struct {
@@ -79,9 +96,13 @@ type PaginatedBoardsResponse struct {
 type MoveBoardRequest struct {
 	ViewID         string  `json:"viewId" binding:"required,uuid"`
 	GroupByFieldID string  `json:"groupByFieldId" binding:"required,uuid"` // Which field is used for grouping
-	NewFieldValue  string  `json:"newFieldValue" binding:"required,uuid"`   // New option_id (destination column)
+	NewFieldValue  string  `json:"newFieldValue" binding:"omitempty,uuid"`  // New option_id (destination column); "" for the "(none)" column
 	BeforePosition *string `json:"beforePosition"`                           // Position of board before insertion point (optional)
 	AfterPosition  *string `json:"afterPosition"`                            // Position of board after insertion point (optional)
+
+	// Target swimlane (row), when the view has swimlanes
+	SwimlaneBy       string  `json:"swimlaneBy" binding:"omitempty,max=64"` // Swimlane field ID or "assignee"
+	NewSwimlaneValue *string `json:"newSwimlaneValue"`                      // New option_id or user ID; "" for the "(none)" swimlane
 }
 
 // MoveBoardResponse represents the result of a board move operation
@@ -90,4 +111,6 @@ type MoveBoardResponse struct {
 	NewFieldValue string `json:"newFieldValue"`
 	NewPosition   string `json:"newPosition"` // New fractional index position
 	Message       string `json:"message"`
+
+	NewSwimlaneValue *string `json:"newSwimlaneValue,omitempty"`
 }
@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+// ==================== Board Link DTOs ====================
+
+// CreateBoardLinkRequest links the board in the path to another board of the same project
+// LinkType is read from the path board's side: "A blocks B" is created on A, "B is_blocked_by A" on B.
+type CreateBoardLinkRequest struct {
+	TargetBoardID string `json:"targetBoardId" binding:"required,uuid"`
+	LinkType      string `json:"linkType" binding:"required,oneof=blocks is_blocked_by relates_to duplicates is_duplicated_by"`
+}
+
+// BoardLinkSummary is a link as seen from one board (embedded in BoardResponse)
+type BoardLinkSummary struct {
+	LinkID   string `json:"linkId"`
+	LinkType string `json:"linkType"` // blocks, is_blocked_by, relates_to, duplicates, is_duplicated_by
+	BoardID  string `json:"boardId"`  // The board on the other end
+	Title    string `json:"title"`
+}
+
+// BoardLinkResponse represents a link with its metadata
+type BoardLinkResponse struct {
+	BoardLinkSummary
+	CreatedBy string    `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -0,0 +1,52 @@
+package dto
+
+import "time"
+
+// ==================== Checklist DTOs ====================
+
+// CreateChecklistItemRequest represents a new checklist item (appended to the end of the checklist)
+type CreateChecklistItemRequest struct {
+	Title      string  `json:"title" binding:"required,max=500"`
+	AssigneeID *string `json:"assigneeId" binding:"omitempty,uuid"`
+}
+
+// UpdateChecklistItemRequest represents a partial update of a checklist item
+// Omitted fields keep their current value; an empty assigneeId clears the assignee
+type UpdateChecklistItemRequest struct {
+	Title      *string `json:"title" binding:"omitempty,min=1,max=500"`
+	IsDone     *bool   `json:"isDone"`
+	AssigneeID *string `json:"assigneeId"`
+}
+
+// MoveChecklistItemRequest moves an item between two neighbours (fractional indexing)
+type MoveChecklistItemRequest struct {
+	BeforePosition *string `json:"beforePosition"` // Position of the item before the insertion point (optional)
+	AfterPosition  *string `json:"afterPosition"`  // Position of the item after the insertion point (optional)
+}
+
+// ChecklistItemResponse represents a checklist item
+type ChecklistItemResponse struct {
+	ItemID      string     `json:"itemId"`
+	BoardID     string     `json:"boardId"`
+	Title       string     `json:"title"`
+	IsDone      bool       `json:"isDone"`
+	AssigneeID  *string    `json:"assigneeId"`
+	Position    string     `json:"position"`
+	CreatedBy   string     `json:"createdBy"`
+	CompletedAt *time.Time `json:"completedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// ChecklistProgress is the done/total summary of a board's checklist
+type ChecklistProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+// ChecklistResponse represents the checklist of a board
+type ChecklistResponse struct {
+	BoardID  string                  `json:"boardId"`
+	Items    []ChecklistItemResponse `json:"items"`
+	Progress ChecklistProgress       `json:"progress"`
+}
@@ -7,9 +7,16 @@ import (
 )
 
 // CreateCommentRequest defines the structure for creating a new comment.
+// ParentID is optional; when set, the comment is created as a reply.
 type CreateCommentRequest struct {
-	BoardID uuid.UUID `json:"boardId" binding:"required"`
-	Content  string    `json:"content" binding:"required"`
+	BoardID  uuid.UUID  `json:"boardId" binding:"required"`
+	ParentID *uuid.UUID `json:"parentId"`
+	Content  string     `json:"content" binding:"required"`
+}
+
+// CreateReplyRequest defines the structure for replying to a comment.
+type CreateReplyRequest struct {
+	Content string `json:"content" binding:"required"`
 }
 
 // UpdateCommentRequest defines the structure for updating a comment.
@@ -18,12 +25,17 @@ type UpdateCommentRequest struct {
 }
 
 // CommentResponse defines the structure for a comment response.
+// Replies are nested under their parent; ReplyCount includes all nested replies.
 type CommentResponse struct {
-	ID          uuid.UUID `json:"commentId"`
-	UserID      uuid.UUID `json:"userId"`
-	UserName    string    `json:"userName"`
-	UserAvatar  string    `json:"userAvatar"`
-	Content     string    `json:"content"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID          uuid.UUID         `json:"commentId"`
+	UserID      uuid.UUID         `json:"userId"`
+	UserName    string            `json:"userName"`
+	UserAvatar  string            `json:"userAvatar"`
+	Content     string            `json:"content"`
+	ParentID    *uuid.UUID        `json:"parentId"`
+	Depth       int               `json:"depth"`
+	ReplyCount  int               `json:"replyCount"`
+	Replies     []CommentResponse `json:"replies,omitempty"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
 }
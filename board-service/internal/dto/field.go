@@ -28,6 +28,49 @@ type UpdateFieldOrderRequest struct {
 	FieldOrders []FieldOrder `json:"fieldOrders" binding:"required,min=1,dive"`
 }
 
+// UpdateFieldEditRolesRequest replaces the roles allowed to edit a field's values
+type UpdateFieldEditRolesRequest struct {
+	// Role names (OWNER, ADMIN, MEMBER, ...) or minimum role levels ("50"); empty lets every member edit
+	Roles []string `json:"roles" binding:"max=20,dive,required,max=50"`
+}
+
+// ConvertFieldTypeRequest changes a field's type, converting its existing values
+type ConvertFieldTypeRequest struct {
+	FieldType string `json:"fieldType" binding:"required,oneof=text number single_select multi_select date datetime single_user multi_user checkbox url"`
+}
+
+// FieldConversionPreview summarizes what converting a field's values to another type does
+type FieldConversionPreview struct {
+	FieldID         string   `json:"fieldId"`
+	FromType        string   `json:"fromType"`
+	ToType          string   `json:"toType"`
+	TotalValues     int      `json:"totalValues"`
+	ConvertedValues int      `json:"convertedValues"`
+	LostValues      int      `json:"lostValues"` // Values the new type cannot hold; they are removed
+	AffectedBoards  int      `json:"affectedBoards"`
+	NewOptions      []string `json:"newOptions"`     // Labels of the options created from distinct values, at most MaxNewOptions
+	NewOptionCount  int      `json:"newOptionCount"` // Options the conversion creates; above MaxNewOptions it is rejected
+	MaxNewOptions   int      `json:"maxNewOptions"`
+
+	AffectedViews []ConvertedView `json:"affectedViews"` // Saved views whose filters, sorting or grouping change
+}
+
+// ConvertedView is how a saved view using a converted field changes
+type ConvertedView struct {
+	ViewID           string `json:"viewId"`
+	Name             string `json:"name"`
+	RewrittenFilters int    `json:"rewrittenFilters"` // Conditions whose values are converted (e.g. option IDs to labels)
+	RemovedFilters   int    `json:"removedFilters"`   // Conditions the new type cannot express
+	RemovedSorts     int    `json:"removedSorts"`     // Sort keys on the field the new type cannot sort by
+	RemovedGrouping  bool   `json:"removedGrouping"`  // Grouping or swimlanes by the field
+}
+
+// FieldConversionResponse is the converted field and what happened to its values
+type FieldConversionResponse struct {
+	Field      *FieldResponse         `json:"field"`
+	Conversion FieldConversionPreview `json:"conversion"`
+}
+
 type FieldOrder struct {
 	FieldID      string `json:"fieldId" binding:"required,uuid"`
 	DisplayOrder int    `json:"displayOrder" binding:"min=0"`
@@ -71,6 +114,18 @@ type UpdateOptionOrderRequest struct {
 	OptionOrders []OptionOrder `json:"optionOrders" binding:"required,min=1,dive"`
 }
 
+// MergeOptionRequest merges an option into another option of the same field
+type MergeOptionRequest struct {
+	TargetOptionID string `json:"targetOptionId" binding:"required,uuid"`
+}
+
+// MergeOptionResponse is the option the values were merged into
+type MergeOptionResponse struct {
+	Option         *OptionResponse `json:"option"`
+	AffectedBoards int             `json:"affectedBoards"` // Boards whose value was remapped
+	UpdatedViews   int             `json:"updatedViews"`   // Saved views whose filters referenced the merged option
+}
+
 type OptionOrder struct {
 	OptionID     string `json:"optionId" binding:"required,uuid"`
 	DisplayOrder int    `json:"displayOrder" binding:"min=0"`
@@ -84,6 +139,7 @@ type OptionResponse struct {
 	Color        string    `json:"color"`
 	Description  string    `json:"description"`
 	DisplayOrder int       `json:"displayOrder"`
+	IsArchived   bool      `json:"isArchived"`
 	CreatedAt    time.Time `json:"createdAt"`
 	UpdatedAt    time.Time `json:"updatedAt"`
 }
@@ -149,10 +205,19 @@ type CreateViewRequest struct {
 	Description    string                 `json:"description" binding:"omitempty,max=1000"`
 	IsDefault      bool                   `json:"isDefault"`                  // Default: false (only one default view per project)
 	IsShared       *bool                  `json:"isShared"`                   // Default: true if nil (team-shared view, most common)
-	Filters        map[string]interface{} `json:"filters"`
-	SortBy         string                 `json:"sortBy" binding:"omitempty"`
+	Filters        map[string]interface{} `json:"filters"`                    // {op, conditions} expression tree or legacy flat {fieldKey: {operator, value}}
+	SortBy         string                 `json:"sortBy" binding:"omitempty"` // Single sort key (legacy), ignored when sorts is set
 	SortDirection  string                 `json:"sortDirection" binding:"omitempty,oneof=asc desc"`
-	GroupByFieldID string                 `json:"groupByFieldId" binding:"omitempty,uuid"`
+	Sorts          []ViewSortKey          `json:"sorts" binding:"omitempty,max=5,dive"`
+	GroupByFieldID string                 `json:"groupByFieldId" binding:"omitempty,uuid"` // Legacy, ignored when groupBy is set
+	GroupBy        string                 `json:"groupBy" binding:"omitempty,max=64"`      // Custom field ID or built-in key (assignee, author, due_date, created_at, updated_at)
+	GroupByBucket  string                 `json:"groupByBucket" binding:"omitempty,oneof=day week month"`
+	SwimlaneBy     string                 `json:"swimlaneBy" binding:"omitempty,max=64"` // Rows of a grouped view (same keys as groupBy)
+	SwimlaneBucket string                 `json:"swimlaneBucket" binding:"omitempty,oneof=day week month"`
+	CalendarField  string                 `json:"calendarField" binding:"omitempty,max=64"`      // "due_date" (default), "start_date" or a date/datetime field ID
+	CalendarStart  string                 `json:"calendarStartField" binding:"omitempty,max=64"` // "start_date" or a date/datetime field ID where multi-day spans start
+	ViewType       string                 `json:"viewType" binding:"omitempty,oneof=kanban table calendar timeline"`
+	TableSettings  *TableSettings         `json:"tableSettings"`
 }
 
 // UpdateViewRequest represents a request to update a saved view
@@ -164,7 +229,24 @@ type UpdateViewRequest struct {
 	Filters        map[string]interface{} `json:"filters"`
 	SortBy         *string                `json:"sortBy"`
 	SortDirection  string                 `json:"sortDirection" binding:"omitempty,oneof=asc desc"`
+	Sorts          []ViewSortKey          `json:"sorts" binding:"omitempty,max=5,dive"` // Replaces all sort keys; [] clears them
 	GroupByFieldID *string                `json:"groupByFieldId" binding:"omitempty,uuid"`
+	GroupBy        *string                `json:"groupBy" binding:"omitempty,max=64"` // "" stops grouping
+	GroupByBucket  *string                `json:"groupByBucket" binding:"omitempty,oneof=day week month"`
+	SwimlaneBy     *string                `json:"swimlaneBy" binding:"omitempty,max=64"` // "" removes swimlanes
+	SwimlaneBucket *string                `json:"swimlaneBucket" binding:"omitempty,oneof=day week month"`
+	CalendarField  *string                `json:"calendarField" binding:"omitempty,max=64"`      // "" resets to due_date
+	CalendarStart  *string                `json:"calendarStartField" binding:"omitempty,max=64"` // "" removes spans
+	ViewType       *string                `json:"viewType" binding:"omitempty,oneof=kanban table calendar timeline"`
+	TableSettings  *TableSettings         `json:"tableSettings"` // Replaces the table settings; {} resets them
+}
+
+// ViewSortKey is one sort key of a view
+type ViewSortKey struct {
+	// Built-in key (title, created_at, updated_at, due_date, assignee, author), custom field ID
+	// or "position" for the user's manual order in the view
+	Field     string `json:"field" binding:"required"`
+	Direction string `json:"direction" binding:"omitempty,oneof=asc desc"`
 }
 
 // ViewResponse represents a saved view
@@ -179,11 +261,54 @@ type ViewResponse struct {
 	Filters        map[string]interface{} `json:"filters"`
 	SortBy         string                 `json:"sortBy"`
 	SortDirection  string                 `json:"sortDirection"`
+	Sorts          []ViewSortKey          `json:"sorts"`
 	GroupByFieldID string                 `json:"groupByFieldId"`
+	GroupBy        string                 `json:"groupBy"`
+	GroupByBucket  string                 `json:"groupByBucket,omitempty"`
+	SwimlaneBy     string                 `json:"swimlaneBy"`
+	SwimlaneBucket string                 `json:"swimlaneBucket,omitempty"`
+	CalendarField  string                 `json:"calendarField"`
+	CalendarStart  string                 `json:"calendarStartField,omitempty"`
+	ViewType       string                 `json:"viewType"`
+	TableSettings  TableSettings          `json:"tableSettings"`
 	CreatedAt      time.Time              `json:"createdAt"`
 	UpdatedAt      time.Time              `json:"updatedAt"`
 }
 
+// TableSettings is the table layout of a view, or a user's override of it
+// Columns lists the visible columns in display order; an override replaces only what it sets.
+type TableSettings struct {
+	Columns       []TableColumnSetting `json:"columns" binding:"omitempty,max=100,dive"`
+	FrozenColumns *int                 `json:"frozenColumns" binding:"omitempty,min=0,max=10"` // Leading columns that stay in place when scrolling
+	Density       string               `json:"density" binding:"omitempty,oneof=compact default comfortable"`
+}
+
+// TableColumnSetting is a visible column of a table view
+type TableColumnSetting struct {
+	// Built-in key (title, assignee, author, start_date, due_date, created_at, updated_at) or custom field ID
+	Key   string `json:"key" binding:"required,max=64"`
+	Width int    `json:"width,omitempty" binding:"omitempty,min=40,max=1000"` // Pixels; 0 keeps the default width
+}
+
+// TableLayout is the effective table layout of a view for the current user
+type TableLayout struct {
+	Columns       []ColumnDefinition `json:"columns"` // Visible columns in display order, then the hidden ones
+	FrozenColumns int                `json:"frozenColumns"`
+	Density       string             `json:"density"`
+	Personalized  bool               `json:"personalized"` // The user's own settings override the view's
+}
+
+// ColumnDefinition describes a column of a table view
+type ColumnDefinition struct {
+	Key           string `json:"key"`
+	Name          string `json:"name"`
+	Type          string `json:"type"` // Field type of the values (text, single_user, datetime, ...)
+	IsCustomField bool   `json:"isCustomField"`
+	Visible       bool   `json:"visible"`
+	Width         int    `json:"width,omitempty"`
+	Frozen        bool   `json:"frozen"`
+}
+
 // ApplyViewRequest represents a request to apply a view and get filtered boards
 type ApplyViewRequest struct {
 	ViewID string `form:"viewId" binding:"required,uuid"`
@@ -193,15 +318,138 @@ type ApplyViewRequest struct {
 
 // GroupedBoardsResponse represents boards grouped by a field
 type GroupedBoardsResponse struct {
-	GroupByField FieldResponse              `json:"groupByField"`
+	GroupByField *FieldResponse             `json:"groupByField"` // nil when grouping by a built-in key
+	GroupBy      string                     `json:"groupBy"`
+	Bucket       string                     `json:"bucket,omitempty"`
 	Groups       []BoardGroup               `json:"groups"`
 	Total        int64                      `json:"total"`
+	ViewType     string                     `json:"viewType,omitempty"`
+	Layout       *TableLayout               `json:"layout,omitempty"` // Columns of the view's table layout
 }
 
+// BoardGroup is one group of a grouped view; Count covers the whole filtered set,
+// Boards only its first boards
 type BoardGroup struct {
-	GroupValue interface{}     `json:"groupValue"` // Option or User object
+	Key        string          `json:"key"`        // Option ID, user ID, date, value or "(none)"
+	GroupValue interface{}     `json:"groupValue"` // Option, user or value object; nil for "(none)"
 	Boards     []BoardResponse `json:"boards"`
 	Count      int             `json:"count"`
+	HasMore    bool            `json:"hasMore"`
+	NextCursor string          `json:"nextCursor,omitempty"` // Fetches the group's next boards
+}
+
+// SwimlaneBoardsResponse represents boards grouped into swimlanes (rows) and groups (columns)
+type SwimlaneBoardsResponse struct {
+	GroupByField    *FieldResponse `json:"groupByField"` // nil when grouping by a built-in key
+	GroupBy         string         `json:"groupBy"`
+	Bucket          string         `json:"bucket,omitempty"`
+	SwimlaneByField *FieldResponse `json:"swimlaneByField"` // nil when swimlanes use a built-in key
+	SwimlaneBy      string         `json:"swimlaneBy"`
+	SwimlaneBucket  string         `json:"swimlaneBucket,omitempty"`
+	Columns         []GroupHeader  `json:"columns"`
+	Swimlanes       []Swimlane     `json:"swimlanes"`
+	Total           int64          `json:"total"`
+	ViewType        string         `json:"viewType,omitempty"`
+	Layout          *TableLayout   `json:"layout,omitempty"` // Columns of the view's table layout
+}
+
+// GroupHeader is a column or swimlane of a swimlane view with its count over the whole filtered set
+type GroupHeader struct {
+	Key        string      `json:"key"`
+	GroupValue interface{} `json:"groupValue"`
+	Count      int         `json:"count"`
+}
+
+// Swimlane is one row of a swimlane view
+type Swimlane struct {
+	GroupHeader
+	Cells []BoardGroup `json:"cells"` // One per column, in column order
+}
+
+// ==================== Calendar DTOs ====================
+
+// GetCalendarRequest represents the visible date range of a calendar view
+type GetCalendarRequest struct {
+	From     string `form:"from" binding:"required,datetime=2006-01-02"`
+	To       string `form:"to" binding:"required,datetime=2006-01-02"` // Inclusive
+	TimeZone string `form:"tz" binding:"omitempty,max=64"`             // IANA time zone, default UTC
+}
+
+// CalendarResponse represents the boards of a calendar view bucketed by day
+type CalendarResponse struct {
+	From       string          `json:"from"`
+	To         string          `json:"to"`
+	TimeZone   string          `json:"timeZone"`
+	DateField  string          `json:"dateField"`
+	StartField string          `json:"startField,omitempty"`
+	Days       []CalendarDay   `json:"days"`   // One per day of the range, including empty days
+	Boards     []BoardResponse `json:"boards"` // Boards on the calendar, once each, in view order
+	Truncated  bool            `json:"truncated"`
+}
+
+// CalendarDay is one day of a calendar view
+type CalendarDay struct {
+	Date    string          `json:"date"` // YYYY-MM-DD
+	Entries []CalendarEntry `json:"entries"`
+}
+
+// CalendarEntry places a board on a day; a board spanning several days has an entry on each of them
+type CalendarEntry struct {
+	BoardID string `json:"boardId"`
+	Start   string `json:"start"` // First day of the board's span (YYYY-MM-DD)
+	End     string `json:"end"`   // Last day of the board's span (YYYY-MM-DD)
+	IsStart bool   `json:"isStart"`
+	IsEnd   bool   `json:"isEnd"`
+}
+
+// MoveCalendarBoardRequest represents dragging a board to another day of a calendar view
+// The board's span keeps its length and times of day keep their value.
+type MoveCalendarBoardRequest struct {
+	Date     string `json:"date" binding:"required,datetime=2006-01-02"` // New last day of the span
+	TimeZone string `json:"timeZone" binding:"omitempty,max=64"`         // IANA time zone, default UTC
+}
+
+// MoveCalendarBoardResponse represents a board's dates after a calendar move
+type MoveCalendarBoardResponse struct {
+	BoardID   string  `json:"boardId"`
+	Start     string  `json:"start"` // First day of the board's span (YYYY-MM-DD)
+	End       string  `json:"end"`   // Last day of the board's span (YYYY-MM-DD)
+	Date      *string `json:"date"`  // New value of the calendar date (RFC 3339); nil for a board with only a start
+	StartDate *string `json:"startDate,omitempty"`
+}
+
+// ==================== Timeline DTOs ====================
+
+// GetTimelineRequest represents the visible window of a timeline view
+type GetTimelineRequest struct {
+	From     string `form:"from" binding:"required,datetime=2006-01-02"`
+	To       string `form:"to" binding:"required,datetime=2006-01-02"` // Inclusive
+	TimeZone string `form:"tz" binding:"omitempty,max=64"`             // IANA time zone of the window, default UTC
+}
+
+// TimelineResponse represents the scheduled boards of a view that overlap a window
+type TimelineResponse struct {
+	From         string         `json:"from"`
+	To           string         `json:"to"`
+	TimeZone     string         `json:"timeZone"`
+	GroupByField *FieldResponse `json:"groupByField"` // nil when not grouped or grouped by a built-in key
+	GroupBy      string         `json:"groupBy,omitempty"`
+	Bucket       string         `json:"bucket,omitempty"`
+	Groups       []GroupHeader  `json:"groups"`      // Rows of a grouped view with their number of bars, in display order
+	Bars         []TimelineBar  `json:"bars"`        // In view order
+	Unscheduled  int64          `json:"unscheduled"` // Boards of the view with neither a start nor a due date
+	Truncated    bool           `json:"truncated"`
+}
+
+// TimelineBar is a board's period on the timeline
+type TimelineBar struct {
+	BoardID     string    `json:"boardId"`
+	Title       string    `json:"title"`
+	Start       time.Time `json:"start"` // Start date, or the due date of a milestone
+	End         time.Time `json:"end"`   // Due date, or the start date of a board without one
+	AssigneeID  *string   `json:"assigneeId"`
+	GroupKey    string    `json:"groupKey,omitempty"` // Row of a grouped view; a board with several values has a bar in each
+	IsMilestone bool      `json:"isMilestone"`        // Only a due date
 }
 
 // ==================== User Board Order DTOs ====================
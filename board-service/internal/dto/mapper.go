@@ -105,6 +105,7 @@ func (m *BoardMapper) ToResponseWithUserMap(
 		Title:     board.Title,
 		Content:   board.Description,
 		DueDate:   board.DueDate,
+		StartDate: board.StartDate,
 		CreatedAt: board.CreatedAt,
 		UpdatedAt: board.UpdatedAt,
 	}
@@ -180,6 +181,59 @@ func (m *BoardMapper) ToResponseList(
 	return responses
 }
 
+// ToActivityResponse converts a domain.BoardActivity to BoardActivityResponse using pre-fetched user map
+func (m *BoardMapper) ToActivityResponse(
+	activity *domain.BoardActivity,
+	userMap map[string]client.UserInfo,
+) BoardActivityResponse {
+	response := BoardActivityResponse{
+		ActivityID: activity.ID.String(),
+		BoardID:    activity.BoardID.String(),
+		Action:     string(activity.Action),
+		Changes:    []ActivityChangeResponse{},
+		CreatedAt:  activity.CreatedAt,
+	}
+
+	// Set actor info (from userMap)
+	if actor, ok := userMap[activity.ActorID.String()]; ok {
+		response.Actor = UserInfo{
+			UserID:   actor.UserID,
+			Name:     actor.Name,
+			Email:    actor.Email,
+			IsActive: actor.IsActive,
+		}
+	} else {
+		// Fallback if user not found
+		response.Actor = UserInfo{
+			UserID:   activity.ActorID.String(),
+			Name:     "Unknown User",
+			Email:    "",
+			IsActive: false,
+		}
+	}
+
+	changes, err := activity.GetChanges()
+	if err != nil {
+		if m.logger != nil {
+			m.logger.Warn("Failed to parse activity changes",
+				zap.Error(err),
+				zap.String("activity_id", activity.ID.String()),
+			)
+		}
+		return response
+	}
+	for _, change := range changes {
+		response.Changes = append(response.Changes, ActivityChangeResponse{
+			Field:     change.Field,
+			FieldName: change.FieldName,
+			OldValue:  change.OldValue,
+			NewValue:  change.NewValue,
+		})
+	}
+
+	return response
+}
+
 // ==================== Project Mapper ====================
 
 type ProjectMapper struct{}
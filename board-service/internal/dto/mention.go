@@ -0,0 +1,40 @@
+package dto
+
+import "time"
+
+// ==================== Mention DTOs ====================
+
+// GetMentionsRequest represents query params for the mention inbox
+type GetMentionsRequest struct {
+	Unread bool `form:"unread"` // true: only unread mentions
+	Page   int  `form:"page" binding:"omitempty,min=1"`
+	Limit  int  `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// MentionResponse represents a single mention in the inbox
+type MentionResponse struct {
+	MentionID   string     `json:"mentionId"`
+	ProjectID   string     `json:"projectId"`
+	BoardID     string     `json:"boardId"`
+	SourceType  string     `json:"sourceType"` // comment, board
+	SourceID    string     `json:"sourceId"`   // commentId or boardId
+	MentionedBy UserInfo   `json:"mentionedBy"`
+	Excerpt     string     `json:"excerpt"`
+	IsRead      bool       `json:"isRead"`
+	ReadAt      *time.Time `json:"readAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// MentionInboxResponse represents a page of mentions with the unread counter
+type MentionInboxResponse struct {
+	Mentions    []MentionResponse `json:"mentions"`
+	Total       int64             `json:"total"`
+	UnreadCount int64             `json:"unreadCount"`
+	Page        int               `json:"page"`
+	Limit       int               `json:"limit"`
+}
+
+// MarkAllMentionsReadResponse represents the result of marking all mentions as read
+type MarkAllMentionsReadResponse struct {
+	Updated int64 `json:"updated"`
+}
@@ -0,0 +1,65 @@
+package dto
+
+import "time"
+
+// ==================== Notification DTOs ====================
+
+// GetNotificationsRequest represents query params for the notification list
+type GetNotificationsRequest struct {
+	Unread bool `form:"unread"` // true: only unread notifications
+	Page   int  `form:"page" binding:"omitempty,min=1"`
+	Limit  int  `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// NotificationResponse represents a single in-app notification
+type NotificationResponse struct {
+	NotificationID string     `json:"notificationId"`
+	ProjectID      string     `json:"projectId"`
+	BoardID        *string    `json:"boardId"`
+	Type           string     `json:"type"`       // board.assigned, board.due_date_changed, comment.created, mention, join_request.decided, member.role_changed
+	ResourceID     *string    `json:"resourceId"` // commentId, joinRequestId or memberId depending on type
+	Actor          UserInfo   `json:"actor"`
+	Title          string     `json:"title"`
+	Detail         string     `json:"detail"`
+	IsRead         bool       `json:"isRead"`
+	ReadAt         *time.Time `json:"readAt"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+// NotificationListResponse represents a page of notifications with the unread counter
+type NotificationListResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	Total         int64                  `json:"total"`
+	UnreadCount   int64                  `json:"unreadCount"`
+	Page          int                    `json:"page"`
+	Limit         int                    `json:"limit"`
+}
+
+// MarkAllNotificationsReadResponse represents the result of marking all notifications as read
+type MarkAllNotificationsReadResponse struct {
+	Updated int64 `json:"updated"`
+}
+
+// ==================== Notification Preference DTOs ====================
+
+// UpdateNotificationPreferenceRequest represents a partial update of project notification settings
+// Omitted fields keep their current value
+type UpdateNotificationPreferenceRequest struct {
+	Assignments  *bool `json:"assignments"`
+	DueDates     *bool `json:"dueDates"`
+	Comments     *bool `json:"comments"`
+	Mentions     *bool `json:"mentions"`
+	JoinRequests *bool `json:"joinRequests"`
+	RoleChanges  *bool `json:"roleChanges"`
+}
+
+// NotificationPreferenceResponse represents a user's notification settings for a project
+type NotificationPreferenceResponse struct {
+	ProjectID    string `json:"projectId"`
+	Assignments  bool   `json:"assignments"`
+	DueDates     bool   `json:"dueDates"`
+	Comments     bool   `json:"comments"`
+	Mentions     bool   `json:"mentions"`
+	JoinRequests bool   `json:"joinRequests"`
+	RoleChanges  bool   `json:"roleChanges"`
+}
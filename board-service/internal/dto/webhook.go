@@ -0,0 +1,71 @@
+package dto
+
+import "time"
+
+// ==================== Webhook DTOs ====================
+
+// CreateWebhookRequest represents a new project webhook subscription
+// Events filters by event type ("board.created") or prefix ("board.*"); empty subscribes to every event.
+// Secret is generated when omitted and returned only in the create response.
+type CreateWebhookRequest struct {
+	URL    string   `json:"url" binding:"required,url,max=2048"`
+	Secret string   `json:"secret" binding:"omitempty,min=16,max=255"`
+	Events []string `json:"events" binding:"omitempty,dive,min=1,max=100"`
+}
+
+// UpdateWebhookRequest represents a partial update of a webhook
+// Omitted fields keep their current value
+type UpdateWebhookRequest struct {
+	URL      *string   `json:"url" binding:"omitempty,url,max=2048"`
+	Secret   *string   `json:"secret" binding:"omitempty,min=16,max=255"`
+	Events   *[]string `json:"events" binding:"omitempty,dive,min=1,max=100"`
+	IsActive *bool     `json:"isActive"`
+}
+
+// WebhookResponse represents a project webhook
+type WebhookResponse struct {
+	WebhookID string    `json:"webhookId"`
+	ProjectID string    `json:"projectId"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret,omitempty"` // Only returned when the webhook is created
+	Events    []string  `json:"events"`
+	IsActive  bool      `json:"isActive"`
+	CreatedBy string    `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ==================== Webhook Delivery DTOs ====================
+
+// GetWebhookDeliveriesRequest represents query params for the delivery log
+type GetWebhookDeliveriesRequest struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// WebhookDeliveryResponse represents a single delivery log entry
+type WebhookDeliveryResponse struct {
+	DeliveryID     string     `json:"deliveryId"`
+	WebhookID      string     `json:"webhookId"`
+	EventID        string     `json:"eventId"`
+	EventType      string     `json:"eventType"`
+	Status         string     `json:"status"` // pending, succeeded, failed
+	Attempts       int        `json:"attempts"`
+	ResponseStatus int        `json:"responseStatus"`
+	ResponseBody   string     `json:"responseBody"`
+	LastError      string     `json:"lastError"`
+	DurationMs     int64      `json:"durationMs"`
+	NextAttemptAt  *time.Time `json:"nextAttemptAt"` // Only set while the delivery is pending
+	DeliveredAt    *time.Time `json:"deliveredAt"`
+	RedeliveryOf   *string    `json:"redeliveryOf"`
+	Payload        string     `json:"payload"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+// PaginatedWebhookDeliveriesResponse represents a page of delivery log entries
+type PaginatedWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	Total      int64                     `json:"total"`
+	Page       int                       `json:"page"`
+	Limit      int                       `json:"limit"`
+}
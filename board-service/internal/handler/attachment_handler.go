@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverhead is the room left for multipart boundaries and headers on top of the file size limit
+const multipartOverhead = 1 << 20
+
+type AttachmentHandler struct {
+	service service.AttachmentService
+}
+
+func NewAttachmentHandler(service service.AttachmentService) *AttachmentHandler {
+	return &AttachmentHandler{service: service}
+}
+
+// UploadBoardAttachment godoc
+// @Summary      Upload board attachment
+// @Description  Upload a file to a board as multipart/form-data field "file" (project member only).
+// @Description  The size limit and allowed MIME types are configured on the server; the type is detected from the content.
+// @Tags         attachments
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        file formData file true "File to upload"
+// @Success      201 {object} dto.SuccessResponse{data=dto.AttachmentResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      413 {object} dto.ErrorResponse
+// @Failure      415 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/attachments [post]
+// @Security     BearerAuth
+func (h *AttachmentHandler) UploadBoardAttachment(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	h.upload(c, func(file service.AttachmentFile) (*dto.AttachmentResponse, error) {
+		return h.service.UploadToBoard(c.Request.Context(), userID, boardID, file)
+	})
+}
+
+// UploadCommentAttachment godoc
+// @Summary      Upload comment attachment
+// @Description  Upload a file to a comment as multipart/form-data field "file" (comment author or ADMIN+)
+// @Tags         attachments
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        commentId path string true "Comment ID"
+// @Param        file formData file true "File to upload"
+// @Success      201 {object} dto.SuccessResponse{data=dto.AttachmentResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      413 {object} dto.ErrorResponse
+// @Failure      415 {object} dto.ErrorResponse
+// @Router       /api/comments/{commentId}/attachments [post]
+// @Security     BearerAuth
+func (h *AttachmentHandler) UploadCommentAttachment(c *gin.Context) {
+	userID := c.GetString("user_id")
+	commentID := c.Param("commentId")
+
+	h.upload(c, func(file service.AttachmentFile) (*dto.AttachmentResponse, error) {
+		return h.service.UploadToComment(c.Request.Context(), userID, commentID, file)
+	})
+}
+
+// upload caps the request body, reads the "file" form field and hands it to the service
+func (h *AttachmentHandler) upload(c *gin.Context, save func(file service.AttachmentFile) (*dto.AttachmentResponse, error)) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.service.MaxUploadSize()+multipartOverhead)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			dto.Error(c, apperrors.New(apperrors.ErrCodePayloadTooLarge, fmt.Sprintf("파일 크기는 %d바이트를 초과할 수 없습니다", h.service.MaxUploadSize()), 413))
+			return
+		}
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "file 필드가 필요합니다", 400))
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "파일을 읽을 수 없습니다", 400))
+		return
+	}
+	defer file.Close()
+
+	attachment, err := save(service.AttachmentFile{
+		FileName: header.Filename,
+		Size:     header.Size,
+		Content:  file,
+	})
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, attachment)
+}
+
+// GetBoardAttachments godoc
+// @Summary      Get board attachments
+// @Description  Get all files of a board, including files attached to its comments (commentId is set for those)
+// @Tags         attachments
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Success      200 {object} dto.SuccessResponse{data=[]dto.AttachmentResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/attachments [get]
+// @Security     BearerAuth
+func (h *AttachmentHandler) GetBoardAttachments(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	attachments, err := h.service.GetBoardAttachments(userID, boardID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, attachments)
+}
+
+// GetCommentAttachments godoc
+// @Summary      Get comment attachments
+// @Description  Get the files attached to a comment (project member only)
+// @Tags         attachments
+// @Accept       json
+// @Produce      json
+// @Param        commentId path string true "Comment ID"
+// @Success      200 {object} dto.SuccessResponse{data=[]dto.AttachmentResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/comments/{commentId}/attachments [get]
+// @Security     BearerAuth
+func (h *AttachmentHandler) GetCommentAttachments(c *gin.Context) {
+	userID := c.GetString("user_id")
+	commentID := c.Param("commentId")
+
+	attachments, err := h.service.GetCommentAttachments(userID, commentID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, attachments)
+}
+
+// DownloadAttachment godoc
+// @Summary      Download attachment
+// @Description  Download the file content (project member only)
+// @Tags         attachments
+// @Produce      octet-stream
+// @Param        attachmentId path string true "Attachment ID"
+// @Success      200 {file} binary
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/attachments/{attachmentId}/download [get]
+// @Security     BearerAuth
+func (h *AttachmentHandler) DownloadAttachment(c *gin.Context) {
+	userID := c.GetString("user_id")
+	attachmentID := c.Param("attachmentId")
+
+	attachment, content, err := h.service.Download(c.Request.Context(), userID, attachmentID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+	defer content.Close()
+
+	// Always served as a download so uploaded HTML/SVG is never rendered in the app origin
+	c.Header("Content-Disposition", "attachment; filename*=UTF-8''"+url.PathEscape(attachment.FileName))
+	c.Header("Content-Type", attachment.ContentType)
+	c.Header("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Status(http.StatusOK)
+	io.Copy(c.Writer, content)
+}
+
+// DeleteAttachment godoc
+// @Summary      Delete attachment
+// @Description  Delete a file (uploader or ADMIN+)
+// @Tags         attachments
+// @Accept       json
+// @Produce      json
+// @Param        attachmentId path string true "Attachment ID"
+// @Success      204 "No Content"
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/attachments/{attachmentId} [delete]
+// @Security     BearerAuth
+func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
+	userID := c.GetString("user_id")
+	attachmentID := c.Param("attachmentId")
+
+	if err := h.service.DeleteAttachment(c.Request.Context(), userID, attachmentID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
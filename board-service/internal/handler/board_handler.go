@@ -202,6 +202,7 @@ func (h *BoardHandler) DeleteBoard(c *gin.Context) {
 // MoveBoard godoc
 // @Summary      Move board to different column
 // @Description  Move a board to a different column/group in a view (integrated API: field value change + order update in single transaction)
+// @Description  Only views grouped by a single- or multi-select field support moves (400 otherwise). An empty newFieldValue moves the board to the "(none)" column, except for required fields.
 // @Tags         boards
 // @Accept       json
 // @Produce      json
@@ -244,3 +245,87 @@ func (h *BoardHandler) MoveBoard(c *gin.Context) {
 
 	dto.Success(c, response)
 }
+
+// RescheduleBoard godoc
+// @Summary      Reschedule board
+// @Description  Move a board on the timeline: the start date moves to startDate and the due date shifts by the same amount, keeping the board's duration
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        request body dto.RescheduleBoardRequest true "Reschedule board request"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/schedule [patch]
+// @Security     BearerAuth
+func (h *BoardHandler) RescheduleBoard(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		dto.Error(c, apperrors.ErrUnauthorized)
+		return
+	}
+
+	boardID := c.Param("boardId")
+	if boardID == "" {
+		dto.Error(c, apperrors.Wrap(nil, apperrors.ErrCodeBadRequest, "보드 ID가 필요합니다", 400))
+		return
+	}
+
+	var req dto.RescheduleBoardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	response, err := h.service.RescheduleBoard(boardID, userID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, response)
+}
+
+// GetBoardActivities godoc
+// @Summary      Get board activity history
+// @Description  Get the activity history of a board with per-field diffs (newest first, project member only)
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        page query int false "Page number (default: 1)"
+// @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.PaginatedBoardActivitiesResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/activity [get]
+// @Security     BearerAuth
+func (h *BoardHandler) GetBoardActivities(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.GetBoardActivitiesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	activities, err := h.service.GetBoardActivities(boardID, userID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, activities)
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BoardLinkHandler struct {
+	service service.BoardLinkService
+}
+
+func NewBoardLinkHandler(service service.BoardLinkService) *BoardLinkHandler {
+	return &BoardLinkHandler{service: service}
+}
+
+// CreateBoardLink godoc
+// @Summary      Link boards
+// @Description  Link the board to another board of the same project (project member only).
+// @Description  linkType is read from this board's side: blocks, is_blocked_by, relates_to, duplicates, is_duplicated_by.
+// @Description  Blocking links that would form a cycle are rejected with 409.
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        request body dto.CreateBoardLinkRequest true "Link details"
+// @Success      201 {object} dto.SuccessResponse{data=dto.BoardLinkResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/links [post]
+// @Security     BearerAuth
+func (h *BoardLinkHandler) CreateBoardLink(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.CreateBoardLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	link, err := h.service.CreateLink(userID, boardID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, link)
+}
+
+// GetBoardLinks godoc
+// @Summary      Get board links
+// @Description  Get all links of a board, seen from this board (project member only)
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Success      200 {object} dto.SuccessResponse{data=[]dto.BoardLinkResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/links [get]
+// @Security     BearerAuth
+func (h *BoardLinkHandler) GetBoardLinks(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	links, err := h.service.GetLinks(userID, boardID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, links)
+}
+
+// DeleteBoardLink godoc
+// @Summary      Remove board link
+// @Description  Remove a link from either of its boards (project member only)
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        linkId path string true "Link ID"
+// @Success      204 "No Content"
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/links/{linkId} [delete]
+// @Security     BearerAuth
+func (h *BoardLinkHandler) DeleteBoardLink(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+	linkID := c.Param("linkId")
+
+	if err := h.service.DeleteLink(userID, boardID, linkID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChecklistHandler struct {
+	service service.ChecklistService
+}
+
+func NewChecklistHandler(service service.ChecklistService) *ChecklistHandler {
+	return &ChecklistHandler{service: service}
+}
+
+// CreateChecklistItem godoc
+// @Summary      Add checklist item
+// @Description  Append a checklist item (subtask) to the end of a board's checklist (project member only)
+// @Tags         checklists
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        request body dto.CreateChecklistItemRequest true "Checklist item details"
+// @Success      201 {object} dto.SuccessResponse{data=dto.ChecklistItemResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/checklist [post]
+// @Security     BearerAuth
+func (h *ChecklistHandler) CreateChecklistItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.CreateChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	item, err := h.service.CreateItem(userID, boardID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, item)
+}
+
+// GetChecklist godoc
+// @Summary      Get board checklist
+// @Description  Get the checklist items of a board in position order, with the done/total progress (project member only)
+// @Tags         checklists
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.ChecklistResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/checklist [get]
+// @Security     BearerAuth
+func (h *ChecklistHandler) GetChecklist(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	checklist, err := h.service.GetChecklist(userID, boardID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, checklist)
+}
+
+// UpdateChecklistItem godoc
+// @Summary      Update checklist item
+// @Description  Update the title, done flag or assignee of a checklist item (project member only).
+// @Description  An empty assigneeId clears the assignee.
+// @Tags         checklists
+// @Accept       json
+// @Produce      json
+// @Param        itemId path string true "Checklist item ID"
+// @Param        request body dto.UpdateChecklistItemRequest true "Fields to update"
+// @Success      200 {object} dto.SuccessResponse{data=dto.ChecklistItemResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/checklist-items/{itemId} [patch]
+// @Security     BearerAuth
+func (h *ChecklistHandler) UpdateChecklistItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	itemID := c.Param("itemId")
+
+	var req dto.UpdateChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	item, err := h.service.UpdateItem(userID, itemID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, item)
+}
+
+// MoveChecklistItem godoc
+// @Summary      Reorder checklist item
+// @Description  Move a checklist item between two neighbours using fractional positions (project member only)
+// @Tags         checklists
+// @Accept       json
+// @Produce      json
+// @Param        itemId path string true "Checklist item ID"
+// @Param        request body dto.MoveChecklistItemRequest true "Neighbour positions"
+// @Success      200 {object} dto.SuccessResponse{data=dto.ChecklistItemResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/checklist-items/{itemId}/move [put]
+// @Security     BearerAuth
+func (h *ChecklistHandler) MoveChecklistItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	itemID := c.Param("itemId")
+
+	var req dto.MoveChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	item, err := h.service.MoveItem(userID, itemID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, item)
+}
+
+// DeleteChecklistItem godoc
+// @Summary      Delete checklist item
+// @Description  Delete a checklist item (project member only)
+// @Tags         checklists
+// @Accept       json
+// @Produce      json
+// @Param        itemId path string true "Checklist item ID"
+// @Success      204 "No Content"
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/checklist-items/{itemId} [delete]
+// @Security     BearerAuth
+func (h *ChecklistHandler) DeleteChecklistItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	itemID := c.Param("itemId")
+
+	if err := h.service.DeleteItem(userID, itemID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -60,9 +60,96 @@ func (h *CommentHandler) CreateComment(c *gin.Context) {
 	dto.SuccessWithStatus(c, http.StatusCreated, resp)
 }
 
+// CreateReply godoc
+// @Summary      Reply to comment
+// @Description  Create a reply to an existing comment (nesting is limited to 2 levels of replies)
+// @Tags         comments
+// @Accept       json
+// @Produce      json
+// @Param        commentId path string true "Parent Comment ID"
+// @Param        request body dto.CreateReplyRequest true "Reply details"
+// @Success      201 {object} dto.SuccessResponse{data=dto.CommentResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/comments/{commentId}/replies [post]
+// @Security     BearerAuth
+func (h *CommentHandler) CreateReply(c *gin.Context) {
+	userIDStr := c.GetString("user_id")
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		dto.Error(c, apperrors.New(apperrors.ErrCodeBadRequest, "Invalid user ID format", http.StatusBadRequest))
+		return
+	}
+
+	parentID, err := uuid.Parse(c.Param("commentId"))
+	if err != nil {
+		dto.Error(c, apperrors.New(apperrors.ErrCodeBadRequest, "Invalid comment ID format", http.StatusBadRequest))
+		return
+	}
+
+	var req dto.CreateReplyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "Invalid request body", http.StatusBadRequest))
+		return
+	}
+
+	resp, err := h.commentService.CreateReply(c.Request.Context(), parentID, req, userID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, resp)
+}
+
+// GetReplies godoc
+// @Summary      Get comment replies
+// @Description  Get the nested replies of a comment (project member only)
+// @Tags         comments
+// @Accept       json
+// @Produce      json
+// @Param        commentId path string true "Comment ID"
+// @Success      200 {object} dto.SuccessResponse{data=[]dto.CommentResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/comments/{commentId}/replies [get]
+// @Security     BearerAuth
+func (h *CommentHandler) GetReplies(c *gin.Context) {
+	userIDStr := c.GetString("user_id")
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		dto.Error(c, apperrors.New(apperrors.ErrCodeBadRequest, "Invalid user ID format", http.StatusBadRequest))
+		return
+	}
+
+	commentID, err := uuid.Parse(c.Param("commentId"))
+	if err != nil {
+		dto.Error(c, apperrors.New(apperrors.ErrCodeBadRequest, "Invalid comment ID format", http.StatusBadRequest))
+		return
+	}
+
+	resp, err := h.commentService.GetReplies(c.Request.Context(), commentID, userID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, resp)
+}
+
 // GetCommentsByBoardID godoc
 // @Summary      Get comments by board
-// @Description  Get all comments for a specific board (project member only)
+// @Description  Get all comments for a specific board as threads: root comments with nested replies (project member only)
 // @Tags         comments
 // @Accept       json
 // @Produce      json
@@ -155,7 +242,7 @@ func (h *CommentHandler) UpdateComment(c *gin.Context) {
 
 // DeleteComment godoc
 // @Summary      Delete comment
-// @Description  Delete a comment (soft delete, author only)
+// @Description  Delete a comment and its replies (author only)
 // @Tags         comments
 // @Accept       json
 // @Produce      json
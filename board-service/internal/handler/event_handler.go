@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"io"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// heartbeatInterval keeps idle stream connections alive through proxies
+const heartbeatInterval = 25 * time.Second
+
+type EventHandler struct {
+	service service.EventStreamService
+}
+
+func NewEventHandler(service service.EventStreamService) *EventHandler {
+	return &EventHandler{service: service}
+}
+
+// StreamProjectEvents godoc
+// @Summary      Stream project events
+// @Description  Server-Sent Events stream of board and comment changes in the project.
+// @Description  Event names: board.created, board.updated, board.moved, board.deleted, comment.created, comment.updated, comment.deleted.
+// @Description  Membership is re-checked with every heartbeat; a member removed from the project receives a "revoked" event and the stream ends.
+// @Description  The JWT may be passed in the Authorization header or, for EventSource clients, as the access_token query parameter.
+// @Tags         events
+// @Produce      text/event-stream
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        access_token query string false "JWT access token (when the Authorization header cannot be set)"
+// @Success      200 {string} string "event stream"
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      401 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/events [get]
+// @Security     BearerAuth
+func (h *EventHandler) StreamProjectEvents(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		dto.Error(c, apperrors.ErrUnauthorized)
+		return
+	}
+
+	projectID := c.Param("projectId")
+
+	events, unsubscribe, err := h.service.SubscribeProject(userID, projectID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+	defer unsubscribe()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	// Tell the client the subscription is active
+	c.SSEvent("ready", gin.H{"projectId": projectID})
+	c.Writer.Flush()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+			c.SSEvent(string(event.Type), event)
+			return true
+		case <-heartbeat.C:
+			// Members removed from the project stop receiving events; a failed check keeps the stream
+			if err := h.service.CheckSubscription(userID, projectID); err != nil {
+				if appErr, ok := err.(*apperrors.AppError); ok && appErr.HTTPStatus == 403 {
+					c.SSEvent("revoked", gin.H{"projectId": projectID, "message": appErr.Message})
+					return false
+				}
+			}
+			c.SSEvent("ping", time.Now().Unix())
+			return true
+		}
+	})
+}
@@ -195,6 +195,128 @@ func (h *FieldHandler) DeleteField(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// UpdateFieldEditRoles godoc
+// @Summary Update field edit roles
+// @Description Replace the roles allowed to edit a field's values (ADMIN+). Entries are role names or minimum role levels ("50").
+// @Description An empty list lets every member edit. Owners can always edit; others get 403 FIELD_EDIT_FORBIDDEN naming the field.
+// @Tags Fields
+// @Accept json
+// @Produce json
+// @Param fieldId path string true "Field ID"
+// @Param request body dto.UpdateFieldEditRolesRequest true "Allowed roles"
+// @Success 200 {object} dto.SuccessResponse{data=dto.FieldResponse}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /fields/{fieldId}/edit-roles [put]
+// @Security BearerAuth
+func (h *FieldHandler) UpdateFieldEditRoles(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	fieldID := c.Param("fieldId")
+
+	var req dto.UpdateFieldEditRolesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값이 유효하지 않습니다", 400)
+		dto.Error(c, appErr)
+		return
+	}
+
+	field, err := h.fieldService.UpdateFieldEditRoles(userID, fieldID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "필드 권한 수정 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, field)
+}
+
+// PreviewFieldConversion godoc
+// @Summary Preview field type conversion
+// @Description Report how many of a field's values convert to another type and how many are lost (ADMIN+). Nothing is changed.
+// @Tags Fields
+// @Accept json
+// @Produce json
+// @Param fieldId path string true "Field ID"
+// @Param request body dto.ConvertFieldTypeRequest true "Target field type"
+// @Success 200 {object} dto.SuccessResponse{data=dto.FieldConversionPreview}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /fields/{fieldId}/conversion/preview [post]
+// @Security BearerAuth
+func (h *FieldHandler) PreviewFieldConversion(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	fieldID := c.Param("fieldId")
+
+	var req dto.ConvertFieldTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값이 유효하지 않습니다", 400)
+		dto.Error(c, appErr)
+		return
+	}
+
+	preview, err := h.fieldService.PreviewFieldConversion(userID, fieldID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "필드 타입 변환 미리보기 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, preview)
+}
+
+// ConvertFieldType godoc
+// @Summary Convert field type
+// @Description Change a field's type and migrate its values (ADMIN+). Values the new type cannot hold are removed;
+// @Description text to single_select creates an option per distinct value. The field's config is reset.
+// @Tags Fields
+// @Accept json
+// @Produce json
+// @Param fieldId path string true "Field ID"
+// @Param request body dto.ConvertFieldTypeRequest true "Target field type"
+// @Success 200 {object} dto.SuccessResponse{data=dto.FieldConversionResponse}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /fields/{fieldId}/conversion [post]
+// @Security BearerAuth
+func (h *FieldHandler) ConvertFieldType(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	fieldID := c.Param("fieldId")
+
+	var req dto.ConvertFieldTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값이 유효하지 않습니다", 400)
+		dto.Error(c, appErr)
+		return
+	}
+
+	result, err := h.fieldService.ConvertFieldType(userID, fieldID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "필드 타입 변환 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
+
 // UpdateFieldOrder godoc
 // @Summary Update field order
 // @Description Update display order of fields in a project
@@ -274,11 +396,12 @@ func (h *FieldHandler) CreateOption(c *gin.Context) {
 
 // GetOptionsByField godoc
 // @Summary Get options by field
-// @Description Get all options for a select field
+// @Description Get all options for a select field; archived options only with includeArchived
 // @Tags Field Options
 // @Accept json
 // @Produce json
 // @Param fieldId path string true "Field ID"
+// @Param includeArchived query bool false "Include archived options"
 // @Success 200 {object} dto.SuccessResponse{data=[]dto.OptionResponse}
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
@@ -290,7 +413,9 @@ func (h *FieldHandler) GetOptionsByField(c *gin.Context) {
 	userID := c.GetString(middleware.UserIDKey)
 	fieldID := c.Param("fieldId")
 
-	options, err := h.fieldService.GetOptionsByField(userID, fieldID)
+	includeArchived := c.Query("includeArchived") == "true"
+
+	options, err := h.fieldService.GetOptionsByField(userID, fieldID, includeArchived)
 	if err != nil {
 		if appErr, ok := err.(*apperrors.AppError); ok {
 			dto.Error(c, appErr)
@@ -345,7 +470,7 @@ func (h *FieldHandler) UpdateOption(c *gin.Context) {
 
 // DeleteOption godoc
 // @Summary Delete field option
-// @Description Delete a field option
+// @Description Delete a field option that no board uses; options in use are archived or merged instead
 // @Tags Field Options
 // @Accept json
 // @Produce json
@@ -355,6 +480,7 @@ func (h *FieldHandler) UpdateOption(c *gin.Context) {
 // @Failure 401 {object} dto.ErrorResponse
 // @Failure 403 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /field-options/{optionId} [delete]
 // @Security BearerAuth
@@ -374,6 +500,110 @@ func (h *FieldHandler) DeleteOption(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// ArchiveOption godoc
+// @Summary Archive field option
+// @Description Hide an option from pickers and kanban columns; boards keep their values
+// @Tags Field Options
+// @Accept json
+// @Produce json
+// @Param optionId path string true "Option ID"
+// @Success 200 {object} dto.SuccessResponse{data=dto.OptionResponse}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /field-options/{optionId}/archive [post]
+// @Security BearerAuth
+func (h *FieldHandler) ArchiveOption(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	optionID := c.Param("optionId")
+
+	option, err := h.fieldService.ArchiveOption(userID, optionID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "옵션 보관 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, option)
+}
+
+// UnarchiveOption godoc
+// @Summary Unarchive field option
+// @Description Make an archived option selectable again
+// @Tags Field Options
+// @Accept json
+// @Produce json
+// @Param optionId path string true "Option ID"
+// @Success 200 {object} dto.SuccessResponse{data=dto.OptionResponse}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /field-options/{optionId}/unarchive [post]
+// @Security BearerAuth
+func (h *FieldHandler) UnarchiveOption(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	optionID := c.Param("optionId")
+
+	option, err := h.fieldService.UnarchiveOption(userID, optionID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "옵션 보관 해제 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, option)
+}
+
+// MergeOption godoc
+// @Summary Merge field option
+// @Description Move all values of an option to another option of the same field, update saved view filters and delete the option
+// @Tags Field Options
+// @Accept json
+// @Produce json
+// @Param optionId path string true "Option ID"
+// @Param request body dto.MergeOptionRequest true "Option merge request"
+// @Success 200 {object} dto.SuccessResponse{data=dto.MergeOptionResponse}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /field-options/{optionId}/merge [post]
+// @Security BearerAuth
+func (h *FieldHandler) MergeOption(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	optionID := c.Param("optionId")
+
+	var req dto.MergeOptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값이 유효하지 않습니다", 400)
+		dto.Error(c, appErr)
+		return
+	}
+
+	result, err := h.fieldService.MergeOption(userID, optionID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "옵션 병합 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
+
 // UpdateOptionOrder godoc
 // @Summary Update option order
 // @Description Update display order of options for a field
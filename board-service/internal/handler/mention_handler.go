@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MentionHandler struct {
+	service service.MentionService
+}
+
+func NewMentionHandler(service service.MentionService) *MentionHandler {
+	return &MentionHandler{service: service}
+}
+
+// GetMyMentions godoc
+// @Summary      Get my mentions
+// @Description  Get the current user's mention inbox (newest first) with the unread count
+// @Tags         mentions
+// @Accept       json
+// @Produce      json
+// @Param        unread query bool false "Only unread mentions"
+// @Param        page query int false "Page number (default: 1)"
+// @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.MentionInboxResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      401 {object} dto.ErrorResponse
+// @Router       /api/me/mentions [get]
+// @Security     BearerAuth
+func (h *MentionHandler) GetMyMentions(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		dto.Error(c, apperrors.ErrUnauthorized)
+		return
+	}
+
+	var req dto.GetMentionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	mentions, err := h.service.GetMyMentions(userID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, mentions)
+}
+
+// MarkMentionAsRead godoc
+// @Summary      Mark mention as read
+// @Description  Mark a single mention in the current user's inbox as read
+// @Tags         mentions
+// @Accept       json
+// @Produce      json
+// @Param        mentionId path string true "Mention ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.MentionResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/me/mentions/{mentionId}/read [patch]
+// @Security     BearerAuth
+func (h *MentionHandler) MarkMentionAsRead(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		dto.Error(c, apperrors.ErrUnauthorized)
+		return
+	}
+	mentionID := c.Param("mentionId")
+
+	mention, err := h.service.MarkAsRead(userID, mentionID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, mention)
+}
+
+// MarkAllMentionsAsRead godoc
+// @Summary      Mark all mentions as read
+// @Description  Mark every unread mention in the current user's inbox as read
+// @Tags         mentions
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} dto.SuccessResponse{data=dto.MarkAllMentionsReadResponse}
+// @Failure      401 {object} dto.ErrorResponse
+// @Router       /api/me/mentions/read-all [put]
+// @Security     BearerAuth
+func (h *MentionHandler) MarkAllMentionsAsRead(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		dto.Error(c, apperrors.ErrUnauthorized)
+		return
+	}
+
+	result, err := h.service.MarkAllAsRead(userID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
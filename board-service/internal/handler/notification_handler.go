@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	service service.NotificationService
+}
+
+func NewNotificationHandler(service service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{service: service}
+}
+
+// GetMyNotifications godoc
+// @Summary      Get my notifications
+// @Description  Get the current user's in-app notifications (newest first) with the unread count
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param        unread query bool false "Only unread notifications"
+// @Param        page query int false "Page number (default: 1)"
+// @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.NotificationListResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      401 {object} dto.ErrorResponse
+// @Router       /api/me/notifications [get]
+// @Security     BearerAuth
+func (h *NotificationHandler) GetMyNotifications(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		dto.Error(c, apperrors.ErrUnauthorized)
+		return
+	}
+
+	var req dto.GetNotificationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	notifications, err := h.service.GetMyNotifications(userID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, notifications)
+}
+
+// MarkNotificationAsRead godoc
+// @Summary      Mark notification as read
+// @Description  Mark a single notification of the current user as read
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param        notificationId path string true "Notification ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.NotificationResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/me/notifications/{notificationId}/read [patch]
+// @Security     BearerAuth
+func (h *NotificationHandler) MarkNotificationAsRead(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		dto.Error(c, apperrors.ErrUnauthorized)
+		return
+	}
+	notificationID := c.Param("notificationId")
+
+	notification, err := h.service.MarkAsRead(userID, notificationID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, notification)
+}
+
+// MarkAllNotificationsAsRead godoc
+// @Summary      Mark all notifications as read
+// @Description  Mark every unread notification of the current user as read
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} dto.SuccessResponse{data=dto.MarkAllNotificationsReadResponse}
+// @Failure      401 {object} dto.ErrorResponse
+// @Router       /api/me/notifications/read-all [put]
+// @Security     BearerAuth
+func (h *NotificationHandler) MarkAllNotificationsAsRead(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		dto.Error(c, apperrors.ErrUnauthorized)
+		return
+	}
+
+	result, err := h.service.MarkAllAsRead(userID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// GetNotificationPreference godoc
+// @Summary      Get notification preferences
+// @Description  Get the current user's notification preferences for a project (project member only)
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.NotificationPreferenceResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/notification-preferences [get]
+// @Security     BearerAuth
+func (h *NotificationHandler) GetNotificationPreference(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	preference, err := h.service.GetPreference(userID, projectID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, preference)
+}
+
+// UpdateNotificationPreference godoc
+// @Summary      Update notification preferences
+// @Description  Enable or disable notification types for a project (omitted fields keep their value)
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        request body dto.UpdateNotificationPreferenceRequest true "Preference changes"
+// @Success      200 {object} dto.SuccessResponse{data=dto.NotificationPreferenceResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/notification-preferences [put]
+// @Security     BearerAuth
+func (h *NotificationHandler) UpdateNotificationPreference(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.UpdateNotificationPreferenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	preference, err := h.service.UpdatePreference(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, preference)
+}
@@ -196,13 +196,13 @@ func (h *ViewHandler) DeleteView(c *gin.Context) {
 
 // ApplyView godoc
 // @Summary Apply view
-// @Description Apply a saved view to get filtered/sorted/grouped boards
+// @Description Apply a saved view to get filtered/sorted/grouped boards. Grouped views return every group with its count and first boards (limit applies per group). The result carries the view type and the user's table layout (column definitions)
 // @Tags Views
 // @Accept json
 // @Produce json
 // @Param viewId path string true "View ID"
 // @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(20)
+// @Param limit query int false "Items per page (boards per group when grouped)" default(20)
 // @Success 200 {object} dto.SuccessResponse{data=object}
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
@@ -232,6 +232,224 @@ func (h *ViewHandler) ApplyView(c *gin.Context) {
 	dto.Success(c, result)
 }
 
+// GetGroupBoards godoc
+// @Summary Get group boards
+// @Description Get the next boards of one group of a grouped view, in the user's manual order. Pass the group's nextCursor to continue after its last board.
+// @Description In views with swimlanes, pass swimlane to page through one cell.
+// @Tags Views
+// @Accept json
+// @Produce json
+// @Param viewId path string true "View ID"
+// @Param groupValue path string true "Group key (option ID, user ID, date, value or (none))"
+// @Param swimlane query string false "Swimlane key (option ID, user ID, date, value or (none)) to page through one cell"
+// @Param cursor query string false "Cursor from the previous page (empty for the first page)"
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.SuccessResponse{data=dto.BoardGroup}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /views/{viewId}/groups/{groupValue}/boards [get]
+// @Security BearerAuth
+func (h *ViewHandler) GetGroupBoards(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	viewID := c.Param("viewId")
+	groupValue := c.Param("groupValue")
+	swimlane := c.Query("swimlane")
+	cursor := c.Query("cursor")
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	result, err := h.viewService.GetGroupBoards(userID, viewID, groupValue, swimlane, cursor, limit)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "그룹 보드 조회 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// ==================== Calendar ====================
+
+// GetCalendar godoc
+// @Summary Get calendar
+// @Description Get the boards of a view bucketed by day, from its calendar date (due date or a date/datetime field).
+// @Description Boards with a start field span every day from their start to their date. Up to 62 days per request.
+// @Tags Views
+// @Accept json
+// @Produce json
+// @Param viewId path string true "View ID"
+// @Param from query string true "First day (YYYY-MM-DD)"
+// @Param to query string true "Last day, inclusive (YYYY-MM-DD)"
+// @Param tz query string false "IANA time zone" default(UTC)
+// @Success 200 {object} dto.SuccessResponse{data=dto.CalendarResponse}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /views/{viewId}/calendar [get]
+// @Security BearerAuth
+func (h *ViewHandler) GetCalendar(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	viewID := c.Param("viewId")
+
+	var req dto.GetCalendarRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		appErr := apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값이 유효하지 않습니다", 400)
+		dto.Error(c, appErr)
+		return
+	}
+
+	result, err := h.viewService.GetCalendar(userID, viewID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "캘린더 조회 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// MoveCalendarBoard godoc
+// @Summary Move board on calendar
+// @Description Move a board to another day of a calendar view. The board's span keeps its length and times keep their time of day.
+// @Description The dates are validated and saved like any board or field value update.
+// @Tags Views
+// @Accept json
+// @Produce json
+// @Param viewId path string true "View ID"
+// @Param boardId path string true "Board ID"
+// @Param request body dto.MoveCalendarBoardRequest true "New day"
+// @Success 200 {object} dto.SuccessResponse{data=dto.MoveCalendarBoardResponse}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /views/{viewId}/calendar/boards/{boardId} [patch]
+// @Security BearerAuth
+func (h *ViewHandler) MoveCalendarBoard(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	viewID := c.Param("viewId")
+	boardID := c.Param("boardId")
+
+	var req dto.MoveCalendarBoardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값이 유효하지 않습니다", 400)
+		dto.Error(c, appErr)
+		return
+	}
+
+	result, err := h.viewService.MoveCalendarBoard(userID, viewID, boardID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "캘린더 이동 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// ==================== Timeline ====================
+
+// GetTimeline godoc
+// @Summary Get timeline
+// @Description Get the boards of a view as bars from their start date to their due date, for the boards that overlap a window.
+// @Description Boards with only a due date are milestones. Grouped views split the bars into rows. Up to 366 days per request.
+// @Tags Views
+// @Accept json
+// @Produce json
+// @Param viewId path string true "View ID"
+// @Param from query string true "First day of the window (YYYY-MM-DD)"
+// @Param to query string true "Last day of the window, inclusive (YYYY-MM-DD)"
+// @Param tz query string false "IANA time zone" default(UTC)
+// @Success 200 {object} dto.SuccessResponse{data=dto.TimelineResponse}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /views/{viewId}/timeline [get]
+// @Security BearerAuth
+func (h *ViewHandler) GetTimeline(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	viewID := c.Param("viewId")
+
+	var req dto.GetTimelineRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		appErr := apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값이 유효하지 않습니다", 400)
+		dto.Error(c, appErr)
+		return
+	}
+
+	result, err := h.viewService.GetTimeline(userID, viewID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "타임라인 조회 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// ==================== Board Query ====================
+
+// QueryBoards godoc
+// @Summary Query boards
+// @Description Search boards with the text query language, e.g. stage = "진행중" AND assignee = me() AND due < now() + 7d ORDER BY importance DESC.
+// @Description Operators: = != > >= < <= ~ !~ IN (...) NOT IN (...) IS [NOT] EMPTY, combined with AND, OR, NOT and parentheses.
+// @Description Fields and options are referenced by name; functions are me(), now() and today() with optional offsets (+7d, -2w, 3h, 30m).
+// @Description Syntax errors return 400 QUERY_SYNTAX_ERROR with the character position in the message.
+// @Tags Views
+// @Accept json
+// @Produce json
+// @Param projectId path string true "Project ID"
+// @Param q query string false "Query (empty returns all boards)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.SuccessResponse{data=object}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /projects/{projectId}/boards/query [get]
+// @Security BearerAuth
+func (h *ViewHandler) QueryBoards(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	projectID := c.Param("projectId")
+
+	// Get pagination params
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	result, err := h.viewService.QueryBoards(userID, projectID, c.Query("q"), page, limit)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "보드 검색 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
+
 // ==================== Board Order ====================
 
 // UpdateBoardOrder godoc
@@ -269,3 +487,77 @@ func (h *ViewHandler) UpdateBoardOrder(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// ==================== Table Settings ====================
+
+// UpdateMyTableSettings godoc
+// @Summary Save my table settings
+// @Description Save the current user's own columns, widths, frozen columns and density for a view.
+// @Description What the settings set replaces the view's settings for the user only; the rest follows the view.
+// @Tags Views
+// @Accept json
+// @Produce json
+// @Param viewId path string true "View ID"
+// @Param request body dto.TableSettings true "Table settings"
+// @Success 200 {object} dto.SuccessResponse{data=dto.TableLayout}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /views/{viewId}/table-settings/me [put]
+// @Security BearerAuth
+func (h *ViewHandler) UpdateMyTableSettings(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	viewID := c.Param("viewId")
+
+	var req dto.TableSettings
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값이 유효하지 않습니다", 400)
+		dto.Error(c, appErr)
+		return
+	}
+
+	layout, err := h.viewService.UpdateMyTableSettings(userID, viewID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "테이블 설정 저장 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, layout)
+}
+
+// ResetMyTableSettings godoc
+// @Summary Reset my table settings
+// @Description Remove the current user's own table settings of a view so that the view's settings apply again.
+// @Tags Views
+// @Produce json
+// @Param viewId path string true "View ID"
+// @Success 200 {object} dto.SuccessResponse{data=dto.TableLayout}
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /views/{viewId}/table-settings/me [delete]
+// @Security BearerAuth
+func (h *ViewHandler) ResetMyTableSettings(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDKey)
+	viewID := c.Param("viewId")
+
+	layout, err := h.viewService.ResetMyTableSettings(userID, viewID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.New(apperrors.ErrCodeInternalServer, "테이블 설정 초기화 실패", 500))
+		}
+		return
+	}
+
+	dto.Success(c, layout)
+}
@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	service service.WebhookService
+}
+
+func NewWebhookHandler(service service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{service: service}
+}
+
+// CreateWebhook godoc
+// @Summary      Create project webhook
+// @Description  Subscribe a URL to project events (ADMIN or OWNER only).
+// @Description  Each request is signed: X-Webhook-Signature = "sha256=" + hex(HMAC-SHA256(secret, X-Webhook-Timestamp + "." + body)).
+// @Description  The secret is generated when omitted and only returned in this response.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        request body dto.CreateWebhookRequest true "Webhook details"
+// @Success      201 {object} dto.SuccessResponse{data=dto.WebhookResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/webhooks [post]
+// @Security     BearerAuth
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	webhook, err := h.service.CreateWebhook(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, webhook)
+}
+
+// GetWebhooks godoc
+// @Summary      List project webhooks
+// @Description  Get all webhooks of a project (ADMIN or OWNER only, secrets are not returned)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Success      200 {object} dto.SuccessResponse{data=[]dto.WebhookResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/webhooks [get]
+// @Security     BearerAuth
+func (h *WebhookHandler) GetWebhooks(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	webhooks, err := h.service.GetWebhooks(userID, projectID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, webhooks)
+}
+
+// UpdateWebhook godoc
+// @Summary      Update webhook
+// @Description  Change the URL, secret, event filter or active flag of a webhook (omitted fields keep their value)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        webhookId path string true "Webhook ID"
+// @Param        request body dto.UpdateWebhookRequest true "Webhook changes"
+// @Success      200 {object} dto.SuccessResponse{data=dto.WebhookResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/webhooks/{webhookId} [patch]
+// @Security     BearerAuth
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	userID := c.GetString("user_id")
+	webhookID := c.Param("webhookId")
+
+	var req dto.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	webhook, err := h.service.UpdateWebhook(userID, webhookID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, webhook)
+}
+
+// DeleteWebhook godoc
+// @Summary      Delete webhook
+// @Description  Delete a webhook; pending deliveries are given up
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        webhookId path string true "Webhook ID"
+// @Success      204 "No Content"
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/webhooks/{webhookId} [delete]
+// @Security     BearerAuth
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	userID := c.GetString("user_id")
+	webhookID := c.Param("webhookId")
+
+	if err := h.service.DeleteWebhook(userID, webhookID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetWebhookDeliveries godoc
+// @Summary      Get webhook delivery log
+// @Description  Get the deliveries of a webhook (newest first) with response status, body and errors
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        webhookId path string true "Webhook ID"
+// @Param        page query int false "Page number (default: 1)"
+// @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.PaginatedWebhookDeliveriesResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/webhooks/{webhookId}/deliveries [get]
+// @Security     BearerAuth
+func (h *WebhookHandler) GetWebhookDeliveries(c *gin.Context) {
+	userID := c.GetString("user_id")
+	webhookID := c.Param("webhookId")
+
+	var req dto.GetWebhookDeliveriesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	deliveries, err := h.service.GetDeliveries(userID, webhookID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, deliveries)
+}
+
+// RedeliverWebhook godoc
+// @Summary      Redeliver webhook event
+// @Description  Queue a new delivery of the same event payload; the original log entry is kept
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        webhookId path string true "Webhook ID"
+// @Param        deliveryId path string true "Delivery ID"
+// @Success      202 {object} dto.SuccessResponse{data=dto.WebhookDeliveryResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver [post]
+// @Security     BearerAuth
+func (h *WebhookHandler) RedeliverWebhook(c *gin.Context) {
+	userID := c.GetString("user_id")
+	webhookID := c.Param("webhookId")
+	deliveryID := c.Param("deliveryId")
+
+	delivery, err := h.service.Redeliver(userID, webhookID, deliveryID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusAccepted, delivery)
+}
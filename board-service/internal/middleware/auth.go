@@ -46,34 +46,71 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 			return
 		}
 
-		tokenString := parts[1]
-
-		// Validate token
-		claims, err := jwtpkg.ValidateToken(tokenString, jwtSecret)
-		if err != nil {
-			var appErr *apperrors.AppError
-
-			// Map JWT errors to app errors
-			if errors.Is(err, jwtpkg.ErrExpiredToken) {
-				appErr = apperrors.ErrTokenExpired
-				c.Writer.Header().Set("X-Debug-Error", "Token expired")
-			} else if errors.Is(err, jwtpkg.ErrInvalidToken) || errors.Is(err, jwtpkg.ErrInvalidClaims) {
-				appErr = apperrors.ErrInvalidToken
-				c.Writer.Header().Set("X-Debug-Error", "Invalid token or claims: "+err.Error())
-			} else {
-				appErr = apperrors.ErrUnauthorized
-				c.Writer.Header().Set("X-Debug-Error", "Unauthorized: "+err.Error())
+		if !authenticate(c, parts[1], jwtSecret) {
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// StreamAuthMiddleware authenticates long-lived event stream connections
+// Browsers' EventSource cannot set headers, so the token may also be passed
+// as the access_token query parameter.
+func StreamAuthMiddleware(jwtSecret string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tokenString := c.Query("access_token")
+
+		if authHeader := c.GetHeader("Authorization"); authHeader != "" {
+			parts := strings.SplitN(authHeader, " ", 2)
+			if len(parts) != 2 || parts[0] != "Bearer" {
+				dto.Error(c, apperrors.ErrInvalidToken)
+				c.Abort()
+				return
 			}
+			tokenString = parts[1]
+		}
 
-			dto.Error(c, appErr)
+		if tokenString == "" {
+			dto.Error(c, apperrors.ErrMissingToken)
 			c.Abort()
 			return
 		}
 
-		// Store user ID and token in context
-		c.Set(UserIDKey, claims.Sub)
-		c.Set(TokenKey, tokenString)
+		if !authenticate(c, tokenString, jwtSecret) {
+			return
+		}
 
 		c.Next()
 	}
 }
+
+// authenticate validates the token and stores the user ID and token in context
+// It aborts the request and returns false when the token is not valid
+func authenticate(c *gin.Context, tokenString, jwtSecret string) bool {
+	claims, err := jwtpkg.ValidateToken(tokenString, jwtSecret)
+	if err != nil {
+		var appErr *apperrors.AppError
+
+		// Map JWT errors to app errors
+		if errors.Is(err, jwtpkg.ErrExpiredToken) {
+			appErr = apperrors.ErrTokenExpired
+			c.Writer.Header().Set("X-Debug-Error", "Token expired")
+		} else if errors.Is(err, jwtpkg.ErrInvalidToken) || errors.Is(err, jwtpkg.ErrInvalidClaims) {
+			appErr = apperrors.ErrInvalidToken
+			c.Writer.Header().Set("X-Debug-Error", "Invalid token or claims: "+err.Error())
+		} else {
+			appErr = apperrors.ErrUnauthorized
+			c.Writer.Header().Set("X-Debug-Error", "Unauthorized: "+err.Error())
+		}
+
+		dto.Error(c, appErr)
+		c.Abort()
+		return false
+	}
+
+	// Store user ID and token in context
+	c.Set(UserIDKey, claims.Sub)
+	c.Set(TokenKey, tokenString)
+	return true
+}
@@ -0,0 +1,76 @@
+package outbox
+
+import (
+	"board-service/internal/domain"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Publisher delivers outbox events to other services
+// Implementations must be safe to call again with the same event (delivery is at-least-once).
+type Publisher interface {
+	Publish(ctx context.Context, event *domain.DomainEvent) error
+}
+
+// MultiPublisher hands an event to several publishers (e.g. the Redis stream and webhooks)
+// Every publisher is called even if an earlier one fails; the relay then retries the event with all
+// of them, so each publisher must tolerate the duplicates.
+type MultiPublisher []Publisher
+
+// Publish sends the event to every publisher and joins their errors
+func (m MultiPublisher) Publish(ctx context.Context, event *domain.DomainEvent) error {
+	var errs []error
+	for _, publisher := range m {
+		if err := publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+const (
+	// DefaultStream is the Redis stream other services consume board-service events from
+	DefaultStream = "board-service:domain-events"
+
+	// defaultStreamMaxLen caps the stream length (approximate trimming)
+	defaultStreamMaxLen = 100000
+)
+
+// RedisStreamPublisher publishes outbox events to a Redis stream
+type RedisStreamPublisher struct {
+	rdb    *redis.Client
+	stream string
+	maxLen int64
+}
+
+// NewRedisStreamPublisher creates a publisher writing to DefaultStream
+func NewRedisStreamPublisher(rdb *redis.Client) *RedisStreamPublisher {
+	return &RedisStreamPublisher{
+		rdb:    rdb,
+		stream: DefaultStream,
+		maxLen: defaultStreamMaxLen,
+	}
+}
+
+// Publish appends the event to the stream
+// The outbox event ID is sent as a field so consumers can dedupe redeliveries.
+func (p *RedisStreamPublisher) Publish(ctx context.Context, event *domain.DomainEvent) error {
+	return p.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: p.stream,
+		MaxLen: p.maxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"event_id":       event.ID.String(),
+			"event_type":     event.EventType,
+			"aggregate_type": event.AggregateType,
+			"aggregate_id":   event.AggregateID.String(),
+			"project_id":     event.ProjectID.String(),
+			"actor_id":       event.ActorID.String(),
+			"payload":        event.Payload,
+			"occurred_at":    event.CreatedAt.UTC().Format(time.RFC3339Nano),
+		},
+	}).Err()
+}
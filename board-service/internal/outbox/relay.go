@@ -0,0 +1,130 @@
+package outbox
+
+import (
+	"board-service/internal/uow"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RelayConfig controls how often the outbox is polled and how failed events are retried
+type RelayConfig struct {
+	BatchSize    int
+	PollInterval time.Duration
+	MaxAttempts  int           // Events are parked as failed after this many attempts
+	BaseBackoff  time.Duration // Delay after the first failure, doubled on every retry
+	MaxBackoff   time.Duration
+}
+
+// DefaultRelayConfig returns the relay settings used in production
+func DefaultRelayConfig() RelayConfig {
+	return RelayConfig{
+		BatchSize:    100,
+		PollInterval: time.Second,
+		MaxAttempts:  10,
+		BaseBackoff:  time.Second,
+		MaxBackoff:   5 * time.Minute,
+	}
+}
+
+// Relay publishes pending outbox events in the background
+// Events are marked as published only after the publisher succeeds, so a crash between
+// publishing and committing leads to a redelivery (at-least-once), never to a lost event.
+type Relay struct {
+	uow       uow.UnitOfWork
+	publisher Publisher
+	logger    *zap.Logger
+	cfg       RelayConfig
+	now       func() time.Time
+}
+
+// NewRelay creates a new Relay with DefaultRelayConfig
+func NewRelay(unitOfWork uow.UnitOfWork, publisher Publisher, logger *zap.Logger) *Relay {
+	return &Relay{
+		uow:       unitOfWork,
+		publisher: publisher,
+		logger:    logger,
+		cfg:       DefaultRelayConfig(),
+		now:       time.Now,
+	}
+}
+
+// Run polls the outbox until ctx is cancelled
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	r.logger.Info("Outbox relay started")
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+			// Drain the backlog before waiting for the next tick
+			for {
+				processed, err := r.ProcessBatch(ctx)
+				if err != nil {
+					r.logger.Error("Outbox relay batch failed", zap.Error(err))
+					break
+				}
+				if processed < r.cfg.BatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// ProcessBatch publishes one batch of due events and records the outcome of each
+// It returns the number of events attempted.
+func (r *Relay) ProcessBatch(ctx context.Context) (int, error) {
+	processed := 0
+
+	err := r.uow.Do(func(repos *uow.Repositories) error {
+		now := r.now()
+		events, err := repos.Event.FindPending(now, r.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range events {
+			event := &events[i]
+
+			if err := r.publisher.Publish(ctx, event); err != nil {
+				event.MarkAsFailed(err, now, r.backoff(event.Attempts), r.cfg.MaxAttempts)
+				r.logger.Warn("Failed to publish domain event",
+					zap.String("event_id", event.ID.String()),
+					zap.String("event_type", event.EventType),
+					zap.Int("attempts", event.Attempts),
+					zap.String("status", string(event.Status)),
+					zap.Error(err))
+			} else {
+				event.MarkAsPublished(now)
+			}
+
+			if err := repos.Event.Update(event); err != nil {
+				return err
+			}
+			processed++
+		}
+
+		return nil
+	})
+
+	return processed, err
+}
+
+// backoff returns the delay before the next attempt: BaseBackoff * 2^attempts, capped at MaxBackoff
+func (r *Relay) backoff(attempts int) time.Duration {
+	delay := r.cfg.BaseBackoff
+	for i := 0; i < attempts; i++ {
+		delay *= 2
+		if delay >= r.cfg.MaxBackoff {
+			return r.cfg.MaxBackoff
+		}
+	}
+	return delay
+}
@@ -0,0 +1,171 @@
+package outbox
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/uow"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// fakePublisher records published events and fails while failures > 0
+type fakePublisher struct {
+	published []uuid.UUID
+	failures  int
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, event *domain.DomainEvent) error {
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("stream unavailable")
+	}
+	p.published = append(p.published, event.ID)
+	return nil
+}
+
+// memoryEventRepository is an in-memory outbox keyed by event ID
+type memoryEventRepository struct {
+	events map[uuid.UUID]domain.DomainEvent
+	order  []uuid.UUID
+}
+
+func newMemoryEventRepository() *memoryEventRepository {
+	return &memoryEventRepository{events: make(map[uuid.UUID]domain.DomainEvent)}
+}
+
+func (r *memoryEventRepository) Create(event *domain.DomainEvent) error {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	r.events[event.ID] = *event
+	r.order = append(r.order, event.ID)
+	return nil
+}
+
+func (r *memoryEventRepository) FindPending(now time.Time, limit int) ([]domain.DomainEvent, error) {
+	var pending []domain.DomainEvent
+	for _, id := range r.order {
+		event := r.events[id]
+		if event.Status == domain.DomainEventPending && !event.NextAttemptAt.After(now) && len(pending) < limit {
+			pending = append(pending, event)
+		}
+	}
+	return pending, nil
+}
+
+func (r *memoryEventRepository) Update(event *domain.DomainEvent) error {
+	r.events[event.ID] = *event
+	return nil
+}
+
+// memoryUnitOfWork runs the function against the in-memory outbox
+type memoryUnitOfWork struct {
+	repo *memoryEventRepository
+}
+
+func (u *memoryUnitOfWork) Do(fn func(repos *uow.Repositories) error) error {
+	return fn(&uow.Repositories{Event: u.repo})
+}
+
+func (u *memoryUnitOfWork) GetDB() *gorm.DB {
+	return nil
+}
+
+func setupRelay(t *testing.T, publisher Publisher) (*Relay, *memoryEventRepository) {
+	t.Helper()
+	repo := newMemoryEventRepository()
+	relay := NewRelay(&memoryUnitOfWork{repo: repo}, publisher, zap.NewNop())
+	relay.cfg.MaxAttempts = 3
+	return relay, repo
+}
+
+func createEvent(t *testing.T, repo *memoryEventRepository) *domain.DomainEvent {
+	t.Helper()
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), Title: "Board"}
+	event, err := domain.NewBoardDomainEvent(domain.EventTypeBoardCreated, board, uuid.New())
+	require.NoError(t, err)
+	event.NextAttemptAt = time.Now().Add(-time.Second)
+	require.NoError(t, repo.Create(event))
+	return event
+}
+
+func TestRelay_ProcessBatch_PublishesPendingEvents(t *testing.T) {
+	publisher := &fakePublisher{}
+	relay, repo := setupRelay(t, publisher)
+
+	first := createEvent(t, repo)
+	second := createEvent(t, repo)
+
+	processed, err := relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, processed)
+	assert.Equal(t, []uuid.UUID{first.ID, second.ID}, publisher.published)
+
+	stored := repo.events[first.ID]
+	assert.Equal(t, domain.DomainEventPublished, stored.Status)
+	assert.Equal(t, 1, stored.Attempts)
+	assert.NotNil(t, stored.PublishedAt)
+
+	// Published events are not picked up again
+	processed, err = relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, processed)
+}
+
+func TestRelay_ProcessBatch_RetriesWithBackoffThenParks(t *testing.T) {
+	publisher := &fakePublisher{failures: 10}
+	relay, repo := setupRelay(t, publisher)
+
+	now := time.Now()
+	relay.now = func() time.Time { return now }
+	event := createEvent(t, repo)
+
+	// First failure: scheduled after the base backoff
+	_, err := relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+
+	stored := repo.events[event.ID]
+	assert.Equal(t, domain.DomainEventPending, stored.Status)
+	assert.Equal(t, 1, stored.Attempts)
+	assert.Equal(t, "stream unavailable", stored.LastError)
+	assert.Equal(t, now.Add(relay.cfg.BaseBackoff), stored.NextAttemptAt)
+
+	// Not due yet
+	processed, err := relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, processed)
+
+	// Keep failing until MaxAttempts is reached
+	for i := 0; i < relay.cfg.MaxAttempts-1; i++ {
+		now = now.Add(relay.cfg.MaxBackoff)
+		_, err = relay.ProcessBatch(context.Background())
+		require.NoError(t, err)
+	}
+
+	stored = repo.events[event.ID]
+	assert.Equal(t, domain.DomainEventFailed, stored.Status)
+	assert.Equal(t, relay.cfg.MaxAttempts, stored.Attempts)
+	assert.Empty(t, publisher.published)
+
+	// Parked events are never retried
+	now = now.Add(relay.cfg.MaxBackoff)
+	processed, err = relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, processed)
+}
+
+func TestRelay_Backoff(t *testing.T) {
+	relay := NewRelay(nil, nil, zap.NewNop())
+
+	assert.Equal(t, time.Second, relay.backoff(0))
+	assert.Equal(t, 2*time.Second, relay.backoff(1))
+	assert.Equal(t, 8*time.Second, relay.backoff(3))
+	assert.Equal(t, 5*time.Minute, relay.backoff(20))
+}
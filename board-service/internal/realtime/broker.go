@@ -0,0 +1,126 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	// channelPrefix is the Redis pub/sub channel prefix; the project ID is appended
+	channelPrefix = "board-service:events:project:"
+
+	// subscriberBufferSize is the number of events buffered per client before events are dropped
+	subscriberBufferSize = 64
+)
+
+// Broker fans project events out through Redis pub/sub
+// Every replica publishes to Redis and receives all events back via Run,
+// then dispatches them to the clients connected to that replica.
+type Broker struct {
+	rdb    *redis.Client
+	logger *zap.Logger
+
+	mu          sync.RWMutex
+	subscribers map[string]map[chan *Event]struct{} // projectID -> client channels
+}
+
+// NewBroker creates a new Broker
+func NewBroker(rdb *redis.Client, logger *zap.Logger) *Broker {
+	return &Broker{
+		rdb:         rdb,
+		logger:      logger,
+		subscribers: make(map[string]map[chan *Event]struct{}),
+	}
+}
+
+// Publish sends the event to every replica (including this one)
+func (b *Broker) Publish(ctx context.Context, event *Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return b.rdb.Publish(ctx, channelPrefix+event.ProjectID, payload).Err()
+}
+
+// Subscribe registers a local client for the project's events
+func (b *Broker) Subscribe(projectID string) (<-chan *Event, func()) {
+	ch := make(chan *Event, subscriberBufferSize)
+
+	b.mu.Lock()
+	if b.subscribers[projectID] == nil {
+		b.subscribers[projectID] = make(map[chan *Event]struct{})
+	}
+	b.subscribers[projectID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers[projectID], ch)
+			if len(b.subscribers[projectID]) == 0 {
+				delete(b.subscribers, projectID)
+			}
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+// Run receives events from Redis and dispatches them to local clients until ctx is cancelled
+func (b *Broker) Run(ctx context.Context) {
+	pubsub := b.rdb.PSubscribe(ctx, channelPrefix+"*")
+	defer pubsub.Close()
+
+	b.logger.Info("Realtime event broker started")
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			b.logger.Info("Realtime event broker stopped")
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			var event Event
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				b.logger.Warn("Failed to decode realtime event",
+					zap.String("channel", msg.Channel),
+					zap.Error(err))
+				continue
+			}
+			if event.ProjectID == "" {
+				event.ProjectID = strings.TrimPrefix(msg.Channel, channelPrefix)
+			}
+
+			b.dispatch(&event)
+		}
+	}
+}
+
+// dispatch delivers the event to the project's local clients
+// Slow clients whose buffer is full miss the event instead of blocking the others
+func (b *Broker) dispatch(event *Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subscribers[event.ProjectID] {
+		select {
+		case ch <- event:
+		default:
+			b.logger.Warn("Dropping realtime event for slow subscriber",
+				zap.String("project_id", event.ProjectID),
+				zap.String("event_type", string(event.Type)))
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package realtime
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestBroker_DispatchesOnlyToProjectSubscribers(t *testing.T) {
+	broker := NewBroker(nil, zap.NewNop())
+
+	projectID := uuid.New()
+	otherProjectID := uuid.New()
+
+	events, unsubscribe := broker.Subscribe(projectID.String())
+	defer unsubscribe()
+	otherEvents, unsubscribeOther := broker.Subscribe(otherProjectID.String())
+	defer unsubscribeOther()
+
+	event, err := NewEvent(EventBoardMoved, projectID, uuid.New(), uuid.New(), map[string]string{"stage": "done"})
+	require.NoError(t, err)
+
+	broker.dispatch(event)
+
+	select {
+	case received := <-events:
+		assert.Equal(t, EventBoardMoved, received.Type)
+		assert.JSONEq(t, `{"stage":"done"}`, string(received.Data))
+	default:
+		t.Fatal("expected event for project subscriber")
+	}
+
+	select {
+	case <-otherEvents:
+		t.Fatal("other project subscriber must not receive the event")
+	default:
+	}
+}
+
+func TestBroker_UnsubscribeClosesChannel(t *testing.T) {
+	broker := NewBroker(nil, zap.NewNop())
+	projectID := uuid.New().String()
+
+	events, unsubscribe := broker.Subscribe(projectID)
+	unsubscribe()
+	unsubscribe() // idempotent
+
+	_, open := <-events
+	assert.False(t, open)
+	assert.Empty(t, broker.subscribers)
+}
+
+func TestBroker_SlowSubscriberDoesNotBlock(t *testing.T) {
+	broker := NewBroker(nil, zap.NewNop())
+	projectID := uuid.New()
+
+	events, unsubscribe := broker.Subscribe(projectID.String())
+	defer unsubscribe()
+
+	event, err := NewEvent(EventBoardUpdated, projectID, uuid.New(), uuid.New(), nil)
+	require.NoError(t, err)
+
+	for i := 0; i < subscriberBufferSize+10; i++ {
+		broker.dispatch(event)
+	}
+
+	assert.Len(t, events, subscriberBufferSize)
+}
@@ -0,0 +1,81 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType identifies a real-time project event
+type EventType string
+
+const (
+	EventBoardCreated      EventType = "board.created"
+	EventBoardUpdated      EventType = "board.updated"
+	EventBoardMoved        EventType = "board.moved"
+	EventBoardDeleted      EventType = "board.deleted"
+	EventBoardLinkAdded    EventType = "board.link_added"
+	EventBoardLinkRemoved  EventType = "board.link_removed"
+	EventCommentCreated    EventType = "comment.created"
+	EventCommentUpdated    EventType = "comment.updated"
+	EventCommentDeleted    EventType = "comment.deleted"
+	EventMemberAdded       EventType = "member.added"
+	EventMemberRoleChanged EventType = "member.role_changed"
+	EventMemberRemoved     EventType = "member.removed"
+)
+
+// EventTypes lists every event type, e.g. for validating webhook event filters
+var EventTypes = []EventType{
+	EventBoardCreated, EventBoardUpdated, EventBoardMoved, EventBoardDeleted,
+	EventBoardLinkAdded, EventBoardLinkRemoved,
+	EventCommentCreated, EventCommentUpdated, EventCommentDeleted,
+	EventMemberAdded, EventMemberRoleChanged, EventMemberRemoved,
+}
+
+// Event is pushed to every client subscribed to the project stream
+type Event struct {
+	ID         string          `json:"id"`
+	Type       EventType       `json:"type"`
+	ProjectID  string          `json:"projectId"`
+	BoardID    string          `json:"boardId,omitempty"`
+	ActorID    string          `json:"actorId"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	OccurredAt time.Time       `json:"occurredAt"`
+}
+
+// NewEvent creates an event with the given payload serialized as JSON
+func NewEvent(eventType EventType, projectID, boardID, actorID uuid.UUID, data interface{}) (*Event, error) {
+	event := &Event{
+		ID:         uuid.New().String(),
+		Type:       eventType,
+		ProjectID:  projectID.String(),
+		ActorID:    actorID.String(),
+		OccurredAt: time.Now(),
+	}
+	if boardID != uuid.Nil {
+		event.BoardID = boardID.String()
+	}
+
+	if data != nil {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		event.Data = raw
+	}
+
+	return event, nil
+}
+
+// Publisher publishes project events to all board-service replicas
+type Publisher interface {
+	Publish(ctx context.Context, event *Event) error
+}
+
+// Subscriber delivers project events received by this replica to local clients
+type Subscriber interface {
+	// Subscribe returns a channel of events for the project and a function to stop receiving them
+	Subscribe(projectID string) (<-chan *Event, func())
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/repository/base"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AttachmentRepository는 Attachment 메타데이터만 관리합니다 (파일 내용은 storage.Storage)
+type AttachmentRepository interface {
+	// 공통 CRUD 메서드 (base repository에서 제공)
+	Create(attachment *domain.Attachment) error
+	FindByID(id uuid.UUID) (*domain.Attachment, error)
+	Delete(id uuid.UUID) error
+
+	// Attachment 전용 메서드
+	FindByBoard(boardID uuid.UUID) ([]domain.Attachment, error)
+	FindByComment(commentID uuid.UUID) ([]domain.Attachment, error)
+	DeleteByBoard(boardID uuid.UUID) error
+}
+
+type attachmentRepository struct {
+	base.BaseRepository[*domain.Attachment]
+	db *gorm.DB
+}
+
+// NewAttachmentRepository는 새로운 AttachmentRepository를 생성합니다
+func NewAttachmentRepository(db *gorm.DB) AttachmentRepository {
+	return &attachmentRepository{
+		BaseRepository: base.NewBaseRepository[*domain.Attachment](db),
+		db:             db,
+	}
+}
+
+// ==================== 공통 CRUD는 base repository에 위임 ====================
+// Create, FindByID, Delete(soft)는 BaseRepository의 구현을 사용합니다
+
+// ==================== Attachment 전용 메서드 ====================
+
+// FindByBoard는 보드와 보드 댓글의 모든 첨부파일을 업로드 순으로 조회합니다
+func (r *attachmentRepository) FindByBoard(boardID uuid.UUID) ([]domain.Attachment, error) {
+	var attachments []domain.Attachment
+	err := r.db.
+		Where("board_id = ? AND is_deleted = ?", boardID, false).
+		Order("created_at ASC").
+		Find(&attachments).Error
+	return attachments, err
+}
+
+// FindByComment는 댓글의 첨부파일을 업로드 순으로 조회합니다
+func (r *attachmentRepository) FindByComment(commentID uuid.UUID) ([]domain.Attachment, error) {
+	var attachments []domain.Attachment
+	err := r.db.
+		Where("comment_id = ? AND is_deleted = ?", commentID, false).
+		Order("created_at ASC").
+		Find(&attachments).Error
+	return attachments, err
+}
+
+// DeleteByBoard는 보드가 삭제될 때 보드와 보드 댓글의 모든 첨부파일을 soft delete합니다
+func (r *attachmentRepository) DeleteByBoard(boardID uuid.UUID) error {
+	return r.db.Model(&domain.Attachment{}).
+		Where("board_id = ? AND is_deleted = ?", boardID, false).
+		Update("is_deleted", true).Error
+}
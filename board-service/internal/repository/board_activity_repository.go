@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BoardActivityRepository는 BoardActivity 엔티티만 관리합니다
+// 활동 기록은 append-only 이므로 수정/삭제 메서드를 제공하지 않습니다
+type BoardActivityRepository interface {
+	Create(activity *domain.BoardActivity) error
+	FindByBoard(boardID uuid.UUID, page, limit int) ([]domain.BoardActivity, int64, error)
+}
+
+type boardActivityRepository struct {
+	db *gorm.DB
+}
+
+// NewBoardActivityRepository는 새로운 BoardActivityRepository를 생성합니다
+func NewBoardActivityRepository(db *gorm.DB) BoardActivityRepository {
+	return &boardActivityRepository{db: db}
+}
+
+func (r *boardActivityRepository) Create(activity *domain.BoardActivity) error {
+	return r.db.Create(activity).Error
+}
+
+// FindByBoard는 보드의 활동 기록을 최신순으로 조회합니다
+func (r *boardActivityRepository) FindByBoard(boardID uuid.UUID, page, limit int) ([]domain.BoardActivity, int64, error) {
+	var activities []domain.BoardActivity
+	var total int64
+
+	query := r.db.Model(&domain.BoardActivity{}).Where("board_id = ?", boardID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&activities).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return activities, total, nil
+}
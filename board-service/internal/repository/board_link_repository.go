@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BoardLinkRepository는 BoardLink 엔티티만 관리합니다
+// 링크는 내용이 없는 관계이므로 soft delete 대신 물리 삭제합니다
+type BoardLinkRepository interface {
+	Create(link *domain.BoardLink) error
+	FindByID(id uuid.UUID) (*domain.BoardLink, error)
+	Delete(id uuid.UUID) error
+
+	// BoardLink 전용 메서드
+	FindByBoard(boardID uuid.UUID) ([]domain.BoardLink, error)
+	FindByBoards(boardIDs []uuid.UUID) ([]domain.BoardLink, error)
+	FindBetween(boardID, otherBoardID uuid.UUID) ([]domain.BoardLink, error)
+	FindBlockingByProject(projectID uuid.UUID) ([]domain.BoardLink, error)
+	DeleteByBoard(boardID uuid.UUID) error
+	LockProject(projectID uuid.UUID) error
+}
+
+type boardLinkRepository struct {
+	db *gorm.DB
+}
+
+// NewBoardLinkRepository는 새로운 BoardLinkRepository를 생성합니다
+func NewBoardLinkRepository(db *gorm.DB) BoardLinkRepository {
+	return &boardLinkRepository{db: db}
+}
+
+func (r *boardLinkRepository) Create(link *domain.BoardLink) error {
+	return r.db.Create(link).Error
+}
+
+func (r *boardLinkRepository) FindByID(id uuid.UUID) (*domain.BoardLink, error) {
+	var link domain.BoardLink
+	if err := r.db.Where("id = ?", id).First(&link).Error; err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+func (r *boardLinkRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&domain.BoardLink{}).Error
+}
+
+// FindByBoard는 보드가 양쪽 어디에든 포함된 링크를 생성순으로 조회합니다
+func (r *boardLinkRepository) FindByBoard(boardID uuid.UUID) ([]domain.BoardLink, error) {
+	return r.FindByBoards([]uuid.UUID{boardID})
+}
+
+// FindByBoards는 여러 보드의 링크를 한 번에 조회합니다 (목록 응답의 N+1 방지)
+func (r *boardLinkRepository) FindByBoards(boardIDs []uuid.UUID) ([]domain.BoardLink, error) {
+	var links []domain.BoardLink
+	if len(boardIDs) == 0 {
+		return links, nil
+	}
+	err := r.db.
+		Where("source_board_id IN ? OR target_board_id IN ?", boardIDs, boardIDs).
+		Order("created_at ASC, id ASC").
+		Find(&links).Error
+	return links, err
+}
+
+// FindBetween은 두 보드 사이의 링크를 방향과 관계없이 조회합니다
+func (r *boardLinkRepository) FindBetween(boardID, otherBoardID uuid.UUID) ([]domain.BoardLink, error) {
+	var links []domain.BoardLink
+	err := r.db.
+		Where("(source_board_id = ? AND target_board_id = ?) OR (source_board_id = ? AND target_board_id = ?)",
+			boardID, otherBoardID, otherBoardID, boardID).
+		Find(&links).Error
+	return links, err
+}
+
+// FindBlockingByProject는 프로젝트의 모든 blocks 링크를 조회합니다 (순환 검사용)
+func (r *boardLinkRepository) FindBlockingByProject(projectID uuid.UUID) ([]domain.BoardLink, error) {
+	var links []domain.BoardLink
+	err := r.db.
+		Where("project_id = ? AND link_type = ?", projectID, domain.BoardLinkBlocks).
+		Find(&links).Error
+	return links, err
+}
+
+// DeleteByBoard는 보드가 삭제될 때 해당 보드의 모든 링크를 삭제합니다
+func (r *boardLinkRepository) DeleteByBoard(boardID uuid.UUID) error {
+	return r.db.
+		Where("source_board_id = ? OR target_board_id = ?", boardID, boardID).
+		Delete(&domain.BoardLink{}).Error
+}
+
+// LockProject는 트랜잭션 동안 프로젝트 행을 잠급니다
+// 동시에 추가된 링크들이 순환 검사를 서로 우회하지 못하도록 링크 생성을 프로젝트 단위로 직렬화합니다
+func (r *boardLinkRepository) LockProject(projectID uuid.UUID) error {
+	var project domain.Project
+	return r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", projectID).
+		First(&project).Error
+}
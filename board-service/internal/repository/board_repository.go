@@ -11,8 +11,10 @@ type BoardRepository interface {
 	// CRUD
 	Create(board *domain.Board) error
 	FindByID(id uuid.UUID) (*domain.Board, error)
+	FindByIDs(ids []uuid.UUID) ([]domain.Board, error)
 	FindByProject(projectID uuid.UUID, filters BoardFilters, page, limit int) ([]domain.Board, int64, error)
 	Update(board *domain.Board) error
+	UpdateFieldsCache(id uuid.UUID, cache string) error
 	Delete(id uuid.UUID) error
 }
 
@@ -45,6 +47,18 @@ func (r *boardRepository) FindByID(id uuid.UUID) (*domain.Board, error) {
 	return &board, nil
 }
 
+// FindByIDs returns the non-deleted boards among ids (order not guaranteed)
+func (r *boardRepository) FindByIDs(ids []uuid.UUID) ([]domain.Board, error) {
+	var boards []domain.Board
+	if len(ids) == 0 {
+		return boards, nil
+	}
+	if err := r.db.Where("id IN ? AND is_deleted = ?", ids, false).Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
 func (r *boardRepository) FindByProject(projectID uuid.UUID, filters BoardFilters, page, limit int) ([]domain.Board, int64, error) {
 	var boards []domain.Board
 	var total int64
@@ -81,6 +95,11 @@ func (r *boardRepository) Update(board *domain.Board) error {
 	return r.db.Save(board).Error
 }
 
+// UpdateFieldsCache replaces the board's custom_fields_cache only
+func (r *boardRepository) UpdateFieldsCache(id uuid.UUID, cache string) error {
+	return r.db.Model(&domain.Board{}).Where("id = ?", id).Update("custom_fields_cache", cache).Error
+}
+
 func (r *boardRepository) Delete(id uuid.UUID) error {
 	// Soft delete
 	return r.db.Model(&domain.Board{}).Where("id = ?", id).Update("is_deleted", true).Error
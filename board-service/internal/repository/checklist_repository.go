@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/repository/base"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ChecklistRepository는 ChecklistItem 엔티티만 관리합니다
+type ChecklistRepository interface {
+	// 공통 CRUD 메서드 (base repository에서 제공)
+	Create(item *domain.ChecklistItem) error
+	FindByID(id uuid.UUID) (*domain.ChecklistItem, error)
+	Update(item *domain.ChecklistItem) error
+	Delete(id uuid.UUID) error
+
+	// ChecklistItem 전용 메서드
+	FindByBoard(boardID uuid.UUID) ([]domain.ChecklistItem, error)
+	FindLastPosition(boardID uuid.UUID) (string, error)
+	CountProgressByBoards(boardIDs []uuid.UUID) (map[uuid.UUID]domain.ChecklistProgress, error)
+}
+
+type checklistRepository struct {
+	base.BaseRepository[*domain.ChecklistItem]
+	db *gorm.DB
+}
+
+// NewChecklistRepository는 새로운 ChecklistRepository를 생성합니다
+func NewChecklistRepository(db *gorm.DB) ChecklistRepository {
+	return &checklistRepository{
+		BaseRepository: base.NewBaseRepository[*domain.ChecklistItem](db),
+		db:             db,
+	}
+}
+
+// ==================== 공통 CRUD는 base repository에 위임 ====================
+// Create, FindByID, Update, Delete(soft)는 BaseRepository의 구현을 사용합니다
+
+// ==================== ChecklistItem 전용 메서드 ====================
+
+// FindByBoard는 보드의 체크리스트 항목을 position 순으로 조회합니다
+func (r *checklistRepository) FindByBoard(boardID uuid.UUID) ([]domain.ChecklistItem, error) {
+	var items []domain.ChecklistItem
+	err := r.db.
+		Where("board_id = ? AND is_deleted = ?", boardID, false).
+		Order("position ASC, created_at ASC").
+		Find(&items).Error
+	return items, err
+}
+
+// FindLastPosition은 보드의 마지막 체크리스트 항목 position을 조회합니다 (없으면 빈 문자열)
+func (r *checklistRepository) FindLastPosition(boardID uuid.UUID) (string, error) {
+	var positions []string
+	err := r.db.Model(&domain.ChecklistItem{}).
+		Where("board_id = ? AND is_deleted = ?", boardID, false).
+		Order("position DESC").
+		Limit(1).
+		Pluck("position", &positions).Error
+	if err != nil || len(positions) == 0 {
+		return "", err
+	}
+	return positions[0], nil
+}
+
+// CountProgressByBoards는 보드별 완료/전체 항목 수를 한 번의 GROUP BY 쿼리로 집계합니다
+// 체크리스트가 없는 보드는 결과에 포함되지 않습니다
+func (r *checklistRepository) CountProgressByBoards(boardIDs []uuid.UUID) (map[uuid.UUID]domain.ChecklistProgress, error) {
+	result := make(map[uuid.UUID]domain.ChecklistProgress)
+	if len(boardIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		BoardID uuid.UUID
+		Done    int
+		Total   int
+	}
+	err := r.db.Model(&domain.ChecklistItem{}).
+		Select("board_id, COUNT(CASE WHEN is_done THEN 1 END) AS done, COUNT(*) AS total").
+		Where("board_id IN ? AND is_deleted = ?", boardIDs, false).
+		Group("board_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.BoardID] = domain.ChecklistProgress{Done: row.Done, Total: row.Total}
+	}
+	return result, nil
+}
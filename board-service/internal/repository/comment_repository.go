@@ -48,7 +48,20 @@ func (r *commentRepository) Update(comment *domain.Comment) error {
 	return r.db.Save(comment).Error
 }
 
-// Delete removes a comment from the database.
+// Delete removes a comment and all of its replies from the database.
 func (r *commentRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&domain.Comment{}, "id = ?", id).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Collect the reply thread level by level (depth is bounded by domain.MaxCommentDepth)
+		ids := []uuid.UUID{id}
+		level := []uuid.UUID{id}
+		for len(level) > 0 {
+			var childIDs []uuid.UUID
+			if err := tx.Model(&domain.Comment{}).Where("parent_id IN ?", level).Pluck("id", &childIDs).Error; err != nil {
+				return err
+			}
+			ids = append(ids, childIDs...)
+			level = childIDs
+		}
+		return tx.Delete(&domain.Comment{}, "id IN ?", ids).Error
+	})
 }
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DomainEventRepository는 transactional outbox(domain_events)를 관리합니다
+// Create는 비즈니스 변경과 같은 트랜잭션(UnitOfWork) 안에서 호출되어야 합니다
+type DomainEventRepository interface {
+	Create(event *domain.DomainEvent) error
+	FindPending(now time.Time, limit int) ([]domain.DomainEvent, error)
+	Update(event *domain.DomainEvent) error
+}
+
+type domainEventRepository struct {
+	db *gorm.DB
+}
+
+// NewDomainEventRepository는 새로운 DomainEventRepository를 생성합니다
+func NewDomainEventRepository(db *gorm.DB) DomainEventRepository {
+	return &domainEventRepository{db: db}
+}
+
+func (r *domainEventRepository) Create(event *domain.DomainEvent) error {
+	return r.db.Create(event).Error
+}
+
+// FindPending는 발행 시각이 도래한 pending 이벤트를 생성 순서대로 조회합니다
+// 여러 replica의 relay가 같은 행을 동시에 가져가지 않도록 SKIP LOCKED를 사용합니다
+// (트랜잭션 안에서 호출해야 잠금이 유지됩니다)
+func (r *domainEventRepository) FindPending(now time.Time, limit int) ([]domain.DomainEvent, error) {
+	var events []domain.DomainEvent
+	err := r.db.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND next_attempt_at <= ?", domain.DomainEventPending, now).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+// Update는 발행 결과(상태, 시도 횟수, 다음 시도 시각)를 저장합니다
+func (r *domainEventRepository) Update(event *domain.DomainEvent) error {
+	return r.db.Model(event).Select("status", "attempts", "last_error", "next_attempt_at", "published_at").Updates(event).Error
+}
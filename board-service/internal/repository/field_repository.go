@@ -12,6 +12,8 @@ type FieldRepository interface {
 	// ==================== Project Field Methods ====================
 	CreateField(field *domain.ProjectField) error
 	FindFieldByID(id uuid.UUID) (*domain.ProjectField, error)
+	FindFieldByIDForUpdate(id uuid.UUID) (*domain.ProjectField, error) // Locks the field row until the transaction ends
+	FindFieldByIDForShare(id uuid.UUID) (*domain.ProjectField, error)  // Share-locks the field row until the transaction ends
 	FindFieldsByProject(projectID uuid.UUID) ([]domain.ProjectField, error)
 	FindFieldsByIDs(ids []uuid.UUID) ([]domain.ProjectField, error)
 	UpdateField(field *domain.ProjectField) error
@@ -34,10 +36,14 @@ type FieldRepository interface {
 	FindFieldValuesByBoard(boardID uuid.UUID) ([]domain.BoardFieldValue, error)
 	FindFieldValuesByBoardAndField(boardID, fieldID uuid.UUID) ([]domain.BoardFieldValue, error)
 	FindFieldValuesByBoards(boardIDs []uuid.UUID) (map[uuid.UUID][]domain.BoardFieldValue, error)
+	FindFieldValuesByField(fieldID uuid.UUID) ([]domain.BoardFieldValue, error)
 	DeleteFieldValue(boardID, fieldID uuid.UUID) error
 	DeleteFieldValueByID(id uuid.UUID) error
 	BatchSetFieldValues(values []domain.BoardFieldValue) error
 	BatchDeleteFieldValues(boardID, fieldID uuid.UUID) error
+	DeleteFieldValuesByField(fieldID uuid.UUID) error
+	RemapOptionValues(fieldID, fromOptionID, toOptionID uuid.UUID) ([]uuid.UUID, error)
+	CountOptionValues(fieldID, optionID uuid.UUID) (int64, error)
 
 	// Cache update
 	UpdateBoardFieldCache(boardID uuid.UUID) (string, error)
@@ -49,6 +55,9 @@ type FieldRepository interface {
 	FindDefaultView(projectID uuid.UUID) (*domain.SavedView, error)
 	UpdateView(view *domain.SavedView) error
 	DeleteView(id uuid.UUID) error
+	FindViewUserSettings(viewID, userID uuid.UUID) (*domain.SavedViewUserSettings, error)
+	SaveViewUserSettings(settings *domain.SavedViewUserSettings) error
+	DeleteViewUserSettings(viewID, userID uuid.UUID) error
 
 	// ==================== User Board Order Methods ====================
 	SetBoardOrder(order *domain.UserBoardOrder) error
@@ -89,6 +98,14 @@ func (r *fieldRepository) FindFieldByID(id uuid.UUID) (*domain.ProjectField, err
 	return r.projectField.FindByID(id)
 }
 
+func (r *fieldRepository) FindFieldByIDForUpdate(id uuid.UUID) (*domain.ProjectField, error) {
+	return r.projectField.FindByIDForUpdate(id)
+}
+
+func (r *fieldRepository) FindFieldByIDForShare(id uuid.UUID) (*domain.ProjectField, error) {
+	return r.projectField.FindByIDForShare(id)
+}
+
 func (r *fieldRepository) FindFieldsByProject(projectID uuid.UUID) ([]domain.ProjectField, error) {
 	return r.projectField.FindByProject(projectID)
 }
@@ -183,6 +200,22 @@ func (r *fieldRepository) BatchDeleteFieldValues(boardID, fieldID uuid.UUID) err
 	return r.value.BatchDelete(boardID, fieldID)
 }
 
+func (r *fieldRepository) FindFieldValuesByField(fieldID uuid.UUID) ([]domain.BoardFieldValue, error) {
+	return r.value.FindByField(fieldID)
+}
+
+func (r *fieldRepository) DeleteFieldValuesByField(fieldID uuid.UUID) error {
+	return r.value.DeleteByField(fieldID)
+}
+
+func (r *fieldRepository) RemapOptionValues(fieldID, fromOptionID, toOptionID uuid.UUID) ([]uuid.UUID, error) {
+	return r.value.RemapOption(fieldID, fromOptionID, toOptionID)
+}
+
+func (r *fieldRepository) CountOptionValues(fieldID, optionID uuid.UUID) (int64, error) {
+	return r.value.CountByOption(fieldID, optionID)
+}
+
 func (r *fieldRepository) UpdateBoardFieldCache(boardID uuid.UUID) (string, error) {
 	return r.value.UpdateBoardCache(boardID)
 }
@@ -214,6 +247,18 @@ func (r *fieldRepository) DeleteView(id uuid.UUID) error {
 	return r.view.Delete(id)
 }
 
+func (r *fieldRepository) FindViewUserSettings(viewID, userID uuid.UUID) (*domain.SavedViewUserSettings, error) {
+	return r.view.FindUserSettings(viewID, userID)
+}
+
+func (r *fieldRepository) SaveViewUserSettings(settings *domain.SavedViewUserSettings) error {
+	return r.view.SaveUserSettings(settings)
+}
+
+func (r *fieldRepository) DeleteViewUserSettings(viewID, userID uuid.UUID) error {
+	return r.view.DeleteUserSettings(viewID, userID)
+}
+
 // ==================== User Board Order Implementation ====================
 // 내부적으로 BoardOrderRepository 위임
 
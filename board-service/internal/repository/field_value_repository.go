@@ -14,10 +14,14 @@ type FieldValueRepository interface {
 	FindByBoard(boardID uuid.UUID) ([]domain.BoardFieldValue, error)
 	FindByBoardAndField(boardID, fieldID uuid.UUID) ([]domain.BoardFieldValue, error)
 	FindByBoards(boardIDs []uuid.UUID) (map[uuid.UUID][]domain.BoardFieldValue, error)
+	FindByField(fieldID uuid.UUID) ([]domain.BoardFieldValue, error)
 	Delete(boardID, fieldID uuid.UUID) error
 	DeleteByID(id uuid.UUID) error
 	BatchSet(values []domain.BoardFieldValue) error
 	BatchDelete(boardID, fieldID uuid.UUID) error
+	DeleteByField(fieldID uuid.UUID) error
+	RemapOption(fieldID, fromOptionID, toOptionID uuid.UUID) ([]uuid.UUID, error)
+	CountByOption(fieldID, optionID uuid.UUID) (int64, error)
 	UpdateBoardCache(boardID uuid.UUID) (string, error) // JSON 캐시 업데이트
 }
 
@@ -74,6 +78,16 @@ func (r *fieldValueRepository) FindByBoards(boardIDs []uuid.UUID) (map[uuid.UUID
 	return result, nil
 }
 
+func (r *fieldValueRepository) FindByField(fieldID uuid.UUID) ([]domain.BoardFieldValue, error) {
+	var values []domain.BoardFieldValue
+	if err := r.db.Where("field_id = ? AND is_deleted = ?", fieldID, false).
+		Order("board_id, display_order ASC").
+		Find(&values).Error; err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
 func (r *fieldValueRepository) Delete(boardID, fieldID uuid.UUID) error {
 	return r.db.Model(&domain.BoardFieldValue{}).
 		Where("board_id = ? AND field_id = ?", boardID, fieldID).
@@ -103,6 +117,52 @@ func (r *fieldValueRepository) BatchDelete(boardID, fieldID uuid.UUID) error {
 		Update("is_deleted", true).Error
 }
 
+// DeleteByField는 모든 보드에서 필드의 값을 삭제합니다
+func (r *fieldValueRepository) DeleteByField(fieldID uuid.UUID) error {
+	return r.db.Model(&domain.BoardFieldValue{}).
+		Where("field_id = ?", fieldID).
+		Update("is_deleted", true).Error
+}
+
+// RemapOption은 필드 값의 옵션을 다른 옵션으로 바꾸고 영향받은 보드 ID를 반환합니다
+// 이미 대상 옵션을 가진 보드(multi-select)는 중복 대신 기존 값을 삭제합니다
+func (r *fieldValueRepository) RemapOption(fieldID, fromOptionID, toOptionID uuid.UUID) ([]uuid.UUID, error) {
+	var boardIDs []uuid.UUID
+	if err := r.db.Model(&domain.BoardFieldValue{}).
+		Where("field_id = ? AND value_option_id = ? AND is_deleted = ?", fieldID, fromOptionID, false).
+		Distinct().
+		Pluck("board_id", &boardIDs).Error; err != nil {
+		return nil, err
+	}
+	if len(boardIDs) == 0 {
+		return nil, nil
+	}
+
+	holdingTarget := r.db.Model(&domain.BoardFieldValue{}).
+		Select("board_id").
+		Where("field_id = ? AND value_option_id = ? AND is_deleted = ?", fieldID, toOptionID, false)
+	if err := r.db.Model(&domain.BoardFieldValue{}).
+		Where("field_id = ? AND value_option_id = ? AND is_deleted = ? AND board_id IN (?)", fieldID, fromOptionID, false, holdingTarget).
+		Update("is_deleted", true).Error; err != nil {
+		return nil, err
+	}
+	if err := r.db.Model(&domain.BoardFieldValue{}).
+		Where("field_id = ? AND value_option_id = ? AND is_deleted = ?", fieldID, fromOptionID, false).
+		Update("value_option_id", toOptionID).Error; err != nil {
+		return nil, err
+	}
+	return boardIDs, nil
+}
+
+// CountByOption은 옵션을 값으로 가진 필드 값의 수를 반환합니다
+func (r *fieldValueRepository) CountByOption(fieldID, optionID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.BoardFieldValue{}).
+		Where("field_id = ? AND value_option_id = ? AND is_deleted = ?", fieldID, optionID, false).
+		Count(&count).Error
+	return count, err
+}
+
 // UpdateBoardCache는 보드의 custom_fields_cache를 업데이트합니다
 func (r *fieldValueRepository) UpdateBoardCache(boardID uuid.UUID) (string, error) {
 	// 서비스 레이어에서 구현될 예정 (JSON 마샬링 필요)
@@ -22,6 +22,14 @@ package repository
 // - FieldValueRepository  : BoardFieldValue 엔티티 관리
 // - ViewRepository        : SavedView 엔티티 관리
 // - BoardOrderRepository  : UserBoardOrder 엔티티 관리
+// - BoardActivityRepository: BoardActivity 엔티티 관리 (append-only 활동 기록)
+// - MentionRepository     : Mention 엔티티 관리 (사용자별 멘션 인박스)
+// - NotificationRepository: Notification 엔티티 및 알림 설정 관리
+// - DomainEventRepository : DomainEvent 엔티티 관리 (transactional outbox)
+// - WebhookRepository     : Webhook 및 WebhookDelivery 엔티티 관리
+// - BoardLinkRepository   : BoardLink 엔티티 관리 (보드 간 의존 관계)
+// - ChecklistRepository   : ChecklistItem 엔티티 관리 (보드 체크리스트)
+// - AttachmentRepository  : Attachment 메타데이터 관리 (파일 내용은 storage 패키지)
 //
 // 각 인터페이스의 상세 정의는 해당 파일을 참조하세요:
 // - board_repository.go
@@ -34,6 +42,14 @@ package repository
 // - field_value_repository.go
 // - view_repository.go
 // - board_order_repository.go
+// - board_activity_repository.go
+// - mention_repository.go
+// - notification_repository.go
+// - domain_event_repository.go
+// - webhook_repository.go
+// - board_link_repository.go
+// - checklist_repository.go
+// - attachment_repository.go
 //
 // ==================== 사용 예시 ====================
 //
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/repository/base"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MentionRepository는 Mention 엔티티만 관리합니다
+type MentionRepository interface {
+	// 공통 CRUD 메서드 (base repository에서 제공)
+	FindByID(id uuid.UUID) (*domain.Mention, error)
+	Update(mention *domain.Mention) error
+
+	// Mention 전용 메서드
+	CreateBatch(mentions []domain.Mention) error
+	FindUserIDsBySource(sourceID uuid.UUID) ([]uuid.UUID, error)
+	FindByUser(userID uuid.UUID, unreadOnly bool, page, limit int) ([]domain.Mention, int64, error)
+	CountUnread(userID uuid.UUID) (int64, error)
+	MarkAllAsRead(userID uuid.UUID) (int64, error)
+}
+
+type mentionRepository struct {
+	base.BaseRepository[*domain.Mention]
+	db *gorm.DB
+}
+
+// NewMentionRepository는 새로운 MentionRepository를 생성합니다
+func NewMentionRepository(db *gorm.DB) MentionRepository {
+	return &mentionRepository{
+		BaseRepository: base.NewBaseRepository[*domain.Mention](db),
+		db:             db,
+	}
+}
+
+// ==================== 공통 CRUD는 base repository에 위임 ====================
+// FindByID, Update는 BaseRepository의 구현을 사용합니다
+
+// ==================== Mention 전용 메서드 ====================
+
+// CreateBatch는 멘션을 일괄 생성합니다
+// 같은 source에서 이미 멘션된 사용자는 무시합니다 (ON CONFLICT DO NOTHING)
+func (r *mentionRepository) CreateBatch(mentions []domain.Mention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "source_id"}, {Name: "mentioned_user_id"}},
+		DoNothing: true,
+	}).Create(&mentions).Error
+}
+
+// FindUserIDsBySource는 해당 source(댓글 또는 보드)에서 이미 멘션된 사용자 ID를 조회합니다
+func (r *mentionRepository) FindUserIDsBySource(sourceID uuid.UUID) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := r.db.Model(&domain.Mention{}).
+		Where("source_id = ? AND is_deleted = ?", sourceID, false).
+		Pluck("mentioned_user_id", &userIDs).Error
+	return userIDs, err
+}
+
+func (r *mentionRepository) FindByUser(userID uuid.UUID, unreadOnly bool, page, limit int) ([]domain.Mention, int64, error) {
+	var mentions []domain.Mention
+	var total int64
+
+	query := r.db.Model(&domain.Mention{}).Where("mentioned_user_id = ? AND is_deleted = ?", userID, false)
+	if unreadOnly {
+		query = query.Where("is_read = ?", false)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&mentions).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return mentions, total, nil
+}
+
+func (r *mentionRepository) CountUnread(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.Mention{}).
+		Where("mentioned_user_id = ? AND is_read = ? AND is_deleted = ?", userID, false, false).
+		Count(&count).Error
+	return count, err
+}
+
+// MarkAllAsRead는 사용자의 읽지 않은 멘션을 모두 읽음 처리하고 변경된 개수를 반환합니다
+func (r *mentionRepository) MarkAllAsRead(userID uuid.UUID) (int64, error) {
+	result := r.db.Model(&domain.Mention{}).
+		Where("mentioned_user_id = ? AND is_read = ? AND is_deleted = ?", userID, false, false).
+		Updates(map[string]interface{}{
+			"is_read": true,
+			"read_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
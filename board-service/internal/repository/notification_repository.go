@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/repository/base"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NotificationRepository는 Notification 엔티티와 사용자별 알림 설정을 관리합니다
+type NotificationRepository interface {
+	// 공통 CRUD 메서드 (base repository에서 제공)
+	FindByID(id uuid.UUID) (*domain.Notification, error)
+	Update(notification *domain.Notification) error
+
+	// Notification 전용 메서드
+	CreateBatch(notifications []domain.Notification) error
+	FindByUser(userID uuid.UUID, unreadOnly bool, page, limit int) ([]domain.Notification, int64, error)
+	CountUnread(userID uuid.UUID) (int64, error)
+	MarkAllAsRead(userID uuid.UUID) (int64, error)
+
+	// NotificationPreference 관련 메서드
+	FindPreference(userID, projectID uuid.UUID) (*domain.NotificationPreference, error)
+	FindPreferencesByUsers(userIDs []uuid.UUID, projectID uuid.UUID) ([]domain.NotificationPreference, error)
+	SavePreference(preference *domain.NotificationPreference) error
+}
+
+type notificationRepository struct {
+	base.BaseRepository[*domain.Notification]
+	db *gorm.DB
+}
+
+// NewNotificationRepository는 새로운 NotificationRepository를 생성합니다
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{
+		BaseRepository: base.NewBaseRepository[*domain.Notification](db),
+		db:             db,
+	}
+}
+
+// ==================== 공통 CRUD는 base repository에 위임 ====================
+// FindByID, Update는 BaseRepository의 구현을 사용합니다
+
+// ==================== Notification 전용 메서드 ====================
+
+func (r *notificationRepository) CreateBatch(notifications []domain.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	return r.db.Create(&notifications).Error
+}
+
+func (r *notificationRepository) FindByUser(userID uuid.UUID, unreadOnly bool, page, limit int) ([]domain.Notification, int64, error) {
+	var notifications []domain.Notification
+	var total int64
+
+	query := r.db.Model(&domain.Notification{}).Where("user_id = ? AND is_deleted = ?", userID, false)
+	if unreadOnly {
+		query = query.Where("is_read = ?", false)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&notifications).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, total, nil
+}
+
+func (r *notificationRepository) CountUnread(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.Notification{}).
+		Where("user_id = ? AND is_read = ? AND is_deleted = ?", userID, false, false).
+		Count(&count).Error
+	return count, err
+}
+
+// MarkAllAsRead는 사용자의 읽지 않은 알림을 모두 읽음 처리하고 변경된 개수를 반환합니다
+func (r *notificationRepository) MarkAllAsRead(userID uuid.UUID) (int64, error) {
+	result := r.db.Model(&domain.Notification{}).
+		Where("user_id = ? AND is_read = ? AND is_deleted = ?", userID, false, false).
+		Updates(map[string]interface{}{
+			"is_read": true,
+			"read_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
+
+// ==================== NotificationPreference 관련 메서드 ====================
+
+func (r *notificationRepository) FindPreference(userID, projectID uuid.UUID) (*domain.NotificationPreference, error) {
+	var preference domain.NotificationPreference
+	err := r.db.Where("user_id = ? AND project_id = ? AND is_deleted = ?", userID, projectID, false).
+		First(&preference).Error
+	if err != nil {
+		return nil, err
+	}
+	return &preference, nil
+}
+
+// FindPreferencesByUsers는 여러 사용자의 프로젝트 알림 설정을 한 번에 조회합니다
+// 설정을 저장하지 않은 사용자는 결과에 포함되지 않습니다
+func (r *notificationRepository) FindPreferencesByUsers(userIDs []uuid.UUID, projectID uuid.UUID) ([]domain.NotificationPreference, error) {
+	var preferences []domain.NotificationPreference
+	if len(userIDs) == 0 {
+		return preferences, nil
+	}
+	err := r.db.Where("user_id IN ? AND project_id = ? AND is_deleted = ?", userIDs, projectID, false).
+		Find(&preferences).Error
+	return preferences, err
+}
+
+// SavePreference는 (user, project) 기준으로 알림 설정을 생성하거나 갱신합니다
+func (r *notificationRepository) SavePreference(preference *domain.NotificationPreference) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "project_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"assignments", "due_dates", "comments", "mentions", "join_requests", "role_changes", "updated_at",
+		}),
+	}).Create(preference).Error
+}
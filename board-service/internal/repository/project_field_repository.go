@@ -5,6 +5,7 @@ import (
 	"board-service/internal/repository/base"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ProjectFieldRepository는 ProjectField 엔티티만 관리합니다
@@ -18,6 +19,8 @@ type ProjectFieldRepository interface {
 	// ProjectField 전용 메서드
 	FindByProject(projectID uuid.UUID) ([]domain.ProjectField, error)
 	FindByIDs(ids []uuid.UUID) ([]domain.ProjectField, error)
+	FindByIDForUpdate(id uuid.UUID) (*domain.ProjectField, error)
+	FindByIDForShare(id uuid.UUID) (*domain.ProjectField, error)
 	UpdateOrder(fieldID uuid.UUID, newOrder int) error
 	BatchUpdateOrders(orders map[uuid.UUID]int) error
 }
@@ -60,6 +63,30 @@ func (r *projectFieldRepository) FindByIDs(ids []uuid.UUID) ([]domain.ProjectFie
 	return fields, nil
 }
 
+// FindByIDForUpdate는 필드를 조회하고 트랜잭션이 끝날 때까지 행을 잠급니다
+// 타입 변환처럼 필드의 값 전체를 다시 쓰는 작업이 동시에 실행되지 않도록 직렬화합니다
+func (r *projectFieldRepository) FindByIDForUpdate(id uuid.UUID) (*domain.ProjectField, error) {
+	var field domain.ProjectField
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&field).Error; err != nil {
+		return nil, err
+	}
+	return &field, nil
+}
+
+// FindByIDForShare는 필드를 조회하고 트랜잭션이 끝날 때까지 공유 잠금을 유지합니다
+// 값 쓰기끼리는 막지 않지만, 잠금이 풀릴 때까지 FindByIDForUpdate를 사용하는 타입 변환을 대기시킵니다
+func (r *projectFieldRepository) FindByIDForShare(id uuid.UUID) (*domain.ProjectField, error) {
+	var field domain.ProjectField
+	if err := r.db.Clauses(clause.Locking{Strength: "SHARE"}).
+		Where("id = ?", id).
+		First(&field).Error; err != nil {
+		return nil, err
+	}
+	return &field, nil
+}
+
 func (r *projectFieldRepository) UpdateOrder(fieldID uuid.UUID, newOrder int) error {
 	return r.db.Model(&domain.ProjectField{}).
 		Where("id = ?", fieldID).
@@ -5,6 +5,7 @@ import (
 	"board-service/internal/repository/base"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ViewRepository는 SavedView 엔티티만 관리합니다
@@ -18,6 +19,11 @@ type ViewRepository interface {
 	// SavedView 전용 메서드
 	FindByProject(projectID uuid.UUID) ([]domain.SavedView, error)
 	FindDefault(projectID uuid.UUID) (*domain.SavedView, error)
+
+	// 사용자별 테이블 설정
+	FindUserSettings(viewID, userID uuid.UUID) (*domain.SavedViewUserSettings, error)
+	SaveUserSettings(settings *domain.SavedViewUserSettings) error
+	DeleteUserSettings(viewID, userID uuid.UUID) error
 }
 
 type viewRepository struct {
@@ -56,3 +62,28 @@ func (r *viewRepository) FindDefault(projectID uuid.UUID) (*domain.SavedView, er
 	}
 	return &view, nil
 }
+
+// ==================== 사용자별 테이블 설정 ====================
+
+// FindUserSettings는 사용자의 뷰 설정을 조회합니다 (없으면 gorm.ErrRecordNotFound)
+func (r *viewRepository) FindUserSettings(viewID, userID uuid.UUID) (*domain.SavedViewUserSettings, error) {
+	var settings domain.SavedViewUserSettings
+	if err := r.db.Where("view_id = ? AND user_id = ?", viewID, userID).
+		First(&settings).Error; err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// SaveUserSettings는 UPSERT로 사용자의 뷰 설정을 저장합니다 (PostgreSQL ON CONFLICT DO UPDATE)
+func (r *viewRepository) SaveUserSettings(settings *domain.SavedViewUserSettings) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "view_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"table_settings", "updated_at"}),
+	}).Create(settings).Error
+}
+
+func (r *viewRepository) DeleteUserSettings(viewID, userID uuid.UUID) error {
+	return r.db.Where("view_id = ? AND user_id = ?", viewID, userID).
+		Delete(&domain.SavedViewUserSettings{}).Error
+}
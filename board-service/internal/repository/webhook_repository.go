@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/repository/base"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WebhookRepository는 프로젝트 Webhook 구독과 전송 기록(WebhookDelivery)을 관리합니다
+type WebhookRepository interface {
+	// 공통 CRUD 메서드 (base repository에서 제공)
+	Create(webhook *domain.Webhook) error
+	FindByID(id uuid.UUID) (*domain.Webhook, error)
+	Update(webhook *domain.Webhook) error
+	Delete(id uuid.UUID) error
+
+	// Webhook 전용 메서드
+	FindByProject(projectID uuid.UUID) ([]domain.Webhook, error)
+	FindActiveByProject(projectID uuid.UUID) ([]domain.Webhook, error)
+	FindByIDs(ids []uuid.UUID) ([]domain.Webhook, error)
+
+	// WebhookDelivery 관련 메서드
+	CreateDeliveries(deliveries []domain.WebhookDelivery) error
+	FindDeliveryByID(id uuid.UUID) (*domain.WebhookDelivery, error)
+	FindDeliveriesByWebhook(webhookID uuid.UUID, page, limit int) ([]domain.WebhookDelivery, int64, error)
+	ClaimDueDeliveries(now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error)
+	UpdateDelivery(delivery *domain.WebhookDelivery) error
+}
+
+type webhookRepository struct {
+	base.BaseRepository[*domain.Webhook]
+	db *gorm.DB
+}
+
+// NewWebhookRepository는 새로운 WebhookRepository를 생성합니다
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &webhookRepository{
+		BaseRepository: base.NewBaseRepository[*domain.Webhook](db),
+		db:             db,
+	}
+}
+
+// ==================== 공통 CRUD는 base repository에 위임 ====================
+// Create, FindByID, Update, Delete는 BaseRepository의 구현을 사용합니다
+
+// ==================== Webhook 전용 메서드 ====================
+
+func (r *webhookRepository) FindByProject(projectID uuid.UUID) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	err := r.db.Where("project_id = ? AND is_deleted = ?", projectID, false).
+		Order("created_at ASC").
+		Find(&webhooks).Error
+	return webhooks, err
+}
+
+func (r *webhookRepository) FindActiveByProject(projectID uuid.UUID) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	err := r.db.Where("project_id = ? AND is_active = ? AND is_deleted = ?", projectID, true, false).
+		Find(&webhooks).Error
+	return webhooks, err
+}
+
+// FindByIDs는 삭제된 Webhook도 포함하여 조회합니다 (전송 시 비활성/삭제 여부를 판단하기 위함)
+func (r *webhookRepository) FindByIDs(ids []uuid.UUID) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	if len(ids) == 0 {
+		return webhooks, nil
+	}
+	err := r.db.Where("id IN ?", ids).Find(&webhooks).Error
+	return webhooks, err
+}
+
+// ==================== WebhookDelivery 관련 메서드 ====================
+
+// CreateDeliveries는 이미 같은 이벤트의 전송 기록이 있는 Webhook은 건너뜁니다 (idx_webhook_deliveries_event)
+func (r *webhookRepository) CreateDeliveries(deliveries []domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&deliveries).Error
+}
+
+func (r *webhookRepository) FindDeliveryByID(id uuid.UUID) (*domain.WebhookDelivery, error) {
+	var delivery domain.WebhookDelivery
+	if err := r.db.Where("id = ?", id).First(&delivery).Error; err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// FindDeliveriesByWebhook는 전송 기록을 최신순으로 조회합니다
+func (r *webhookRepository) FindDeliveriesByWebhook(webhookID uuid.UUID, page, limit int) ([]domain.WebhookDelivery, int64, error) {
+	var deliveries []domain.WebhookDelivery
+	var total int64
+
+	query := r.db.Model(&domain.WebhookDelivery{}).Where("webhook_id = ?", webhookID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, total, nil
+}
+
+// ClaimDueDeliveries는 전송 시각이 도래한 pending 전송을 가져오면서 next_attempt_at을 lease만큼 미룹니다
+// HTTP 호출은 트랜잭션 밖에서 이루어지므로, lease 동안 다른 replica가 같은 전송을 가져가지 않습니다
+// (lease가 끝나기 전에 결과가 저장되지 않으면 다시 전송됩니다: at-least-once)
+func (r *webhookRepository) ClaimDueDeliveries(now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error) {
+	var deliveries []domain.WebhookDelivery
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", domain.WebhookDeliveryPending, now).
+			Order("next_attempt_at ASC, id ASC").
+			Limit(limit).
+			Find(&deliveries).Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		ids := make([]uuid.UUID, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID)
+		}
+		return tx.Model(&domain.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+
+	return deliveries, err
+}
+
+// UpdateDelivery는 전송 결과를 저장합니다
+func (r *webhookRepository) UpdateDelivery(delivery *domain.WebhookDelivery) error {
+	return r.db.Model(delivery).
+		Select("status", "attempts", "response_status", "response_body", "last_error", "duration_ms", "next_attempt_at", "delivered_at").
+		Updates(delivery).Error
+}
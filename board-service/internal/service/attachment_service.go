@@ -0,0 +1,396 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/parser"
+	"board-service/internal/config"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"board-service/internal/storage"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// AttachmentLimits restricts what can be uploaded
+type AttachmentLimits struct {
+	MaxSize      int64    // Max file size in bytes
+	AllowedTypes []string // Allowed MIME types, matched against the type sniffed from the content
+}
+
+// NewAttachmentLimits reads the attachment limits from the configuration
+func NewAttachmentLimits(cfg *config.Config) AttachmentLimits {
+	return AttachmentLimits{
+		MaxSize:      cfg.Attachment.MaxSize,
+		AllowedTypes: cfg.Attachment.AllowedTypes,
+	}
+}
+
+// AttachmentFile is an uploaded file as received by the handler
+type AttachmentFile struct {
+	FileName string
+	Size     int64
+	Content  io.Reader
+}
+
+type AttachmentService interface {
+	UploadToBoard(ctx context.Context, userID, boardID string, file AttachmentFile) (*dto.AttachmentResponse, error)
+	UploadToComment(ctx context.Context, userID, commentID string, file AttachmentFile) (*dto.AttachmentResponse, error)
+	GetBoardAttachments(userID, boardID string) ([]dto.AttachmentResponse, error)
+	GetCommentAttachments(userID, commentID string) ([]dto.AttachmentResponse, error)
+	Download(ctx context.Context, userID, attachmentID string) (*dto.AttachmentResponse, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, userID, attachmentID string) error
+
+	// MaxUploadSize lets the handler cap the request body before parsing it
+	MaxUploadSize() int64
+}
+
+type attachmentService struct {
+	repo        repository.AttachmentRepository
+	boardRepo   repository.BoardRepository
+	commentRepo repository.CommentRepository
+	storage     storage.Storage
+	limits      AttachmentLimits
+	authorizer  auth.ProjectAuthorizer
+	logger      *zap.Logger
+}
+
+func NewAttachmentService(
+	repo repository.AttachmentRepository,
+	boardRepo repository.BoardRepository,
+	commentRepo repository.CommentRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	storage storage.Storage,
+	limits AttachmentLimits,
+	logger *zap.Logger,
+) AttachmentService {
+	return &attachmentService{
+		repo:        repo,
+		boardRepo:   boardRepo,
+		commentRepo: commentRepo,
+		storage:     storage,
+		limits:      limits,
+		authorizer:  auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		logger:      logger,
+	}
+}
+
+func (s *attachmentService) MaxUploadSize() int64 {
+	return s.limits.MaxSize
+}
+
+// ==================== Upload ====================
+
+func (s *attachmentService) UploadToBoard(ctx context.Context, userID, boardID string, file AttachmentFile) (*dto.AttachmentResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.findBoardAsMember(userUUID, boardUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.upload(ctx, board, nil, userUUID, file)
+}
+
+func (s *attachmentService) UploadToComment(ctx context.Context, userID, commentID string, file AttachmentFile) (*dto.AttachmentResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.findComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.findBoardAsMember(userUUID, comment.BoardID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the comment author (or ADMIN+) may attach files to a comment
+	canEdit, err := s.authorizer.CanEdit(userUUID, board.ProjectID, comment.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !canEdit {
+		return nil, apperrors.New(apperrors.ErrCodeForbidden, "댓글에 파일을 첨부할 권한이 없습니다", 403)
+	}
+
+	return s.upload(ctx, board, &comment.ID, userUUID, file)
+}
+
+// upload checks the limits, stores the content and then the metadata
+// The stored object is removed again if the metadata cannot be saved.
+func (s *attachmentService) upload(ctx context.Context, board *domain.Board, commentID *uuid.UUID, userID uuid.UUID, file AttachmentFile) (*dto.AttachmentResponse, error) {
+	if file.Size > s.limits.MaxSize {
+		return nil, apperrors.New(apperrors.ErrCodePayloadTooLarge, fmt.Sprintf("파일 크기는 %d바이트를 초과할 수 없습니다", s.limits.MaxSize), 413)
+	}
+
+	// The content type is sniffed from the first bytes; the client-provided type is not trusted
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file.Content, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "파일을 읽을 수 없습니다", 400)
+	}
+	head = head[:n]
+
+	contentType := detectContentType(head)
+	if !s.isAllowedType(contentType) {
+		return nil, apperrors.New(apperrors.ErrCodeUnsupportedMediaType, fmt.Sprintf("허용되지 않는 파일 형식입니다: %s", contentType), 415)
+	}
+
+	attachment, err := domain.NewAttachment(board, commentID, file.FileName, contentType, file.Size, userID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	content := io.MultiReader(bytes.NewReader(head), file.Content)
+	if err := s.storage.Put(ctx, attachment.StorageKey, contentType, content, file.Size); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "파일 저장 실패", 500)
+	}
+
+	if err := s.repo.Create(attachment); err != nil {
+		if delErr := s.storage.Delete(ctx, attachment.StorageKey); delErr != nil {
+			s.logger.Warn("Failed to remove orphaned attachment object",
+				zap.String("key", attachment.StorageKey),
+				zap.Error(delErr),
+			)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부파일 저장 실패", 500)
+	}
+
+	return toAttachmentResponse(attachment), nil
+}
+
+// ==================== List ====================
+
+func (s *attachmentService) GetBoardAttachments(userID, boardID string) ([]dto.AttachmentResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.findBoardAsMember(userUUID, boardUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.repo.FindByBoard(board.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부파일 조회 실패", 500)
+	}
+
+	return toAttachmentResponses(attachments), nil
+}
+
+func (s *attachmentService) GetCommentAttachments(userID, commentID string) ([]dto.AttachmentResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.findComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findBoardAsMember(userUUID, comment.BoardID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.repo.FindByComment(comment.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부파일 조회 실패", 500)
+	}
+
+	return toAttachmentResponses(attachments), nil
+}
+
+// ==================== Download ====================
+
+func (s *attachmentService) Download(ctx context.Context, userID, attachmentID string) (*dto.AttachmentResponse, io.ReadCloser, error) {
+	_, attachment, err := s.findAttachmentAsMember(userID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "파일을 찾을 수 없습니다", 404)
+		}
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "파일 조회 실패", 500)
+	}
+
+	return toAttachmentResponse(attachment), content, nil
+}
+
+// ==================== Delete ====================
+
+func (s *attachmentService) DeleteAttachment(ctx context.Context, userID, attachmentID string) error {
+	userUUID, attachment, err := s.findAttachmentAsMember(userID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	// Uploader or ADMIN+
+	canDelete, err := s.authorizer.CanDelete(userUUID, attachment.ProjectID, attachment.UploadedBy)
+	if err != nil {
+		return err
+	}
+	if !canDelete {
+		return apperrors.New(apperrors.ErrCodeForbidden, "삭제 권한이 없습니다", 403)
+	}
+
+	if err := s.repo.Delete(attachment.ID); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부파일 삭제 실패", 500)
+	}
+
+	// The metadata is gone, so a leftover object is unreachable; log it for cleanup
+	if err := s.storage.Delete(ctx, attachment.StorageKey); err != nil {
+		s.logger.Warn("Failed to delete attachment object",
+			zap.String("attachment_id", attachment.ID.String()),
+			zap.String("key", attachment.StorageKey),
+			zap.Error(err),
+		)
+	}
+	return nil
+}
+
+// ==================== Helpers ====================
+
+// findBoardAsMember loads the board and checks that the user is a member of its project
+func (s *attachmentService) findBoardAsMember(userID, boardID uuid.UUID) (*domain.Board, error) {
+	board, err := s.boardRepo.FindByID(boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	if _, err := s.authorizer.RequireMember(userID, board.ProjectID); err != nil {
+		return nil, err
+	}
+
+	return board, nil
+}
+
+func (s *attachmentService) findComment(commentID string) (*domain.Comment, error) {
+	commentUUID, err := parser.ParseUUID(commentID, "댓글")
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.commentRepo.FindByID(commentUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "댓글을 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "댓글 조회 실패", 500)
+	}
+	if comment.IsDeleted {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "댓글을 찾을 수 없습니다", 404)
+	}
+	return comment, nil
+}
+
+// findAttachmentAsMember loads an attachment and checks that the user is a member of its project
+// Attachments of a deleted board are not found, like the board itself.
+func (s *attachmentService) findAttachmentAsMember(userID, attachmentID string) (uuid.UUID, *domain.Attachment, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	attachmentUUID, err := parser.ParseUUID(attachmentID, "첨부파일")
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	attachment, err := s.repo.FindByID(attachmentUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "첨부파일을 찾을 수 없습니다", 404)
+		}
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부파일 조회 실패", 500)
+	}
+
+	if _, err := s.findBoardAsMember(userUUID, attachment.BoardID); err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	return userUUID, attachment, nil
+}
+
+func (s *attachmentService) isAllowedType(contentType string) bool {
+	for _, allowed := range s.limits.AllowedTypes {
+		if allowed == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// detectContentType sniffs the MIME type and drops parameters ("text/plain; charset=utf-8" -> "text/plain")
+func detectContentType(head []byte) string {
+	detected := http.DetectContentType(head)
+	mediaType, _, err := mime.ParseMediaType(detected)
+	if err != nil {
+		return detected
+	}
+	return mediaType
+}
+
+func toAttachmentResponse(attachment *domain.Attachment) *dto.AttachmentResponse {
+	var commentID *string
+	if attachment.CommentID != nil {
+		id := attachment.CommentID.String()
+		commentID = &id
+	}
+
+	return &dto.AttachmentResponse{
+		AttachmentID: attachment.ID.String(),
+		BoardID:      attachment.BoardID.String(),
+		CommentID:    commentID,
+		FileName:     attachment.FileName,
+		ContentType:  attachment.ContentType,
+		Size:         attachment.Size,
+		UploadedBy:   attachment.UploadedBy.String(),
+		DownloadURL:  fmt.Sprintf("/api/attachments/%s/download", attachment.ID),
+		CreatedAt:    attachment.CreatedAt,
+	}
+}
+
+func toAttachmentResponses(attachments []domain.Attachment) []dto.AttachmentResponse {
+	responses := make([]dto.AttachmentResponse, 0, len(attachments))
+	for i := range attachments {
+		responses = append(responses, *toAttachmentResponse(&attachments[i]))
+	}
+	return responses
+}
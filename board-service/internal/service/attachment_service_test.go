@@ -0,0 +1,256 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/storage"
+	"board-service/internal/testutil"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// memoryAttachmentRepository keeps attachment metadata in memory
+type memoryAttachmentRepository struct {
+	attachments map[uuid.UUID]domain.Attachment
+	createErr   error
+}
+
+func (r *memoryAttachmentRepository) Create(attachment *domain.Attachment) error {
+	if r.createErr != nil {
+		return r.createErr
+	}
+	r.attachments[attachment.ID] = *attachment
+	return nil
+}
+
+func (r *memoryAttachmentRepository) FindByID(id uuid.UUID) (*domain.Attachment, error) {
+	attachment, ok := r.attachments[id]
+	if !ok || attachment.IsDeleted {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &attachment, nil
+}
+
+func (r *memoryAttachmentRepository) Delete(id uuid.UUID) error {
+	attachment := r.attachments[id]
+	attachment.IsDeleted = true
+	r.attachments[id] = attachment
+	return nil
+}
+
+func (r *memoryAttachmentRepository) FindByBoard(boardID uuid.UUID) ([]domain.Attachment, error) {
+	var result []domain.Attachment
+	for _, attachment := range r.attachments {
+		if attachment.BoardID == boardID && !attachment.IsDeleted {
+			result = append(result, attachment)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryAttachmentRepository) DeleteByBoard(boardID uuid.UUID) error {
+	for id, attachment := range r.attachments {
+		if attachment.BoardID == boardID {
+			attachment.IsDeleted = true
+			r.attachments[id] = attachment
+		}
+	}
+	return nil
+}
+
+func (r *memoryAttachmentRepository) FindByComment(commentID uuid.UUID) ([]domain.Attachment, error) {
+	var result []domain.Attachment
+	for _, attachment := range r.attachments {
+		if attachment.CommentID != nil && *attachment.CommentID == commentID && !attachment.IsDeleted {
+			result = append(result, attachment)
+		}
+	}
+	return result, nil
+}
+
+type attachmentTestSuite struct {
+	service     AttachmentService
+	repo        *memoryAttachmentRepository
+	storage     *storage.LocalStorage
+	root        string
+	commentRepo *testutil.MockCommentRepository
+	boardRepo   *testutil.MockBoardRepository
+	userID      uuid.UUID
+	otherID     uuid.UUID
+	board       *domain.Board
+}
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func setupAttachmentTest(t *testing.T) *attachmentTestSuite {
+	t.Helper()
+	root := t.TempDir()
+	local, err := storage.NewLocalStorage(root)
+	require.NoError(t, err)
+
+	suite := &attachmentTestSuite{
+		repo:        &memoryAttachmentRepository{attachments: make(map[uuid.UUID]domain.Attachment)},
+		storage:     local,
+		root:        root,
+		commentRepo: new(testutil.MockCommentRepository),
+		userID:      uuid.New(),
+		otherID:     uuid.New(),
+	}
+	suite.board = testutil.NewTestBoard(uuid.New(), suite.userID)
+	suite.board.ID = uuid.New()
+
+	suite.boardRepo = new(testutil.MockBoardRepository)
+	suite.boardRepo.On("FindByID", suite.board.ID).Return(suite.board, nil).Maybe()
+
+	projectRepo := new(testutil.MockProjectRepository)
+	for _, id := range []uuid.UUID{suite.userID, suite.otherID} {
+		member := testutil.NewTestProjectMember(suite.board.ProjectID, id, uuid.New())
+		member.Role = &domain.Role{Name: "MEMBER", Level: 10}
+		projectRepo.On("FindMemberByUserAndProject", id, suite.board.ProjectID).Return(member, nil).Maybe()
+	}
+
+	suite.service = NewAttachmentService(
+		suite.repo,
+		suite.boardRepo,
+		suite.commentRepo,
+		projectRepo,
+		new(testutil.MockRoleRepository),
+		local,
+		AttachmentLimits{MaxSize: 1024, AllowedTypes: []string{"image/png", "text/plain"}},
+		zap.NewNop(),
+	)
+	return suite
+}
+
+func fileOf(name string, content []byte) AttachmentFile {
+	return AttachmentFile{FileName: name, Size: int64(len(content)), Content: bytes.NewReader(content)}
+}
+
+func assertStatus(t *testing.T, err error, status int) {
+	t.Helper()
+	require.Error(t, err)
+	var appErr *apperrors.AppError
+	require.True(t, errors.As(err, &appErr), err)
+	assert.Equal(t, status, appErr.HTTPStatus)
+}
+
+func TestAttachmentService_UploadAndDownload(t *testing.T) {
+	suite := setupAttachmentTest(t)
+	ctx := context.Background()
+
+	// The type comes from the content, not from the file name
+	response, err := suite.service.UploadToBoard(ctx, suite.userID.String(), suite.board.ID.String(), fileOf("../../screenshot.txt", pngHeader))
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", response.ContentType)
+	assert.Equal(t, "screenshot.txt", response.FileName)
+	assert.Nil(t, response.CommentID)
+
+	meta, content, err := suite.service.Download(ctx, suite.otherID.String(), response.AttachmentID)
+	require.NoError(t, err)
+	defer content.Close()
+	got, _ := io.ReadAll(content)
+	assert.Equal(t, pngHeader, got)
+	assert.Equal(t, int64(len(pngHeader)), meta.Size)
+}
+
+func TestAttachmentService_Limits(t *testing.T) {
+	suite := setupAttachmentTest(t)
+	ctx := context.Background()
+
+	_, err := suite.service.UploadToBoard(ctx, suite.userID.String(), suite.board.ID.String(), fileOf("big.txt", bytes.Repeat([]byte("a"), 2048)))
+	assertStatus(t, err, 413)
+
+	_, err = suite.service.UploadToBoard(ctx, suite.userID.String(), suite.board.ID.String(), fileOf("page.png", []byte("<html><script>alert(1)</script></html>")))
+	assertStatus(t, err, 415)
+
+	_, err = suite.service.UploadToBoard(ctx, suite.userID.String(), suite.board.ID.String(), fileOf("empty.txt", nil))
+	assertStatus(t, err, 400)
+
+	assert.Empty(t, suite.repo.attachments)
+}
+
+func TestAttachmentService_UploadToComment_OnlyAuthor(t *testing.T) {
+	suite := setupAttachmentTest(t)
+	ctx := context.Background()
+
+	comment := &domain.Comment{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: suite.board.ID, UserID: suite.userID}
+	suite.commentRepo.On("FindByID", comment.ID).Return(comment, nil)
+
+	_, err := suite.service.UploadToComment(ctx, suite.otherID.String(), comment.ID.String(), fileOf("note.txt", []byte("hello")))
+	assertStatus(t, err, 403)
+
+	response, err := suite.service.UploadToComment(ctx, suite.userID.String(), comment.ID.String(), fileOf("note.txt", []byte("hello")))
+	require.NoError(t, err)
+	require.NotNil(t, response.CommentID)
+	assert.Equal(t, comment.ID.String(), *response.CommentID)
+
+	listed, err := suite.service.GetCommentAttachments(suite.otherID.String(), comment.ID.String())
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+}
+
+func TestAttachmentService_Delete(t *testing.T) {
+	suite := setupAttachmentTest(t)
+	ctx := context.Background()
+
+	response, err := suite.service.UploadToBoard(ctx, suite.userID.String(), suite.board.ID.String(), fileOf("a.txt", []byte("hello")))
+	require.NoError(t, err)
+	key := suite.repo.attachments[uuid.MustParse(response.AttachmentID)].StorageKey
+
+	// Another member is neither the uploader nor an admin
+	err = suite.service.DeleteAttachment(ctx, suite.otherID.String(), response.AttachmentID)
+	assertStatus(t, err, 403)
+
+	require.NoError(t, suite.service.DeleteAttachment(ctx, suite.userID.String(), response.AttachmentID))
+	_, err = suite.storage.Get(ctx, key)
+	assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+
+	_, _, err = suite.service.Download(ctx, suite.userID.String(), response.AttachmentID)
+	assertStatus(t, err, 404)
+}
+
+func TestAttachmentService_MetadataFailureRemovesObject(t *testing.T) {
+	suite := setupAttachmentTest(t)
+	suite.repo.createErr = errors.New("db down")
+
+	_, err := suite.service.UploadToBoard(context.Background(), suite.userID.String(), suite.board.ID.String(), fileOf("a.txt", []byte("hello")))
+	assertStatus(t, err, 500)
+
+	// The stored object was removed again
+	var files []string
+	require.NoError(t, filepath.WalkDir(suite.root, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			files = append(files, path)
+		}
+		return err
+	}))
+	assert.Empty(t, files)
+}
+
+func TestAttachmentService_DeletedBoard(t *testing.T) {
+	suite := setupAttachmentTest(t)
+	ctx := context.Background()
+
+	response, err := suite.service.UploadToBoard(ctx, suite.userID.String(), suite.board.ID.String(), fileOf("a.txt", []byte("hello")))
+	require.NoError(t, err)
+
+	// Once the board is deleted its attachments can no longer be downloaded or deleted
+	suite.boardRepo.ExpectedCalls = nil
+	suite.boardRepo.On("FindByID", suite.board.ID).Return(nil, gorm.ErrRecordNotFound)
+
+	_, _, err = suite.service.Download(ctx, suite.otherID.String(), response.AttachmentID)
+	assertStatus(t, err, 404)
+	err = suite.service.DeleteAttachment(ctx, suite.userID.String(), response.AttachmentID)
+	assertStatus(t, err, 404)
+}
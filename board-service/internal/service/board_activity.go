@@ -0,0 +1,108 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/repository"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ==================== Board Activity Helpers ====================
+// boardService와 fieldValueService가 공유하는 활동 기록(diff) 생성 로직
+
+// buildFieldValueChange builds the diff for a custom field from the values before and after a mutation
+// Option IDs are resolved to labels so the history stays readable after options are renamed or deleted
+// Returns nil if the value did not change
+func buildFieldValueChange(
+	fieldRepo repository.FieldRepository,
+	field *domain.ProjectField,
+	before, after []domain.BoardFieldValue,
+) *domain.ActivityChange {
+	optionsMap := loadActivityOptions(fieldRepo, before, after)
+
+	oldValue := describeFieldValues(field, before, optionsMap)
+	newValue := describeFieldValues(field, after, optionsMap)
+
+	oldJSON, _ := json.Marshal(oldValue)
+	newJSON, _ := json.Marshal(newValue)
+	if string(oldJSON) == string(newJSON) {
+		return nil
+	}
+
+	return &domain.ActivityChange{
+		Field:     field.ID.String(),
+		FieldName: field.Name,
+		OldValue:  oldValue,
+		NewValue:  newValue,
+	}
+}
+
+// loadActivityOptions batch fetches every option referenced by the given values
+func loadActivityOptions(fieldRepo repository.FieldRepository, valueSets ...[]domain.BoardFieldValue) map[uuid.UUID]domain.FieldOption {
+	optionIDs := make([]uuid.UUID, 0)
+	seen := make(map[uuid.UUID]bool)
+	for _, values := range valueSets {
+		for _, v := range values {
+			if v.ValueOptionID != nil && !seen[*v.ValueOptionID] {
+				seen[*v.ValueOptionID] = true
+				optionIDs = append(optionIDs, *v.ValueOptionID)
+			}
+		}
+	}
+
+	optionsMap := make(map[uuid.UUID]domain.FieldOption)
+	if len(optionIDs) == 0 {
+		return optionsMap
+	}
+
+	options, err := fieldRepo.FindOptionsByIDs(optionIDs)
+	if err != nil {
+		// Fall back to option IDs without labels
+		return optionsMap
+	}
+	for _, opt := range options {
+		optionsMap[opt.ID] = opt
+	}
+	return optionsMap
+}
+
+// describeFieldValues converts EAV rows into the JSON value stored in an ActivityChange
+// Multi-value fields are always arrays; an unset single-value field is nil
+func describeFieldValues(field *domain.ProjectField, values []domain.BoardFieldValue, optionsMap map[uuid.UUID]domain.FieldOption) interface{} {
+	if field.FieldType == domain.FieldTypeMultiSelect || field.FieldType == domain.FieldTypeMultiUser {
+		result := make([]interface{}, 0, len(values))
+		for _, v := range values {
+			result = append(result, describeFieldValue(v, optionsMap))
+		}
+		return result
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+	return describeFieldValue(values[0], optionsMap)
+}
+
+func describeFieldValue(v domain.BoardFieldValue, optionsMap map[uuid.UUID]domain.FieldOption) interface{} {
+	switch {
+	case v.ValueText != nil:
+		return *v.ValueText
+	case v.ValueNumber != nil:
+		return *v.ValueNumber
+	case v.ValueDate != nil:
+		return v.ValueDate.Format(time.RFC3339)
+	case v.ValueBoolean != nil:
+		return *v.ValueBoolean
+	case v.ValueOptionID != nil:
+		optionValue := domain.ActivityOptionValue{OptionID: v.ValueOptionID.String()}
+		if opt, ok := optionsMap[*v.ValueOptionID]; ok {
+			optionValue.Label = opt.Label
+		}
+		return optionValue
+	case v.ValueUserID != nil:
+		return v.ValueUserID.String()
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/testutil"
+	"board-service/internal/uow"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBuildFieldValueChange_SingleSelectResolvesLabels(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+
+	field := &domain.ProjectField{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		Name:      "Stage",
+		FieldType: domain.FieldTypeSingleSelect,
+	}
+	inProgress := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldID: field.ID, Label: "진행중"}
+	done := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldID: field.ID, Label: "완료"}
+
+	fieldRepo.On("FindOptionsByIDs", mock.Anything).
+		Return([]domain.FieldOption{inProgress, done}, nil)
+
+	before := []domain.BoardFieldValue{{FieldID: field.ID, ValueOptionID: &inProgress.ID}}
+	after := []domain.BoardFieldValue{{FieldID: field.ID, ValueOptionID: &done.ID}}
+
+	change := buildFieldValueChange(fieldRepo, field, before, after)
+
+	assert.NotNil(t, change)
+	assert.Equal(t, field.ID.String(), change.Field)
+	assert.Equal(t, "Stage", change.FieldName)
+	assert.Equal(t, domain.ActivityOptionValue{OptionID: inProgress.ID.String(), Label: "진행중"}, change.OldValue)
+	assert.Equal(t, domain.ActivityOptionValue{OptionID: done.ID.String(), Label: "완료"}, change.NewValue)
+}
+
+func TestBuildFieldValueChange_NoChange(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+
+	text := "same"
+	field := &domain.ProjectField{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		Name:      "Memo",
+		FieldType: domain.FieldTypeText,
+	}
+	before := []domain.BoardFieldValue{{FieldID: field.ID, ValueText: &text}}
+	after := []domain.BoardFieldValue{{FieldID: field.ID, ValueText: &text}}
+
+	assert.Nil(t, buildFieldValueChange(fieldRepo, field, before, after))
+	fieldRepo.AssertNotCalled(t, "FindOptionsByIDs", mock.Anything)
+}
+
+func TestBuildFieldValueChange_MultiUserFromEmpty(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+
+	userID := uuid.New()
+	field := &domain.ProjectField{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		Name:      "Reviewers",
+		FieldType: domain.FieldTypeMultiUser,
+	}
+	after := []domain.BoardFieldValue{{FieldID: field.ID, ValueUserID: &userID}}
+
+	change := buildFieldValueChange(fieldRepo, field, nil, after)
+
+	assert.NotNil(t, change)
+	assert.Equal(t, []interface{}{}, change.OldValue)
+	assert.Equal(t, []interface{}{userID.String()}, change.NewValue)
+}
+
+// recordingActivities keeps the activities created through it
+type recordingActivities struct {
+	created []domain.BoardActivity
+}
+
+func (r *recordingActivities) Create(activity *domain.BoardActivity) error {
+	r.created = append(r.created, *activity)
+	return nil
+}
+
+func (r *recordingActivities) FindByBoard(boardID uuid.UUID, page, limit int) ([]domain.BoardActivity, int64, error) {
+	return r.created, int64(len(r.created)), nil
+}
+
+func TestRecordFieldValueActivity(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+	activities := &recordingActivities{}
+	repos := &uow.Repositories{Field: fieldRepo, Activity: activities}
+
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New()}
+	field := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, Name: "Reviewers", FieldType: domain.FieldTypeMultiUser}
+	first, second := uuid.New(), uuid.New()
+
+	// Written values are described in display order, whatever order they were requested in
+	written := []domain.BoardFieldValue{
+		{FieldID: field.ID, ValueUserID: &second, DisplayOrder: 1},
+		{FieldID: field.ID, ValueUserID: &first, DisplayOrder: 0},
+	}
+	err := recordFieldValueActivity(repos, board, field, uuid.New(), nil, written)
+	assert.NoError(t, err)
+	assert.Len(t, activities.created, 1)
+	assert.Equal(t, domain.ActivityFieldValueChanged, activities.created[0].Action)
+	changes, err := activities.created[0].GetChanges()
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{first.String(), second.String()}, changes[0].NewValue)
+
+	// Unchanged values record nothing
+	previous := []domain.BoardFieldValue{written[1], written[0]}
+	assert.NoError(t, recordFieldValueActivity(repos, board, field, uuid.New(), previous, written))
+	assert.Len(t, activities.created, 1)
+}
+
+func TestDiffBoard_BuiltInColumns(t *testing.T) {
+	assignee := uuid.New()
+	before := &domain.Board{Title: "Old", Description: "Same"}
+	after := &domain.Board{Title: "New", Description: "Same", AssigneeID: &assignee}
+
+	changes := domain.DiffBoard(before, after)
+
+	assert.Len(t, changes, 2)
+	assert.Equal(t, domain.ActivityChange{Field: "title", OldValue: "Old", NewValue: "New"}, changes[0])
+	assert.Equal(t, domain.ActivityChange{Field: "assignee", OldValue: nil, NewValue: assignee.String()}, changes[1])
+}
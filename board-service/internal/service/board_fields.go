@@ -0,0 +1,237 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/domain"
+	"board-service/internal/repository"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ==================== Board Field Values ====================
+// Boards are created and updated with a map of custom field values by field ID, validated like
+// single values set through the field value API and written with the board in one transaction.
+// A new board also gets the configured default of every other field. Required fields must end up
+// with a value; a required single select without a default falls back to its first option, so
+// boards keep landing in the first stage.
+
+// fieldValueUpdate is the validated new value of one field of a board
+type fieldValueUpdate struct {
+	field    *domain.ProjectField
+	values   []domain.BoardFieldValue // Empty clears the field
+	previous []domain.BoardFieldValue // For activity history
+}
+
+// requestedFieldValues validates requested field values of a board without writing them, in field order
+// Fields must belong to the board's project and be editable by userID; required fields cannot be cleared.
+func requestedFieldValues(repo repository.FieldRepository, board *domain.Board, authorizer auth.ProjectAuthorizer, userID uuid.UUID, fields []domain.ProjectField, requested map[string]interface{}, logger *zap.Logger) ([]fieldValueUpdate, error) {
+	byID := fieldsByID(fields)
+	for key := range requested {
+		if _, ok := byID[key]; !ok {
+			return nil, apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("프로젝트에 없는 필드입니다: %s", key), 400)
+		}
+	}
+
+	updates := make([]fieldValueUpdate, 0, len(requested))
+	for i := range fields {
+		field := &fields[i]
+		value, ok := requested[field.ID.String()]
+		if !ok {
+			continue
+		}
+		if _, err := authorizer.RequireFieldEditor(userID, field); err != nil {
+			return nil, err
+		}
+
+		update := fieldValueUpdate{field: field}
+		if value != nil {
+			values, err := buildFieldValues(repo, board.ID, field, value, value, logger)
+			if err != nil {
+				return nil, err
+			}
+			update.values = values
+		}
+		if len(update.values) == 0 && field.IsRequired {
+			return nil, requiredFieldError(field)
+		}
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
+// lockValueFields share-locks, in field order, every field that has one of the values (see lockFieldType)
+func lockValueFields(repo repository.FieldRepository, fields []domain.ProjectField, values []domain.BoardFieldValue) error {
+	written := make(map[uuid.UUID]bool, len(values))
+	for _, value := range values {
+		written[value.FieldID] = true
+	}
+	for i := range fields {
+		if !written[fields[i].ID] {
+			continue
+		}
+		if err := lockFieldType(repo, &fields[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// initialFieldValues resolves and validates the field values of a new board without writing them
+// Only requested values are checked against the author's permission to edit their fields.
+func initialFieldValues(repo repository.FieldRepository, board *domain.Board, authorizer auth.ProjectAuthorizer, fields []domain.ProjectField, requested map[string]interface{}, now time.Time, logger *zap.Logger) ([]domain.BoardFieldValue, error) {
+	updates, err := requestedFieldValues(repo, board, authorizer, board.CreatedBy, fields, requested, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]domain.BoardFieldValue, 0, len(fields))
+	for _, update := range updates {
+		values = append(values, update.values...)
+	}
+
+	var missing []string
+	for i := range fields {
+		field := &fields[i]
+		if _, ok := requested[field.ID.String()]; ok {
+			continue
+		}
+
+		var fieldValues []domain.BoardFieldValue
+		if value, ok := fieldDefaultValue(repo, field, board.CreatedBy, now, logger); ok {
+			built, err := buildFieldValues(repo, board.ID, field, value, value, logger)
+			if err != nil {
+				return nil, err
+			}
+			fieldValues = built
+		}
+		if len(fieldValues) == 0 {
+			if field.IsRequired {
+				missing = append(missing, field.Name)
+			}
+			continue
+		}
+		values = append(values, fieldValues...)
+	}
+
+	if len(missing) > 0 {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("필수 필드 값이 없습니다: %s", strings.Join(missing, ", ")), 400)
+	}
+	return values, nil
+}
+
+// mergeFieldValues returns the board's values with those of the updated fields replaced
+func mergeFieldValues(current []domain.BoardFieldValue, updates []fieldValueUpdate) []domain.BoardFieldValue {
+	updated := make(map[uuid.UUID]bool, len(updates))
+	for _, update := range updates {
+		updated[update.field.ID] = true
+	}
+
+	merged := make([]domain.BoardFieldValue, 0, len(current))
+	for _, value := range current {
+		if !updated[value.FieldID] {
+			merged = append(merged, value)
+		}
+	}
+	for _, update := range updates {
+		merged = append(merged, update.values...)
+	}
+	return merged
+}
+
+// fieldDefaultValue returns the configured default value of the field for a board created by creatorID
+// Relative defaults (today, creator) are resolved; unreadable configs are logged and have no default.
+func fieldDefaultValue(repo repository.FieldRepository, field *domain.ProjectField, creatorID uuid.UUID, now time.Time, logger *zap.Logger) (interface{}, bool) {
+	var config domain.FieldConfig
+	if err := json.Unmarshal([]byte(field.Config), &config); err != nil {
+		logger.Warn("Failed to parse config", zap.Error(err), zap.String("field_id", field.ID.String()))
+	}
+
+	if config.DefaultValue == nil {
+		if field.IsRequired && field.FieldType == domain.FieldTypeSingleSelect {
+			return firstOptionID(repo, field, logger)
+		}
+		return nil, false
+	}
+
+	switch field.FieldType {
+	case domain.FieldTypeDate:
+		if config.DefaultValue == domain.DefaultValueToday {
+			return now.UTC().Truncate(24 * time.Hour).Format(time.RFC3339), true
+		}
+	case domain.FieldTypeDateTime:
+		if config.DefaultValue == domain.DefaultValueToday {
+			return now.UTC().Format(time.RFC3339), true
+		}
+	case domain.FieldTypeSingleUser:
+		if config.DefaultValue == domain.DefaultValueCreator {
+			return creatorID.String(), true
+		}
+	case domain.FieldTypeMultiUser:
+		if users, ok := config.DefaultValue.([]interface{}); ok {
+			resolved := make([]interface{}, len(users))
+			for i, user := range users {
+				if user == domain.DefaultValueCreator {
+					user = creatorID.String()
+				}
+				resolved[i] = user
+			}
+			return resolved, true
+		}
+	}
+	return config.DefaultValue, true
+}
+
+// firstOptionID returns the ID of the field's first active option in display order
+func firstOptionID(repo repository.FieldRepository, field *domain.ProjectField, logger *zap.Logger) (interface{}, bool) {
+	options, err := repo.FindOptionsByField(field.ID)
+	if err != nil {
+		logger.Warn("Failed to fetch field options", zap.Error(err), zap.String("field_id", field.ID.String()))
+		return nil, false
+	}
+	options = activeOptions(options)
+	if len(options) == 0 {
+		return nil, false
+	}
+	return options[0].ID.String(), true
+}
+
+// validateDefaultValue checks the shape of a field's configured default value
+// Options and users are not resolved here: a field's options may be created after it.
+func validateDefaultValue(fieldType domain.FieldType, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var ok bool
+	switch fieldType {
+	case domain.FieldTypeText, domain.FieldTypeURL, domain.FieldTypeSingleSelect,
+		domain.FieldTypeDate, domain.FieldTypeDateTime, domain.FieldTypeSingleUser:
+		_, ok = value.(string)
+	case domain.FieldTypeNumber:
+		_, ok = value.(float64)
+	case domain.FieldTypeCheckbox:
+		_, ok = value.(bool)
+	case domain.FieldTypeMultiSelect, domain.FieldTypeMultiUser:
+		_, ok = value.([]interface{})
+	}
+	if !ok {
+		return fmt.Errorf("default_value does not match field type %s", fieldType)
+	}
+
+	switch fieldType {
+	case domain.FieldTypeDate, domain.FieldTypeDateTime:
+		if value == domain.DefaultValueToday {
+			return nil
+		}
+		if _, err := time.Parse(time.RFC3339, value.(string)); err != nil {
+			return fmt.Errorf("default_value must be an ISO 8601 date or %q", domain.DefaultValueToday)
+		}
+	}
+	return nil
+}
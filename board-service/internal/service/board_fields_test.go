@@ -0,0 +1,189 @@
+package service
+
+import (
+	"board-service/internal/common/auth"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/testutil"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// roleAuthorizer authorizes field edits for a member with a fixed role
+type roleAuthorizer struct {
+	auth.ProjectAuthorizer
+	role *domain.Role
+}
+
+func (a roleAuthorizer) RequireFieldEditor(userID uuid.UUID, field *domain.ProjectField) (*domain.ProjectMember, error) {
+	if err := auth.CheckFieldEditor(a.role, field); err != nil {
+		return nil, err
+	}
+	return &domain.ProjectMember{UserID: userID, Role: a.role}, nil
+}
+
+func TestInitialFieldValues(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, CreatedBy: uuid.New()}
+	now := time.Date(2026, 10, 17, 15, 30, 0, 0, time.UTC)
+
+	newField := func(name string, fieldType domain.FieldType, config string, required bool) domain.ProjectField {
+		return domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, Name: name, FieldType: fieldType, Config: config, IsRequired: required}
+	}
+	stage := newField("Stage", domain.FieldTypeSingleSelect, "{}", true)
+	fields := []domain.ProjectField{
+		stage,
+		newField("Due", domain.FieldTypeDate, `{"default_value":"today"}`, false),
+		newField("Owner", domain.FieldTypeSingleUser, `{"default_value":"creator"}`, false),
+		newField("Points", domain.FieldTypeNumber, `{"default_value":3}`, false),
+		newField("Note", domain.FieldTypeText, "{}", false),
+	}
+	first := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldID: stage.ID}
+	fieldRepo.On("FindOptionsByField", stage.ID).Return([]domain.FieldOption{first}, nil)
+	fieldRepo.On("FindOptionByID", first.ID).Return(&first, nil)
+
+	// Defaults: the first stage, today, the author, the configured number; no value without a default
+	values, err := initialFieldValues(fieldRepo, board, roleAuthorizer{}, fields, nil, now, zap.NewNop())
+	require.NoError(t, err)
+	require.Len(t, values, 4)
+	assert.Equal(t, first.ID, *values[0].ValueOptionID)
+	assert.Equal(t, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), *values[1].ValueDate)
+	assert.Equal(t, board.CreatedBy, *values[2].ValueUserID)
+	assert.Equal(t, 3.0, *values[3].ValueNumber)
+	for _, value := range values {
+		assert.Equal(t, board.ID, value.BoardID)
+	}
+
+	// Requested values come first and replace defaults
+	values, err = initialFieldValues(fieldRepo, board, roleAuthorizer{}, fields, map[string]interface{}{
+		fields[3].ID.String(): 8.0,
+		fields[4].ID.String(): "hello",
+	}, now, zap.NewNop())
+	require.NoError(t, err)
+	require.Len(t, values, 5)
+	assert.Equal(t, 8.0, *values[0].ValueNumber)
+	assert.Equal(t, "hello", *values[1].ValueText)
+
+	// Required fields must have a value
+	_, err = initialFieldValues(fieldRepo, board, roleAuthorizer{}, fields, map[string]interface{}{stage.ID.String(): nil}, now, zap.NewNop())
+	assertStatus(t, err, 400)
+	assert.Contains(t, err.Error(), "Stage")
+
+	// Values of other projects' fields and invalid values are rejected
+	_, err = initialFieldValues(fieldRepo, board, roleAuthorizer{}, fields, map[string]interface{}{uuid.New().String(): "x"}, now, zap.NewNop())
+	assertStatus(t, err, 400)
+	_, err = initialFieldValues(fieldRepo, board, roleAuthorizer{}, fields, map[string]interface{}{fields[3].ID.String(): "x"}, now, zap.NewNop())
+	assertStatus(t, err, 400)
+
+	// Restricted fields can only be set by their editors; defaults still apply
+	fields[4].SetEditRoles([]string{"ADMIN"})
+	member := &domain.Role{Name: "MEMBER", Level: 10}
+	_, err = initialFieldValues(fieldRepo, board, roleAuthorizer{role: member}, fields, map[string]interface{}{fields[4].ID.String(): "hello"}, now, zap.NewNop())
+	assertStatus(t, err, 403)
+	_, err = initialFieldValues(fieldRepo, board, roleAuthorizer{role: member}, fields, nil, now, zap.NewNop())
+	assert.NoError(t, err)
+}
+
+func TestRequestedFieldValues(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}}
+	fields := testProjectFields()
+	number, multi, checkbox := fields[0].ID, fields[2].ID, fields[3].ID
+	fields[2].IsRequired = true
+	option := uuid.New()
+	fieldRepo.On("FindOptionsByIDs", []uuid.UUID{option}).Return([]domain.FieldOption{{BaseModel: domain.BaseModel{ID: option}, FieldID: multi}}, nil)
+
+	// Only requested fields are updated, in field order; null clears a field
+	updates, err := requestedFieldValues(fieldRepo, board, roleAuthorizer{}, board.CreatedBy, fields, map[string]interface{}{
+		checkbox.String(): true,
+		number.String():   nil,
+		multi.String():    []interface{}{option.String()},
+	}, zap.NewNop())
+	require.NoError(t, err)
+	require.Len(t, updates, 3)
+	assert.Equal(t, number, updates[0].field.ID)
+	assert.Empty(t, updates[0].values)
+	assert.Equal(t, option, *updates[1].values[0].ValueOptionID)
+	assert.True(t, *updates[2].values[0].ValueBoolean)
+
+	// Required fields cannot be cleared
+	_, err = requestedFieldValues(fieldRepo, board, roleAuthorizer{}, board.CreatedBy, fields, map[string]interface{}{multi.String(): []interface{}{}}, zap.NewNop())
+	assertStatus(t, err, 400)
+
+	// The cache keeps other fields and replaces or drops the updated ones
+	kept, old := 5.0, false
+	current := []domain.BoardFieldValue{
+		{FieldID: number, ValueNumber: &kept},
+		{FieldID: checkbox, ValueBoolean: &old},
+	}
+	updates, err = requestedFieldValues(fieldRepo, board, roleAuthorizer{}, board.CreatedBy, fields, map[string]interface{}{checkbox.String(): true}, zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		number.String():   5.0,
+		checkbox.String(): true,
+	}, buildFieldsCache(mergeFieldValues(current, updates)))
+}
+
+func TestBuildFieldsCache(t *testing.T) {
+	single, multi := uuid.New(), uuid.New()
+	optionA, optionB := uuid.New(), uuid.New()
+	text := "hello"
+
+	cache := buildFieldsCache([]domain.BoardFieldValue{
+		{FieldID: single, ValueText: &text},
+		{FieldID: multi, ValueOptionID: &optionA},
+		{FieldID: multi, ValueOptionID: &optionB, DisplayOrder: 1},
+	})
+	assert.Equal(t, map[string]interface{}{
+		single.String(): "hello",
+		multi.String():  []interface{}{optionA.String(), optionB.String()},
+	}, cache)
+}
+
+func TestValidateDefaultValue(t *testing.T) {
+	assert.NoError(t, validateDefaultValue(domain.FieldTypeCheckbox, true))
+	assert.NoError(t, validateDefaultValue(domain.FieldTypeDate, "today"))
+	assert.NoError(t, validateDefaultValue(domain.FieldTypeDateTime, "2026-10-17T09:00:00Z"))
+	assert.NoError(t, validateDefaultValue(domain.FieldTypeMultiUser, []interface{}{"creator"}))
+
+	assert.Error(t, validateDefaultValue(domain.FieldTypeCheckbox, "true"))
+	assert.Error(t, validateDefaultValue(domain.FieldTypeNumber, "3"))
+	assert.Error(t, validateDefaultValue(domain.FieldTypeDate, "tomorrow"))
+	assert.Error(t, validateDefaultValue(domain.FieldTypeMultiSelect, "option"))
+}
+
+func TestFieldValueService_RequiredField(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+	boardRepo := new(testutil.MockBoardRepository)
+	projectRepo := new(testutil.MockProjectRepository)
+	s := &fieldValueService{
+		repo:        fieldRepo,
+		boardRepo:   boardRepo,
+		projectRepo: projectRepo,
+		authorizer:  auth.NewProjectAuthorizer(projectRepo, new(testutil.MockRoleRepository)),
+		logger:      zap.NewNop(),
+	}
+
+	userID, projectID, boardID := uuid.New(), uuid.New(), uuid.New()
+	field := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Name: "Labels", FieldType: domain.FieldTypeMultiSelect, Config: "{}", IsRequired: true}
+
+	boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{Role: &domain.Role{Name: "MEMBER", Level: 10}}, nil)
+	fieldRepo.On("FindFieldByID", field.ID).Return(field, nil)
+	fieldRepo.On("FindFieldValuesByBoardAndField", boardID, field.ID).Return([]domain.BoardFieldValue{}, nil)
+
+	// The last value of a required field can neither be deleted nor emptied
+	err := s.DeleteFieldValue(userID.String(), boardID.String(), field.ID.String())
+	assertStatus(t, err, 400)
+	err = s.SetMultiSelectValue(userID.String(), &dto.SetMultiSelectValueRequest{BoardID: boardID.String(), FieldID: field.ID.String()})
+	assertStatus(t, err, 400)
+	err = s.SetFieldValue(userID.String(), &dto.SetFieldValueRequest{BoardID: boardID.String(), FieldID: field.ID.String(), Values: []interface{}{}})
+	assertStatus(t, err, 400)
+	fieldRepo.AssertNotCalled(t, "DeleteFieldValue", boardID, field.ID)
+	fieldRepo.AssertNotCalled(t, "BatchDeleteFieldValues", boardID, field.ID)
+}
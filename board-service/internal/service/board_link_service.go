@@ -0,0 +1,344 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/realtime"
+	"board-service/internal/repository"
+	"board-service/internal/uow"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type BoardLinkService interface {
+	CreateLink(userID, boardID string, req *dto.CreateBoardLinkRequest) (*dto.BoardLinkResponse, error)
+	GetLinks(userID, boardID string) ([]dto.BoardLinkResponse, error)
+	DeleteLink(userID, boardID, linkID string) error
+}
+
+type boardLinkService struct {
+	linkRepo   repository.BoardLinkRepository
+	boardRepo  repository.BoardRepository
+	authorizer auth.ProjectAuthorizer
+	events     realtime.Publisher
+	uow        uow.UnitOfWork
+	logger     *zap.Logger
+}
+
+func NewBoardLinkService(
+	linkRepo repository.BoardLinkRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	eventPublisher realtime.Publisher,
+	unitOfWork uow.UnitOfWork,
+	logger *zap.Logger,
+) BoardLinkService {
+	return &boardLinkService{
+		linkRepo:   linkRepo,
+		boardRepo:  boardRepo,
+		authorizer: auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		events:     eventPublisher,
+		uow:        unitOfWork,
+		logger:     logger,
+	}
+}
+
+// ==================== Create Link ====================
+
+func (s *boardLinkService) CreateLink(userID, boardID string, req *dto.CreateBoardLinkRequest) (*dto.BoardLinkResponse, error) {
+	userUUID, board, err := s.findBoardAsMember(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetUUID, err := parser.ParseUUID(req.TargetBoardID, "대상 보드")
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.boardRepo.FindByID(targetUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "링크할 보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	link, err := domain.NewBoardLink(board, target, domain.BoardLinkType(req.LinkType), userUUID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	var response *dto.BoardLinkResponse
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		// Serialize link changes per project so concurrent requests cannot build a cycle together
+		if err := repos.Link.LockProject(board.ProjectID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "링크 생성 실패", 500)
+		}
+
+		existing, err := repos.Link.FindBetween(board.ID, target.ID)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "링크 조회 실패", 500)
+		}
+		for i := range existing {
+			if existing[i].SameAs(link) {
+				return apperrors.New(apperrors.ErrCodeConflict, "이미 존재하는 링크입니다", 409)
+			}
+		}
+
+		if link.LinkType == domain.BoardLinkBlocks {
+			blocking, err := repos.Link.FindBlockingByProject(board.ProjectID)
+			if err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "링크 조회 실패", 500)
+			}
+			if cycle := domain.FindBlockingCycle(blocking, link); cycle != nil {
+				return apperrors.New(apperrors.ErrCodeConflict,
+					"순환 blocking 관계가 생깁니다: "+s.describeCycle(cycle), 409)
+			}
+		}
+
+		if err := repos.Link.Create(link); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "링크 생성 실패", 500)
+		}
+		if err := s.recordLinkActivities(repos, domain.ActivityLinkAdded, link, board, target, userUUID); err != nil {
+			return err
+		}
+		response = toBoardLinkResponse(link, board.ID, target)
+		return recordEvent(repos.Event, realtime.EventBoardLinkAdded, board.ProjectID, board.ID, userUUID, response)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(s.events, s.logger, realtime.EventBoardLinkAdded, board.ProjectID, board.ID, userUUID, response)
+	return response, nil
+}
+
+// ==================== Get Links ====================
+
+func (s *boardLinkService) GetLinks(userID, boardID string) ([]dto.BoardLinkResponse, error) {
+	_, board, err := s.findBoardAsMember(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.linkRepo.FindByBoard(board.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "링크 조회 실패", 500)
+	}
+
+	others, err := loadLinkedBoards(s.boardRepo, links)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "링크 조회 실패", 500)
+	}
+
+	responses := make([]dto.BoardLinkResponse, 0, len(links))
+	for i := range links {
+		other, ok := others[links[i].OtherBoardID(board.ID)]
+		if !ok {
+			continue
+		}
+		responses = append(responses, *toBoardLinkResponse(&links[i], board.ID, other))
+	}
+	return responses, nil
+}
+
+// ==================== Delete Link ====================
+
+func (s *boardLinkService) DeleteLink(userID, boardID, linkID string) error {
+	userUUID, board, err := s.findBoardAsMember(userID, boardID)
+	if err != nil {
+		return err
+	}
+
+	linkUUID, err := parser.ParseUUID(linkID, "링크")
+	if err != nil {
+		return err
+	}
+
+	link, err := s.linkRepo.FindByID(linkUUID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "링크 조회 실패", 500)
+	}
+	if link == nil || !link.Involves(board.ID) {
+		return apperrors.New(apperrors.ErrCodeNotFound, "링크를 찾을 수 없습니다", 404)
+	}
+
+	other, err := s.boardRepo.FindByID(link.OtherBoardID(board.ID))
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	data := map[string]string{"linkId": link.ID.String()}
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Link.Delete(link.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "링크 삭제 실패", 500)
+		}
+		if other != nil {
+			if err := s.recordLinkActivities(repos, domain.ActivityLinkRemoved, link, board, other, userUUID); err != nil {
+				return err
+			}
+		}
+		return recordEvent(repos.Event, realtime.EventBoardLinkRemoved, board.ProjectID, board.ID, userUUID, data)
+	})
+	if err != nil {
+		return err
+	}
+
+	publishEvent(s.events, s.logger, realtime.EventBoardLinkRemoved, board.ProjectID, board.ID, userUUID, data)
+	return nil
+}
+
+// ==================== Helpers ====================
+
+// findBoardAsMember loads the board and checks that the user is a member of its project
+func (s *boardLinkService) findBoardAsMember(userID, boardID string) (uuid.UUID, *domain.Board, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	board, err := s.boardRepo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	if _, err := s.authorizer.RequireMember(userUUID, board.ProjectID); err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	return userUUID, board, nil
+}
+
+// recordLinkActivities writes the link change to the history of both boards
+func (s *boardLinkService) recordLinkActivities(
+	repos *uow.Repositories,
+	action domain.ActivityAction,
+	link *domain.BoardLink,
+	board, other *domain.Board,
+	actorID uuid.UUID,
+) error {
+	for _, pair := range [][2]*domain.Board{{board, other}, {other, board}} {
+		value := map[string]string{
+			"linkType": string(link.TypeFor(pair[0].ID)),
+			"boardId":  pair[1].ID.String(),
+			"title":    pair[1].Title,
+		}
+		change := domain.ActivityChange{Field: "link"}
+		if action == domain.ActivityLinkAdded {
+			change.NewValue = value
+		} else {
+			change.OldValue = value
+		}
+
+		activity, err := domain.NewBoardActivity(pair[0], actorID, action, []domain.ActivityChange{change})
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "활동 기록 생성 실패", 500)
+		}
+		if err := repos.Activity.Create(activity); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "활동 기록 저장 실패", 500)
+		}
+	}
+	return nil
+}
+
+// describeCycle renders a blocking cycle with board titles, e.g. "A → B → A"
+func (s *boardLinkService) describeCycle(cycle []uuid.UUID) string {
+	boards, err := s.boardRepo.FindByIDs(cycle)
+	titles := make(map[uuid.UUID]string, len(boards))
+	if err == nil {
+		for _, b := range boards {
+			titles[b.ID] = b.Title
+		}
+	}
+
+	names := make([]string, 0, len(cycle))
+	for _, id := range cycle {
+		if title, ok := titles[id]; ok {
+			names = append(names, title)
+		} else {
+			names = append(names, id.String())
+		}
+	}
+	return strings.Join(names, " → ")
+}
+
+// loadLinkedBoards batch fetches the boards on both ends of the links
+// Deleted boards are left out of the result.
+func loadLinkedBoards(boardRepo repository.BoardRepository, links []domain.BoardLink) (map[uuid.UUID]*domain.Board, error) {
+	boardIDs := make([]uuid.UUID, 0, len(links))
+	seen := make(map[uuid.UUID]bool)
+	for _, link := range links {
+		for _, id := range []uuid.UUID{link.SourceBoardID, link.TargetBoardID} {
+			if !seen[id] {
+				seen[id] = true
+				boardIDs = append(boardIDs, id)
+			}
+		}
+	}
+
+	result := make(map[uuid.UUID]*domain.Board, len(boardIDs))
+	if len(boardIDs) == 0 {
+		return result, nil
+	}
+
+	boards, err := boardRepo.FindByIDs(boardIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range boards {
+		result[boards[i].ID] = &boards[i]
+	}
+	return result, nil
+}
+
+// buildLinkSummaries groups links by board for BoardResponse.Links
+func buildLinkSummaries(links []domain.BoardLink, boardIDs []uuid.UUID, linked map[uuid.UUID]*domain.Board) map[uuid.UUID][]dto.BoardLinkSummary {
+	summaries := make(map[uuid.UUID][]dto.BoardLinkSummary, len(boardIDs))
+	for _, boardID := range boardIDs {
+		for i := range links {
+			if !links[i].Involves(boardID) {
+				continue
+			}
+			other, ok := linked[links[i].OtherBoardID(boardID)]
+			if !ok {
+				continue
+			}
+			summaries[boardID] = append(summaries[boardID], toBoardLinkSummary(&links[i], boardID, other))
+		}
+	}
+	return summaries
+}
+
+func toBoardLinkSummary(link *domain.BoardLink, boardID uuid.UUID, other *domain.Board) dto.BoardLinkSummary {
+	return dto.BoardLinkSummary{
+		LinkID:   link.ID.String(),
+		LinkType: string(link.TypeFor(boardID)),
+		BoardID:  other.ID.String(),
+		Title:    other.Title,
+	}
+}
+
+func toBoardLinkResponse(link *domain.BoardLink, boardID uuid.UUID, other *domain.Board) *dto.BoardLinkResponse {
+	return &dto.BoardLinkResponse{
+		BoardLinkSummary: toBoardLinkSummary(link, boardID, other),
+		CreatedBy:        link.CreatedBy.String(),
+		CreatedAt:        link.CreatedAt,
+	}
+}
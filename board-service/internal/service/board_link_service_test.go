@@ -0,0 +1,280 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/realtime"
+	"board-service/internal/testutil"
+	"board-service/internal/uow"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// recordingActivityRepository collects activities written inside the unit of work
+type recordingActivityRepository struct {
+	activities []domain.BoardActivity
+}
+
+func (r *recordingActivityRepository) Create(activity *domain.BoardActivity) error {
+	r.activities = append(r.activities, *activity)
+	return nil
+}
+
+func (r *recordingActivityRepository) FindByBoard(boardID uuid.UUID, page, limit int) ([]domain.BoardActivity, int64, error) {
+	return nil, 0, nil
+}
+
+// recordingEventRepository collects outbox events written inside the unit of work
+type recordingEventRepository struct {
+	events []domain.DomainEvent
+}
+
+func (r *recordingEventRepository) Create(event *domain.DomainEvent) error {
+	r.events = append(r.events, *event)
+	return nil
+}
+
+func (r *recordingEventRepository) FindPending(now time.Time, limit int) ([]domain.DomainEvent, error) {
+	return nil, nil
+}
+
+func (r *recordingEventRepository) Update(event *domain.DomainEvent) error {
+	return nil
+}
+
+// linkUnitOfWork runs the function against the mocked link repository without a database
+type linkUnitOfWork struct {
+	links      *testutil.MockBoardLinkRepository
+	activities *recordingActivityRepository
+	events     *recordingEventRepository
+}
+
+func (u *linkUnitOfWork) Do(fn func(repos *uow.Repositories) error) error {
+	return fn(&uow.Repositories{Link: u.links, Activity: u.activities, Event: u.events})
+}
+
+func (u *linkUnitOfWork) GetDB() *gorm.DB {
+	return nil
+}
+
+type boardLinkTestSuite struct {
+	service    BoardLinkService
+	boardRepo  *testutil.MockBoardRepository
+	linkRepo   *testutil.MockBoardLinkRepository
+	activities *recordingActivityRepository
+	events     *recordingEventRepository
+	userID     uuid.UUID
+	projectID  uuid.UUID
+}
+
+func setupBoardLinkTest(t *testing.T) *boardLinkTestSuite {
+	t.Helper()
+	suite := &boardLinkTestSuite{
+		boardRepo:  new(testutil.MockBoardRepository),
+		linkRepo:   new(testutil.MockBoardLinkRepository),
+		activities: &recordingActivityRepository{},
+		events:     &recordingEventRepository{},
+		userID:     uuid.New(),
+		projectID:  uuid.New(),
+	}
+
+	projectRepo := new(testutil.MockProjectRepository)
+	member := testutil.NewTestProjectMember(suite.projectID, suite.userID, uuid.New())
+	member.Role = &domain.Role{Name: "MEMBER", Level: 10}
+	projectRepo.On("FindMemberByUserAndProject", suite.userID, suite.projectID).Return(member, nil).Maybe()
+
+	suite.service = NewBoardLinkService(
+		suite.linkRepo,
+		suite.boardRepo,
+		projectRepo,
+		new(testutil.MockRoleRepository),
+		nil, // eventPublisher - realtime events not asserted
+		&linkUnitOfWork{links: suite.linkRepo, activities: suite.activities, events: suite.events},
+		zap.NewNop(),
+	)
+	return suite
+}
+
+func (s *boardLinkTestSuite) newBoard(title string) *domain.Board {
+	board := &domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: s.projectID,
+		Title:     title,
+		CreatedBy: s.userID,
+	}
+	s.boardRepo.On("FindByID", board.ID).Return(board, nil).Maybe()
+	return board
+}
+
+func TestBoardLinkService_CreateLink_NormalizesInverseType(t *testing.T) {
+	suite := setupBoardLinkTest(t)
+	a := suite.newBoard("A")
+	b := suite.newBoard("B")
+
+	suite.linkRepo.On("LockProject", suite.projectID).Return(nil)
+	suite.linkRepo.On("FindBetween", b.ID, a.ID).Return([]domain.BoardLink{}, nil)
+	suite.linkRepo.On("FindBlockingByProject", suite.projectID).Return([]domain.BoardLink{}, nil)
+	suite.linkRepo.On("Create", mock.MatchedBy(func(link *domain.BoardLink) bool {
+		// "B is_blocked_by A" is stored as "A blocks B"
+		return link.SourceBoardID == a.ID && link.TargetBoardID == b.ID && link.LinkType == domain.BoardLinkBlocks
+	})).Return(nil)
+
+	response, err := suite.service.CreateLink(suite.userID.String(), b.ID.String(), &dto.CreateBoardLinkRequest{
+		TargetBoardID: a.ID.String(),
+		LinkType:      string(domain.BoardLinkIsBlockedBy),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, string(domain.BoardLinkIsBlockedBy), response.LinkType)
+	assert.Equal(t, a.ID.String(), response.BoardID)
+	assert.Equal(t, "A", response.Title)
+
+	// Both boards get a history entry, each from its own side
+	require.Len(t, suite.activities.activities, 2)
+	assert.Equal(t, b.ID, suite.activities.activities[0].BoardID)
+	assert.Contains(t, suite.activities.activities[0].Changes, `"linkType":"is_blocked_by"`)
+	assert.Equal(t, a.ID, suite.activities.activities[1].BoardID)
+	assert.Contains(t, suite.activities.activities[1].Changes, `"linkType":"blocks"`)
+
+	// The event for webhooks is written to the outbox with the link
+	require.Len(t, suite.events.events, 1)
+	assert.Equal(t, string(realtime.EventBoardLinkAdded), suite.events.events[0].EventType)
+	assert.Equal(t, b.ID, suite.events.events[0].AggregateID)
+	suite.linkRepo.AssertExpectations(t)
+}
+
+func TestBoardLinkService_CreateLink_RejectsBlockingCycle(t *testing.T) {
+	suite := setupBoardLinkTest(t)
+	a := suite.newBoard("A")
+	b := suite.newBoard("B")
+	c := suite.newBoard("C")
+
+	// Existing: A blocks B, B blocks C
+	existing := []domain.BoardLink{
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: suite.projectID, SourceBoardID: a.ID, TargetBoardID: b.ID, LinkType: domain.BoardLinkBlocks},
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: suite.projectID, SourceBoardID: b.ID, TargetBoardID: c.ID, LinkType: domain.BoardLinkBlocks},
+	}
+	suite.linkRepo.On("LockProject", suite.projectID).Return(nil)
+	suite.linkRepo.On("FindBetween", c.ID, a.ID).Return([]domain.BoardLink{}, nil)
+	suite.linkRepo.On("FindBlockingByProject", suite.projectID).Return(existing, nil)
+	suite.boardRepo.On("FindByIDs", mock.Anything).Return([]domain.Board{*a, *b, *c}, nil)
+
+	// C blocks A would close the chain
+	_, err := suite.service.CreateLink(suite.userID.String(), c.ID.String(), &dto.CreateBoardLinkRequest{
+		TargetBoardID: a.ID.String(),
+		LinkType:      string(domain.BoardLinkBlocks),
+	})
+
+	require.Error(t, err)
+	appErr, ok := err.(*apperrors.AppError)
+	require.True(t, ok)
+	assert.Equal(t, 409, appErr.HTTPStatus)
+	assert.Contains(t, appErr.Message, "C → A → B → C")
+	suite.linkRepo.AssertNotCalled(t, "Create", mock.Anything)
+	assert.Empty(t, suite.activities.activities)
+}
+
+func TestBoardLinkService_CreateLink_RelatesToIsNotACycle(t *testing.T) {
+	suite := setupBoardLinkTest(t)
+	a := suite.newBoard("A")
+	b := suite.newBoard("B")
+
+	suite.linkRepo.On("LockProject", suite.projectID).Return(nil)
+	suite.linkRepo.On("FindBetween", a.ID, b.ID).Return([]domain.BoardLink{
+		{SourceBoardID: b.ID, TargetBoardID: a.ID, LinkType: domain.BoardLinkBlocks},
+	}, nil)
+	suite.linkRepo.On("Create", mock.Anything).Return(nil)
+
+	_, err := suite.service.CreateLink(suite.userID.String(), a.ID.String(), &dto.CreateBoardLinkRequest{
+		TargetBoardID: b.ID.String(),
+		LinkType:      string(domain.BoardLinkRelatesTo),
+	})
+
+	require.NoError(t, err)
+	suite.linkRepo.AssertNotCalled(t, "FindBlockingByProject", mock.Anything)
+}
+
+func TestBoardLinkService_CreateLink_RejectsDuplicateRelatesTo(t *testing.T) {
+	suite := setupBoardLinkTest(t)
+	a := suite.newBoard("A")
+	b := suite.newBoard("B")
+
+	// B relates_to A already exists; relates_to is symmetric
+	suite.linkRepo.On("LockProject", suite.projectID).Return(nil)
+	suite.linkRepo.On("FindBetween", a.ID, b.ID).Return([]domain.BoardLink{
+		{SourceBoardID: b.ID, TargetBoardID: a.ID, LinkType: domain.BoardLinkRelatesTo},
+	}, nil)
+
+	_, err := suite.service.CreateLink(suite.userID.String(), a.ID.String(), &dto.CreateBoardLinkRequest{
+		TargetBoardID: b.ID.String(),
+		LinkType:      string(domain.BoardLinkRelatesTo),
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 409, err.(*apperrors.AppError).HTTPStatus)
+}
+
+func TestBoardLinkService_CreateLink_RejectsSelfAndOtherProject(t *testing.T) {
+	suite := setupBoardLinkTest(t)
+	a := suite.newBoard("A")
+	other := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), Title: "Other"}
+	suite.boardRepo.On("FindByID", other.ID).Return(other, nil)
+
+	_, err := suite.service.CreateLink(suite.userID.String(), a.ID.String(), &dto.CreateBoardLinkRequest{
+		TargetBoardID: a.ID.String(),
+		LinkType:      string(domain.BoardLinkRelatesTo),
+	})
+	require.Error(t, err)
+	assert.Equal(t, 400, err.(*apperrors.AppError).HTTPStatus)
+
+	_, err = suite.service.CreateLink(suite.userID.String(), a.ID.String(), &dto.CreateBoardLinkRequest{
+		TargetBoardID: other.ID.String(),
+		LinkType:      string(domain.BoardLinkRelatesTo),
+	})
+	require.Error(t, err)
+	assert.Equal(t, 400, err.(*apperrors.AppError).HTTPStatus)
+}
+
+func TestBoardLinkService_DeleteLink_NotOnBoard(t *testing.T) {
+	suite := setupBoardLinkTest(t)
+	a := suite.newBoard("A")
+
+	link := &domain.BoardLink{BaseModel: domain.BaseModel{ID: uuid.New()}, SourceBoardID: uuid.New(), TargetBoardID: uuid.New()}
+	suite.linkRepo.On("FindByID", link.ID).Return(link, nil)
+
+	err := suite.service.DeleteLink(suite.userID.String(), a.ID.String(), link.ID.String())
+
+	require.Error(t, err)
+	assert.Equal(t, 404, err.(*apperrors.AppError).HTTPStatus)
+	suite.linkRepo.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func TestBuildLinkSummaries(t *testing.T) {
+	a := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, Title: "A"}
+	b := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, Title: "B"}
+	deleted := uuid.New()
+
+	links := []domain.BoardLink{
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, SourceBoardID: a.ID, TargetBoardID: b.ID, LinkType: domain.BoardLinkDuplicates},
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, SourceBoardID: deleted, TargetBoardID: a.ID, LinkType: domain.BoardLinkBlocks},
+	}
+	linked := map[uuid.UUID]*domain.Board{a.ID: a, b.ID: b}
+
+	summaries := buildLinkSummaries(links, []uuid.UUID{a.ID, b.ID}, linked)
+
+	// Links to boards that no longer exist are left out
+	require.Len(t, summaries[a.ID], 1)
+	assert.Equal(t, "duplicates", summaries[a.ID][0].LinkType)
+	assert.Equal(t, "B", summaries[a.ID][0].Title)
+	require.Len(t, summaries[b.ID], 1)
+	assert.Equal(t, "is_duplicated_by", summaries[b.ID][0].LinkType)
+	assert.Equal(t, a.ID.String(), summaries[b.ID][0].BoardID)
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/testutil"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestResolveColumnMove(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+	s := &boardService{fieldRepo: fieldRepo, logger: zap.NewNop()}
+
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New()}
+	stage := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: board.ProjectID, FieldType: domain.FieldTypeSingleSelect}
+	status := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: board.ProjectID, FieldType: domain.FieldTypeSingleSelect, IsRequired: true}
+	owner := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: board.ProjectID, FieldType: domain.FieldTypeSingleUser}
+	todo := &domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldID: stage.ID}
+
+	fieldRepo.On("FindFieldByID", stage.ID).Return(stage, nil)
+	fieldRepo.On("FindFieldByID", status.ID).Return(status, nil)
+	fieldRepo.On("FindFieldByID", owner.ID).Return(owner, nil)
+	fieldRepo.On("FindOptionByID", todo.ID).Return(todo, nil)
+
+	field, value, err := s.resolveColumnMove(board, stage.ID, todo.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, stage, field)
+	assert.Equal(t, todo.ID, *value)
+
+	// "" moves the board to the "(none)" column, unless the field is required
+	field, value, err = s.resolveColumnMove(board, stage.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, stage, field)
+	assert.Nil(t, value)
+	_, _, err = s.resolveColumnMove(board, status.ID, "")
+	assertStatus(t, err, 400)
+
+	// Columns of other groupings cannot be moved between
+	_, _, err = s.resolveColumnMove(board, owner.ID, uuid.New().String())
+	assertStatus(t, err, 400)
+	// Options of another field are rejected
+	_, _, err = s.resolveColumnMove(board, status.ID, todo.ID.String())
+	assertStatus(t, err, 400)
+}
+
+func TestResolveSwimlaneMove(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+	projectRepo := new(testutil.MockProjectRepository)
+	s := &boardService{fieldRepo: fieldRepo, projectRepo: projectRepo, logger: zap.NewNop()}
+
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New()}
+	stageID := uuid.New()
+	role := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: board.ProjectID, FieldType: domain.FieldTypeSingleSelect}
+	tags := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: board.ProjectID, FieldType: domain.FieldTypeMultiSelect}
+	backend := &domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldID: role.ID}
+	member, stranger := uuid.New(), uuid.New()
+
+	fieldRepo.On("FindFieldByID", role.ID).Return(role, nil)
+	fieldRepo.On("FindFieldByID", tags.ID).Return(tags, nil)
+	fieldRepo.On("FindOptionByID", backend.ID).Return(backend, nil)
+	fieldRepo.On("FindFieldValuesByBoardAndField", board.ID, role.ID).Return([]domain.BoardFieldValue{}, nil)
+	projectRepo.On("FindMemberByUserAndProject", member, board.ProjectID).Return(&domain.ProjectMember{}, nil)
+	projectRepo.On("FindMemberByUserAndProject", stranger, board.ProjectID).Return(nil, errors.New("record not found"))
+
+	value := func(s string) *string { return &s }
+
+	move, err := s.resolveSwimlaneMove(board, stageID, role.ID.String(), value(backend.ID.String()))
+	require.NoError(t, err)
+	assert.Equal(t, role, move.field)
+	assert.Equal(t, backend.ID, *move.value)
+
+	// "" moves the board to the "(none)" swimlane
+	move, err = s.resolveSwimlaneMove(board, stageID, "assignee", value(""))
+	require.NoError(t, err)
+	assert.Nil(t, move.field)
+	assert.Nil(t, move.value)
+
+	_, err = s.resolveSwimlaneMove(board, stageID, "assignee", value(member.String()))
+	require.NoError(t, err)
+
+	_, err = s.resolveSwimlaneMove(board, stageID, "assignee", value(stranger.String()))
+	assertStatus(t, err, 404)
+	_, err = s.resolveSwimlaneMove(board, stageID, role.ID.String(), nil)
+	assertStatus(t, err, 400)
+	_, err = s.resolveSwimlaneMove(board, stageID, stageID.String(), value(""))
+	assertStatus(t, err, 400)
+	_, err = s.resolveSwimlaneMove(board, stageID, tags.ID.String(), value(""))
+	assertStatus(t, err, 400)
+}
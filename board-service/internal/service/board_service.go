@@ -11,10 +11,13 @@ import (
 	"board-service/internal/domain"
 	"board-service/internal/dto"
 	"board-service/internal/metrics"
+	"board-service/internal/realtime"
 	"board-service/internal/repository"
+	"board-service/internal/storage"
 	"board-service/internal/uow"
 	"board-service/internal/util"
 	"context"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -30,21 +33,30 @@ type BoardService interface {
 	UpdateBoard(boardID, userID string, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error)
 	DeleteBoard(boardID, userID string) error
 	MoveBoard(userID, boardID string, req *dto.MoveBoardRequest) (*dto.MoveBoardResponse, error)
+	RescheduleBoard(boardID, userID string, req *dto.RescheduleBoardRequest) (*dto.BoardResponse, error)
+	GetBoardActivities(boardID, userID string, req *dto.GetBoardActivitiesRequest) (*dto.PaginatedBoardActivitiesResponse, error)
 }
 
 type boardService struct {
 	repo          repository.BoardRepository
 	projectRepo   repository.ProjectRepository
 	roleRepo      repository.RoleRepository
-	fieldRepo     repository.FieldRepository       // For custom fields system
-	commentRepo   repository.CommentRepository     // For UnitOfWork operations
-	authorizer    auth.ProjectAuthorizer           // Centralized authorization
+	fieldRepo     repository.FieldRepository         // For custom fields system
+	commentRepo   repository.CommentRepository       // For UnitOfWork operations
+	activityRepo  repository.BoardActivityRepository // Board activity history (audit trail)
+	linkRepo      repository.BoardLinkRepository     // Link summaries in board responses
+	checklistRepo repository.ChecklistRepository     // Checklist progress in board responses
+	files         storage.Storage                    // Attachment contents, removed with their board
+	mentions      MentionService                     // @mentions in board descriptions
+	notifications NotificationService                // In-app notifications (assignment, due date)
+	events        realtime.Publisher                 // Real-time project event stream
+	authorizer    auth.ProjectAuthorizer             // Centralized authorization
 	userClient    client.UserClient
 	userInfoCache cache.UserInfoCache
 	logger        *zap.Logger
 	db            *gorm.DB
-	uow           uow.UnitOfWork                   // Unit of Work for transaction management
-	mapper        *dto.BoardMapper                 // DTO Mapper for reducing duplication
+	uow           uow.UnitOfWork   // Unit of Work for transaction management
+	mapper        *dto.BoardMapper // DTO Mapper for reducing duplication
 }
 
 func NewBoardService(
@@ -53,6 +65,13 @@ func NewBoardService(
 	roleRepo repository.RoleRepository,
 	fieldRepo repository.FieldRepository,
 	commentRepo repository.CommentRepository,
+	activityRepo repository.BoardActivityRepository,
+	linkRepo repository.BoardLinkRepository,
+	checklistRepo repository.ChecklistRepository,
+	files storage.Storage,
+	mentionService MentionService,
+	notificationService NotificationService,
+	eventPublisher realtime.Publisher,
 	userClient client.UserClient,
 	userInfoCache cache.UserInfoCache,
 	logger *zap.Logger,
@@ -73,6 +92,13 @@ func NewBoardService(
 		roleRepo:      roleRepo,
 		fieldRepo:     fieldRepo,
 		commentRepo:   commentRepo,
+		activityRepo:  activityRepo,
+		linkRepo:      linkRepo,
+		checklistRepo: checklistRepo,
+		files:         files,
+		mentions:      mentionService,
+		notifications: notificationService,
+		events:        eventPublisher,
 		authorizer:    authorizer,
 		userClient:    userClient,
 		userInfoCache: userInfoCache,
@@ -134,22 +160,63 @@ func (s *boardService) CreateBoard(userID string, req *dto.CreateBoardRequest) (
 		}
 		dueDate = parsed
 	}
+	var startDate *time.Time
+	if req.StartDate != nil {
+		parsed, err := validator.ValidateDateFormat(*req.StartDate, "시작일")
+		if err != nil {
+			return nil, err
+		}
+		startDate = parsed
+	}
 
 	// 4. Create Board
 	board := &domain.Board{
-		ProjectID:         projectUUID,
-		Title:             req.Title,
-		Description:       req.Content,
-		AssigneeID:        assigneeUUID,
-		CreatedBy:         userUUID,
-		DueDate:           dueDate,
-		CustomFieldsCache: "{}",  // Initialize empty, use FieldValueService to set values
+		BaseModel:   domain.BaseModel{ID: uuid.New()}, // Known up front for the field values
+		ProjectID:   projectUUID,
+		Title:       req.Title,
+		Description: req.Content,
+		AssigneeID:  assigneeUUID,
+		CreatedBy:   userUUID,
+		DueDate:     dueDate,
+		StartDate:   startDate,
+	}
+	if err := board.ValidateSchedule(); err != nil {
+		return nil, apperrors.FromDomainError(err)
 	}
 
-	err = s.repo.Create(board)
+	// 5. Resolve custom field values: requested ones, then defaults; required fields must have one
+	fields, err := s.fieldRepo.FindFieldsByProject(projectUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	fieldValues, err := initialFieldValues(s.fieldRepo, board, s.authorizer, fields, req.FieldValues, time.Now(), s.logger)
+	if err != nil {
+		return nil, err
+	}
+	cacheJSON, err := json.Marshal(buildFieldsCache(fieldValues))
 	if err != nil {
-		s.logger.Error("Failed to create board", zap.Error(err))
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "칸반 생성 실패", 500)
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 캐시 생성 실패", 500)
+	}
+	board.CustomFieldsCache = string(cacheJSON)
+
+	// Save board, its field values and its domain event in a single transaction
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Board.Create(board); err != nil {
+			s.logger.Error("Failed to create board", zap.Error(err))
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "칸반 생성 실패", 500)
+		}
+		if len(fieldValues) > 0 {
+			if err := lockValueFields(repos.Field, fields, fieldValues); err != nil {
+				return err
+			}
+			if err := repos.Field.BatchSetFieldValues(fieldValues); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 설정 실패", 500)
+			}
+		}
+		return s.recordDomainEvent(repos, domain.EventTypeBoardCreated, board, userUUID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Metrics: Record success
@@ -157,11 +224,17 @@ func (s *boardService) CreateBoard(userID string, req *dto.CreateBoardRequest) (
 	metrics.BoardCreatedTotal.WithLabelValues(projectIDStr).Inc()
 	metrics.RecordDuration(start, metrics.BoardOperationDuration, "create", projectIDStr)
 
-	// Note: Custom field values (stage, role, importance) should be set via FieldValueService
-	// after board creation using /field-values API
+	s.recordMentions(board, userUUID)
+	s.notifyBoardChanges(nil, board, userUUID)
 
-	// 5. Build response
-	return s.buildBoardResponse(board)
+	// 6. Build response
+	response, err := s.buildBoardResponse(board)
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(s.events, s.logger, realtime.EventBoardCreated, board.ProjectID, board.ID, userUUID, response)
+	return response, nil
 }
 
 // ==================== Get Single Board ====================
@@ -334,11 +407,17 @@ func (s *boardService) GetBoards(userID string, req *dto.GetBoardsRequest) (*dto
 		}
 	}
 
-	// 11. Build responses
+	// 11. Batch fetch link summaries and checklist progress
+	linksMap := s.loadLinkSummaries(boardIDs)
+	progressMap := loadChecklistProgress(s.checklistRepo, s.logger, boardIDs)
+
+	// 12. Build responses
 	responses := make([]dto.BoardResponse, 0, len(boards))
 	for _, board := range boards {
 		response, err := s.buildBoardResponseOptimized(&board, userMap, fieldValuesMap, fieldsMap, optionsMap)
 		if err == nil && response != nil {
+			response.Links = linksMap[board.ID]
+			response.Checklist = toChecklistProgress(progressMap[board.ID])
 			responses = append(responses, *response)
 		}
 	}
@@ -386,6 +465,9 @@ func (s *boardService) UpdateBoard(boardID, userID string, req *dto.UpdateBoardR
 		return nil, apperrors.New(apperrors.ErrCodeForbidden, "수정 권한이 없습니다", 403)
 	}
 
+	// Snapshot for activity history diff
+	before := *board
+
 	// 3. Update fields using Domain methods (Rich Domain Model)
 	if req.Title != "" {
 		// Domain 메서드 사용: 검증 로직이 Domain에 포함됨
@@ -399,9 +481,6 @@ func (s *boardService) UpdateBoard(boardID, userID string, req *dto.UpdateBoardR
 		board.UpdateDescription(req.Content)
 	}
 
-	// Note: Stage, Importance, and Role updates should now be done via FieldValueService
-	// using /field-values API endpoints
-
 	if req.AssigneeID != nil {
 		assigneeUUID, err := parser.ParseOptionalUUID(req.AssigneeID, "담당자")
 		if err != nil {
@@ -429,10 +508,45 @@ func (s *boardService) UpdateBoard(boardID, userID string, req *dto.UpdateBoardR
 		// Domain 메서드 사용: 마감일 설정 로직이 Domain에 캡슐화됨
 		board.SetDueDate(*dueDate)
 	}
+	if req.StartDate != nil {
+		startDate, err := validator.ValidateDateFormat(*req.StartDate, "시작일")
+		if err != nil {
+			return nil, err
+		}
+		board.SetStartDate(*startDate)
+	}
+	if err := board.ValidateSchedule(); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	// 4. Validate custom field values and rebuild the board's field cache with them
+	fieldUpdates, err := s.resolveFieldValueUpdates(board, userUUID, req.FieldValues)
+	if err != nil {
+		return nil, err
+	}
 
-	// 4. Save board
-	if err := s.repo.Update(board); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 수정 실패", 500)
+	// 5. Save board, its field values and record activity in a single transaction
+	changes := domain.DiffBoard(&before, board)
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Board.Update(board); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 수정 실패", 500)
+		}
+		fieldChanges, err := s.applyFieldValueUpdates(repos, board, fieldUpdates)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, fieldChanges...)
+
+		if len(changes) == 0 {
+			return nil
+		}
+		if err := s.recordActivity(repos, board, userUUID, domain.ActivityBoardUpdated, changes); err != nil {
+			return err
+		}
+		return s.recordDomainEvent(repos, domain.EventTypeBoardUpdated, board, userUUID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Metrics: Record success
@@ -440,8 +554,114 @@ func (s *boardService) UpdateBoard(boardID, userID string, req *dto.UpdateBoardR
 	metrics.BoardUpdatedTotal.WithLabelValues(projectIDStr).Inc()
 	metrics.RecordDuration(start, metrics.BoardOperationDuration, "update", projectIDStr)
 
-	// 5. Return updated board
-	return s.GetBoard(board.ID.String(), userID)
+	if board.Description != before.Description {
+		s.recordMentions(board, userUUID)
+	}
+	s.notifyBoardChanges(&before, board, userUUID)
+
+	// 6. Return updated board
+	response, err := s.GetBoard(board.ID.String(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(s.events, s.logger, realtime.EventBoardUpdated, board.ProjectID, board.ID, userUUID, response)
+	return response, nil
+}
+
+// resolveFieldValueUpdates validates the requested field values of an existing board and sets its
+// custom_fields_cache to the values it will have once they are written
+func (s *boardService) resolveFieldValueUpdates(board *domain.Board, userUUID uuid.UUID, requested map[string]interface{}) ([]fieldValueUpdate, error) {
+	if len(requested) == 0 {
+		return nil, nil
+	}
+
+	fields, err := s.fieldRepo.FindFieldsByProject(board.ProjectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	updates, err := requestedFieldValues(s.fieldRepo, board, s.authorizer, userUUID, fields, requested, s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.fieldRepo.FindFieldValuesByBoard(board.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 필드 값 조회 실패", 500)
+	}
+	for i := range updates {
+		for _, value := range current {
+			if value.FieldID == updates[i].field.ID {
+				updates[i].previous = append(updates[i].previous, value)
+			}
+		}
+	}
+
+	cacheJSON, err := json.Marshal(buildFieldsCache(mergeFieldValues(current, updates)))
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 캐시 생성 실패", 500)
+	}
+	board.CustomFieldsCache = string(cacheJSON)
+	return updates, nil
+}
+
+// applyFieldValueUpdates replaces the values of the updated fields within the update's transaction
+// and returns the activity changes
+func (s *boardService) applyFieldValueUpdates(repos *uow.Repositories, board *domain.Board, updates []fieldValueUpdate) ([]domain.ActivityChange, error) {
+	var changes []domain.ActivityChange
+	for _, update := range updates {
+		if err := lockFieldType(repos.Field, update.field); err != nil {
+			return nil, err
+		}
+		if err := repos.Field.BatchDeleteFieldValues(board.ID, update.field.ID); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 필드 값 삭제 실패", 500)
+		}
+		if len(update.values) > 0 {
+			if err := repos.Field.BatchSetFieldValues(update.values); err != nil {
+				return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 설정 실패", 500)
+			}
+		}
+		if change := buildFieldValueChange(s.fieldRepo, update.field, update.previous, update.values); change != nil {
+			changes = append(changes, *change)
+		}
+	}
+	return changes, nil
+}
+
+// ==================== Reschedule Board ====================
+
+// RescheduleBoard moves a board to start at req.StartDate and shifts its due date by the same amount,
+// so the board keeps its duration. The dates are saved through UpdateBoard (permission, activity, events).
+func (s *boardService) RescheduleBoard(boardID, userID string, req *dto.RescheduleBoardRequest) (*dto.BoardResponse, error) {
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, err := validator.ValidateDateFormat(req.StartDate, "시작일")
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.repo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	board.Reschedule(*startDate)
+	update := &dto.UpdateBoardRequest{}
+	if board.StartDate != nil {
+		value := board.StartDate.Format(time.RFC3339Nano)
+		update.StartDate = &value
+	}
+	if board.DueDate != nil {
+		value := board.DueDate.Format(time.RFC3339Nano)
+		update.DueDate = &value
+	}
+	return s.UpdateBoard(boardID, userID, update)
 }
 
 // ==================== Delete Board (Soft) ====================
@@ -483,6 +703,7 @@ func (s *boardService) DeleteBoard(boardID, userID string) error {
 	projectIDStr := board.ProjectID.String()
 
 	// 3. UnitOfWork로 보드와 댓글을 트랜잭션으로 삭제
+	var attachments []domain.Attachment
 	err = s.uow.Do(func(repos *uow.Repositories) error {
 		// 3-1. 보드 삭제 (Domain 메서드 사용)
 		board.MarkAsDeleted()
@@ -490,7 +711,30 @@ func (s *boardService) DeleteBoard(boardID, userID string) error {
 			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 삭제 실패", 500)
 		}
 
-		// 3-2. 관련 댓글 모두 조회 및 삭제
+		// 3-2. 활동 기록
+		if err := s.recordActivity(repos, board, userUUID, domain.ActivityBoardDeleted, nil); err != nil {
+			return err
+		}
+		if err := s.recordDomainEvent(repos, domain.EventTypeBoardDeleted, board, userUUID); err != nil {
+			return err
+		}
+
+		// 3-3. 다른 보드와의 링크 삭제
+		if err := repos.Link.DeleteByBoard(board.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "링크 삭제 실패", 500)
+		}
+
+		// 3-4. 첨부파일 메타데이터 삭제 (파일은 커밋 후 삭제)
+		found, err := repos.Attachment.FindByBoard(board.ID)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부파일 조회 실패", 500)
+		}
+		attachments = found
+		if err := repos.Attachment.DeleteByBoard(board.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부파일 삭제 실패", 500)
+		}
+
+		// 3-5. 관련 댓글 모두 조회 및 삭제
 		comments, err := repos.Comment.FindByBoardID(boardUUID)
 		if err != nil {
 			// 댓글이 없을 수도 있으므로 NotFound는 무시
@@ -519,11 +763,27 @@ func (s *boardService) DeleteBoard(boardID, userID string) error {
 	if err == nil {
 		metrics.BoardDeletedTotal.WithLabelValues(projectIDStr).Inc()
 		metrics.RecordDuration(start, metrics.BoardOperationDuration, "delete", projectIDStr)
+
+		publishEvent(s.events, s.logger, realtime.EventBoardDeleted, board.ProjectID, board.ID, userUUID, nil)
+		s.removeAttachmentFiles(attachments)
 	}
 
 	return err
 }
 
+// removeAttachmentFiles deletes the stored files of attachments deleted with their board
+func (s *boardService) removeAttachmentFiles(attachments []domain.Attachment) {
+	for _, attachment := range attachments {
+		if err := s.files.Delete(context.Background(), attachment.StorageKey); err != nil {
+			s.logger.Warn("Failed to delete attachment object",
+				zap.String("attachment_id", attachment.ID.String()),
+				zap.String("key", attachment.StorageKey),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
 // ==================== Helper: Build Board Response ====================
 
 func (s *boardService) buildBoardResponse(board *domain.Board) (*dto.BoardResponse, error) {
@@ -596,9 +856,32 @@ func (s *boardService) buildBoardResponse(board *domain.Board) (*dto.BoardRespon
 		}
 	}
 
+	response.Links = s.loadLinkSummaries([]uuid.UUID{board.ID})[board.ID]
+	response.Checklist = toChecklistProgress(loadChecklistProgress(s.checklistRepo, s.logger, []uuid.UUID{board.ID})[board.ID])
+
 	return response, nil
 }
 
+// loadLinkSummaries batch fetches the link summaries of the given boards, by board ID
+func (s *boardService) loadLinkSummaries(boardIDs []uuid.UUID) map[uuid.UUID][]dto.BoardLinkSummary {
+	links, err := s.linkRepo.FindByBoards(boardIDs)
+	if err != nil {
+		s.logger.Warn("Failed to fetch board links", zap.Error(err))
+		return nil
+	}
+	if len(links) == 0 {
+		return nil
+	}
+
+	linked, err := loadLinkedBoards(s.repo, links)
+	if err != nil {
+		s.logger.Warn("Failed to fetch linked boards", zap.Error(err))
+		return nil
+	}
+
+	return buildLinkSummaries(links, boardIDs, linked)
+}
+
 // buildBoardResponseOptimized builds a board response using pre-fetched data (batch optimized)
 func (s *boardService) buildBoardResponseOptimized(
 	board *domain.Board,
@@ -685,6 +968,9 @@ func (s *boardService) getUserInfoBatch(ctx context.Context, userIDs []string) m
 // MoveBoard moves a board to a different column/group in a view
 // This API combines field value change + position update in a single transaction
 // Uses fractional indexing for O(1) operations - only 1 row updated!
+// In views with swimlanes the target row is changed in the same transaction.
+// Only views grouped by a single- or multi-select field can be moved between (400 otherwise); an empty
+// NewFieldValue moves the board to the "(none)" column, which required fields do not allow.
 func (s *boardService) MoveBoard(userID, boardID string, req *dto.MoveBoardRequest) (*dto.MoveBoardResponse, error) {
 	// Parse UUIDs using common parser
 	userUUID, err := parser.ParseUserID(userID)
@@ -707,11 +993,6 @@ func (s *boardService) MoveBoard(userID, boardID string, req *dto.MoveBoardReque
 		return nil, err
 	}
 
-	newValueUUID, err := parser.ParseUUID(req.NewFieldValue, "필드 값")
-	if err != nil {
-		return nil, err
-	}
-
 	// 1. Fetch board
 	board, err := s.repo.FindByID(boardUUID)
 	if err != nil {
@@ -721,41 +1002,50 @@ func (s *boardService) MoveBoard(userID, boardID string, req *dto.MoveBoardReque
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
 	}
 
-	// 2. Check project membership
-	_, err = s.projectRepo.FindMemberByUserAndProject(userUUID, board.ProjectID)
+	// 2. Validate the grouping field and the target column (option, or none)
+	field, newValue, err := s.resolveColumnMove(board, fieldUUID, req.NewFieldValue)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
-		}
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
+		return nil, err
 	}
 
-	// 3. Fetch field to validate
-	field, err := s.fieldRepo.FindFieldByID(fieldUUID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, apperrors.New(apperrors.ErrCodeNotFound, "필드를 찾을 수 없습니다", 404)
-		}
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	// 3. Check the user is a project member whose role may edit the field
+	if _, err := s.authorizer.RequireFieldEditor(userUUID, field); err != nil {
+		return nil, err
 	}
 
-	// 4. Validate field belongs to board's project
-	if field.ProjectID != board.ProjectID {
-		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "필드가 보드의 프로젝트에 속하지 않습니다", 400)
+	// Previous values for activity history
+	previousValues, err := s.fieldRepo.FindFieldValuesByBoardAndField(boardUUID, fieldUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 필드 값 조회 실패", 500)
 	}
 
-	// 5. Validate field type (only single_select and multi_select supported for grouping)
-	if field.FieldType != domain.FieldTypeSingleSelect && field.FieldType != domain.FieldTypeMultiSelect {
-		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "Single-select 또는 Multi-select 필드만 그룹핑에 사용할 수 있습니다", 400)
+	// Validate the target swimlane (row), if the view has swimlanes
+	var lane *swimlaneMove
+	if req.SwimlaneBy != "" {
+		lane, err = s.resolveSwimlaneMove(board, fieldUUID, req.SwimlaneBy, req.NewSwimlaneValue)
+		if err != nil {
+			return nil, err
+		}
+		if lane.field != nil {
+			if _, err := s.authorizer.RequireFieldEditor(userUUID, lane.field); err != nil {
+				return nil, err
+			}
+		} else {
+			// Changing the assignee is a board edit (author or ADMIN+)
+			canEdit, err := s.authorizer.CanEdit(userUUID, board.ProjectID, board.CreatedBy)
+			if err != nil {
+				return nil, err
+			}
+			if !canEdit {
+				return nil, apperrors.New(apperrors.ErrCodeForbidden, "수정 권한이 없습니다", 403)
+			}
+		}
 	}
 
-	// 6. Validate option exists
-	option, err := s.fieldRepo.FindOptionByID(newValueUUID)
-	if err != nil || option.FieldID != fieldUUID {
-		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "유효하지 않은 옵션입니다", 400)
-	}
+	// Snapshot for the assignee notification
+	before := *board
 
-	// 7. Generate new position using fractional indexing
+	// 4. Generate new position using fractional indexing
 	var beforePos, afterPos string
 	if req.BeforePosition != nil {
 		beforePos = *req.BeforePosition
@@ -767,45 +1057,70 @@ func (s *boardService) MoveBoard(userID, boardID string, req *dto.MoveBoardReque
 	// Import util package for fractional indexing
 	newPosition := util.GeneratePositionBetween(beforePos, afterPos)
 
-	// 8. Execute in transaction
+	// 5. Execute in transaction (UnitOfWork)
 	var finalPosition string
-	err = s.db.Transaction(func(tx *gorm.DB) error {
-		// 8-1. Update field value (change column)
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		// 5-1. Update field value (change column)
 		// Delete old value first
-		if err := s.fieldRepo.BatchDeleteFieldValues(boardUUID, fieldUUID); err != nil {
+		if err := lockFieldType(repos.Field, field); err != nil {
+			return err
+		}
+		if err := repos.Field.BatchDeleteFieldValues(boardUUID, fieldUUID); err != nil {
 			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 필드 값 삭제 실패", 500)
 		}
 
-		// Set new value
-		newFieldValue := &domain.BoardFieldValue{
-			BoardID:       boardUUID,
-			FieldID:       fieldUUID,
-			ValueOptionID: &newValueUUID,
-			DisplayOrder:  0,
+		// Set new value, unless moving to the "(none)" column
+		newFieldValues := []domain.BoardFieldValue{}
+		if newValue != nil {
+			newFieldValue := domain.BoardFieldValue{
+				BoardID:       boardUUID,
+				FieldID:       fieldUUID,
+				ValueOptionID: newValue,
+				DisplayOrder:  0,
+			}
+			if err := repos.Field.SetFieldValue(&newFieldValue); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 설정 실패", 500)
+			}
+			newFieldValues = append(newFieldValues, newFieldValue)
 		}
-		if err := s.fieldRepo.SetFieldValue(newFieldValue); err != nil {
-			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 설정 실패", 500)
+
+		// 5-2. Update swimlane value (change row)
+		var laneChanges []domain.ActivityChange
+		if lane != nil {
+			var err error
+			if laneChanges, err = s.applySwimlaneMove(repos, board, lane); err != nil {
+				return err
+			}
 		}
 
-		// 8-2. Update board position (fractional indexing - only 1 row!)
+		// 5-3. Update board position (fractional indexing - only 1 row!)
 		boardOrder := domain.UserBoardOrder{
 			ViewID:   viewUUID,
 			UserID:   userUUID,
 			BoardID:  boardUUID,
 			Position: newPosition,
 		}
-		if err := s.fieldRepo.SetBoardOrder(&boardOrder); err != nil {
+		if err := repos.Field.SetBoardOrder(&boardOrder); err != nil {
 			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 순서 업데이트 실패", 500)
 		}
 
 		finalPosition = newPosition
 
-		// 8-3. Update JSONB cache
-		if _, err := s.fieldRepo.UpdateBoardFieldCache(boardUUID); err != nil {
+		// 5-4. Update JSONB cache
+		if _, err := repos.Field.UpdateBoardFieldCache(boardUUID); err != nil {
 			s.logger.Warn("Failed to update board cache", zap.Error(err))
 		}
 
-		return nil
+		// 5-5. Record activity (option labels resolved for history)
+		changes := []domain.ActivityChange{}
+		if change := buildFieldValueChange(s.fieldRepo, field, previousValues, newFieldValues); change != nil {
+			changes = append(changes, *change)
+		}
+		changes = append(changes, laneChanges...)
+		if err := s.recordActivity(repos, board, userUUID, domain.ActivityBoardMoved, changes); err != nil {
+			return err
+		}
+		return s.recordDomainEvent(repos, domain.EventTypeBoardMoved, board, userUUID)
 	})
 
 	if err != nil {
@@ -815,10 +1130,296 @@ func (s *boardService) MoveBoard(userID, boardID string, req *dto.MoveBoardReque
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 이동 실패", 500)
 	}
 
-	return &dto.MoveBoardResponse{
+	response := &dto.MoveBoardResponse{
 		BoardID:       boardID,
 		NewFieldValue: req.NewFieldValue,
 		NewPosition:   finalPosition,
 		Message:       "보드가 성공적으로 이동되었습니다 (O(1) 연산)",
+	}
+	if lane != nil {
+		response.NewSwimlaneValue = req.NewSwimlaneValue
+	}
+
+	s.notifyBoardChanges(&before, board, userUUID)
+	publishEvent(s.events, s.logger, realtime.EventBoardMoved, board.ProjectID, board.ID, userUUID, response)
+	return response, nil
+}
+
+// resolveColumnMove validates the grouping field of a move and its target column
+// Only select fields can be moved between; newValue is an option of the field, or "" for the "(none)" column.
+func (s *boardService) resolveColumnMove(board *domain.Board, fieldID uuid.UUID, newValue string) (*domain.ProjectField, *uuid.UUID, error) {
+	field, err := s.fieldRepo.FindFieldByID(fieldID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "필드를 찾을 수 없습니다", 404)
+		}
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	if field.ProjectID != board.ProjectID {
+		return nil, nil, apperrors.New(apperrors.ErrCodeBadRequest, "필드가 보드의 프로젝트에 속하지 않습니다", 400)
+	}
+
+	// Other groupings (users, dates, ...) have no column values a move could set
+	if field.FieldType != domain.FieldTypeSingleSelect && field.FieldType != domain.FieldTypeMultiSelect {
+		return nil, nil, apperrors.New(apperrors.ErrCodeBadRequest, "Single-select 또는 Multi-select 필드로 그룹핑된 뷰에서만 보드를 다른 열로 이동할 수 있습니다", 400)
+	}
+
+	if newValue == "" {
+		if field.IsRequired {
+			return nil, nil, requiredFieldError(field)
+		}
+		return field, nil, nil
+	}
+
+	optionID, err := parser.ParseUUID(newValue, "필드 값")
+	if err != nil {
+		return nil, nil, err
+	}
+	option, err := s.fieldRepo.FindOptionByID(optionID)
+	if err != nil || option.FieldID != fieldID {
+		return nil, nil, apperrors.New(apperrors.ErrCodeBadRequest, "유효하지 않은 옵션입니다", 400)
+	}
+	if option.IsArchived {
+		return nil, nil, archivedOptionError(option)
+	}
+	return field, &optionID, nil
+}
+
+// swimlaneMove is a validated target swimlane of a move
+type swimlaneMove struct {
+	field    *domain.ProjectField // nil when swimlanes are by assignee
+	value    *uuid.UUID           // Option or user ID; nil moves to the "(none)" swimlane
+	previous []domain.BoardFieldValue
+}
+
+// resolveSwimlaneMove validates the target swimlane: the assignee, or a single-select or single-user field
+// other than the column field
+func (s *boardService) resolveSwimlaneMove(board *domain.Board, columnFieldID uuid.UUID, swimlaneBy string, newValue *string) (*swimlaneMove, error) {
+	if newValue == nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "이동할 스윔레인 값이 필요합니다", 400)
+	}
+	move := &swimlaneMove{}
+	if *newValue != "" {
+		value, err := parser.ParseUUID(*newValue, "스윔레인 값")
+		if err != nil {
+			return nil, err
+		}
+		move.value = &value
+	}
+
+	if swimlaneBy == "assignee" || swimlaneBy == "assignee_id" {
+		if move.value != nil {
+			if _, err := s.projectRepo.FindMemberByUserAndProject(*move.value, board.ProjectID); err != nil {
+				return nil, apperrors.New(apperrors.ErrCodeNotFound, "담당자가 프로젝트 멤버가 아닙니다", 404)
+			}
+		}
+		return move, nil
+	}
+
+	fieldUUID, err := parser.ParseFieldID(swimlaneBy)
+	if err != nil {
+		return nil, err
+	}
+	if fieldUUID == columnFieldID {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "스윔레인은 그룹핑과 다른 필드여야 합니다", 400)
+	}
+	field, err := s.fieldRepo.FindFieldByID(fieldUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "필드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	if field.ProjectID != board.ProjectID {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "필드가 보드의 프로젝트에 속하지 않습니다", 400)
+	}
+	move.field = field
+	if move.value == nil && field.IsRequired {
+		return nil, requiredFieldError(field)
+	}
+
+	switch field.FieldType {
+	case domain.FieldTypeSingleSelect:
+		if move.value != nil {
+			option, err := s.fieldRepo.FindOptionByID(*move.value)
+			if err != nil || option.FieldID != fieldUUID {
+				return nil, apperrors.New(apperrors.ErrCodeBadRequest, "유효하지 않은 옵션입니다", 400)
+			}
+			if option.IsArchived {
+				return nil, archivedOptionError(option)
+			}
+		}
+	case domain.FieldTypeSingleUser:
+		if move.value != nil {
+			if _, err := s.projectRepo.FindMemberByUserAndProject(*move.value, board.ProjectID); err != nil {
+				return nil, apperrors.New(apperrors.ErrCodeBadRequest, "사용자가 프로젝트 멤버가 아닙니다", 400)
+			}
+		}
+	default:
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "Single-select 또는 Single-user 필드 스윔레인으로만 이동할 수 있습니다", 400)
+	}
+
+	move.previous, err = s.fieldRepo.FindFieldValuesByBoardAndField(board.ID, fieldUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 필드 값 조회 실패", 500)
+	}
+	return move, nil
+}
+
+// applySwimlaneMove writes the target swimlane value within the move's transaction and returns the activity changes
+func (s *boardService) applySwimlaneMove(repos *uow.Repositories, board *domain.Board, move *swimlaneMove) ([]domain.ActivityChange, error) {
+	if move.field == nil {
+		before := *board
+		if move.value != nil {
+			board.Assign(*move.value)
+		} else {
+			board.Unassign()
+		}
+		if err := repos.Board.Update(board); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "담당자 변경 실패", 500)
+		}
+		return domain.DiffBoard(&before, board), nil
+	}
+
+	if err := lockFieldType(repos.Field, move.field); err != nil {
+		return nil, err
+	}
+	if err := repos.Field.BatchDeleteFieldValues(board.ID, move.field.ID); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 필드 값 삭제 실패", 500)
+	}
+	after := []domain.BoardFieldValue{}
+	if move.value != nil {
+		value := domain.BoardFieldValue{BoardID: board.ID, FieldID: move.field.ID}
+		if move.field.FieldType == domain.FieldTypeSingleUser {
+			value.ValueUserID = move.value
+		} else {
+			value.ValueOptionID = move.value
+		}
+		if err := repos.Field.SetFieldValue(&value); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 설정 실패", 500)
+		}
+		after = append(after, value)
+	}
+
+	if change := buildFieldValueChange(s.fieldRepo, move.field, move.previous, after); change != nil {
+		return []domain.ActivityChange{*change}, nil
+	}
+	return nil, nil
+}
+
+// ==================== Board Activity History ====================
+
+// GetBoardActivities returns the activity history of a board (newest first)
+func (s *boardService) GetBoardActivities(boardID, userID string, req *dto.GetBoardActivitiesRequest) (*dto.PaginatedBoardActivitiesResponse, error) {
+	// Parse UUIDs using common parser
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Find board
+	board, err := s.repo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	// 2. Check if user is project member (using authorizer)
+	if _, err := s.authorizer.RequireMember(userUUID, board.ProjectID); err != nil {
+		return nil, err
+	}
+
+	// 3. Fetch activities
+	page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
+	activities, total, err := s.activityRepo.FindByBoard(boardUUID, page, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "활동 기록 조회 실패", 500)
+	}
+
+	// 4. Batch fetch actors
+	userIDs := make([]string, 0, len(activities))
+	for _, activity := range activities {
+		userIDs = append(userIDs, activity.ActorID.String())
+	}
+	userMap := s.getUserInfoBatch(context.Background(), userIDs)
+
+	// 5. Build responses
+	responses := make([]dto.BoardActivityResponse, 0, len(activities))
+	for i := range activities {
+		responses = append(responses, s.mapper.ToActivityResponse(&activities[i], userMap))
+	}
+
+	return &dto.PaginatedBoardActivitiesResponse{
+		Activities: responses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
 	}, nil
 }
+
+// recordActivity persists an activity entry within the current UnitOfWork transaction
+func (s *boardService) recordActivity(
+	repos *uow.Repositories,
+	board *domain.Board,
+	actorID uuid.UUID,
+	action domain.ActivityAction,
+	changes []domain.ActivityChange,
+) error {
+	activity, err := domain.NewBoardActivity(board, actorID, action, changes)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "활동 기록 생성 실패", 500)
+	}
+	if err := repos.Activity.Create(activity); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "활동 기록 저장 실패", 500)
+	}
+	return nil
+}
+
+// recordDomainEvent appends a domain event to the outbox within the current UnitOfWork transaction
+// The outbox relay publishes it to other services after the transaction commits
+func (s *boardService) recordDomainEvent(
+	repos *uow.Repositories,
+	eventType string,
+	board *domain.Board,
+	actorID uuid.UUID,
+) error {
+	event, err := domain.NewBoardDomainEvent(eventType, board, actorID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "도메인 이벤트 생성 실패", 500)
+	}
+	if err := repos.Event.Create(event); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "도메인 이벤트 저장 실패", 500)
+	}
+	return nil
+}
+
+// recordMentions stores @mentions in the board description
+func (s *boardService) recordMentions(board *domain.Board, authorID uuid.UUID) {
+	if _, err := s.mentions.RecordMentions(context.Background(), board.ID, domain.MentionSourceBoard, board.ID, authorID, board.Description); err != nil {
+		s.logger.Warn("Failed to record board mentions",
+			zap.String("board_id", board.ID.String()),
+			zap.Error(err))
+	}
+}
+
+// notifyBoardChanges notifies the assignee about a new assignment or a due date change
+// before is nil for a newly created board
+func (s *boardService) notifyBoardChanges(before, board *domain.Board, actorID uuid.UUID) {
+	n := domain.NewBoardChangeNotification(before, board, actorID)
+	if n == nil {
+		return
+	}
+
+	if err := s.notifications.Notify(context.Background(), []domain.Notification{*n}); err != nil {
+		s.logger.Warn("Failed to notify board assignee",
+			zap.String("board_id", board.ID.String()),
+			zap.Error(err))
+	}
+}
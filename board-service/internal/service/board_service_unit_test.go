@@ -141,6 +141,8 @@ type BoardServiceTestSuite struct {
 	roleRepo      *testutil.MockRoleRepository
 	fieldRepo     *testutil.MockFieldRepository
 	commentRepo   *testutil.MockCommentRepository
+	linkRepo      *testutil.MockBoardLinkRepository
+	checklistRepo *testutil.MockChecklistRepository
 	userClient    *MockUserClient
 	userInfoCache *MockUserInfoCache
 	logger        *zap.Logger
@@ -154,6 +156,8 @@ func setupBoardServiceTest(t *testing.T) *BoardServiceTestSuite {
 		roleRepo:      new(testutil.MockRoleRepository),
 		fieldRepo:     new(testutil.MockFieldRepository),
 		commentRepo:   new(testutil.MockCommentRepository),
+		linkRepo:      new(testutil.MockBoardLinkRepository),
+		checklistRepo: new(testutil.MockChecklistRepository),
 		userClient:    new(MockUserClient),
 		userInfoCache: new(MockUserInfoCache),
 		logger:        zap.NewNop(),
@@ -166,12 +170,23 @@ func setupBoardServiceTest(t *testing.T) *BoardServiceTestSuite {
 		suite.roleRepo,
 		suite.fieldRepo,
 		suite.commentRepo,
+		nil, // activityRepo - recorded via UnitOfWork
+		suite.linkRepo,
+		suite.checklistRepo,
+		nil, // files - boards in these tests have no attachments
+		service.NewMentionService(new(testutil.MockMentionRepository), suite.boardRepo, suite.projectRepo, nil, suite.userClient, suite.logger),
+		service.NewNotificationService(new(testutil.MockNotificationRepository), suite.projectRepo, suite.userClient, suite.logger),
+		nil, // eventPublisher - realtime events not asserted
 		suite.userClient,
 		suite.userInfoCache,
 		suite.logger,
 		nil, // db - will be mocked when needed
 	)
 
+	// Link summaries and checklist progress are not asserted by these tests
+	suite.linkRepo.On("FindByBoards", mock.Anything).Return([]domain.BoardLink{}, nil).Maybe()
+	suite.checklistRepo.On("CountProgressByBoards", mock.Anything).Return(map[uuid.UUID]domain.ChecklistProgress{}, nil).Maybe()
+
 	return suite
 }
 
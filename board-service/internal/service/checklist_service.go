@@ -0,0 +1,310 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"board-service/internal/util"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type ChecklistService interface {
+	CreateItem(userID, boardID string, req *dto.CreateChecklistItemRequest) (*dto.ChecklistItemResponse, error)
+	GetChecklist(userID, boardID string) (*dto.ChecklistResponse, error)
+	UpdateItem(userID, itemID string, req *dto.UpdateChecklistItemRequest) (*dto.ChecklistItemResponse, error)
+	MoveItem(userID, itemID string, req *dto.MoveChecklistItemRequest) (*dto.ChecklistItemResponse, error)
+	DeleteItem(userID, itemID string) error
+}
+
+type checklistService struct {
+	repo        repository.ChecklistRepository
+	boardRepo   repository.BoardRepository
+	projectRepo repository.ProjectRepository
+	authorizer  auth.ProjectAuthorizer
+	logger      *zap.Logger
+}
+
+func NewChecklistService(
+	repo repository.ChecklistRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	logger *zap.Logger,
+) ChecklistService {
+	return &checklistService{
+		repo:        repo,
+		boardRepo:   boardRepo,
+		projectRepo: projectRepo,
+		authorizer:  auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		logger:      logger,
+	}
+}
+
+// ==================== Create Item ====================
+
+func (s *checklistService) CreateItem(userID, boardID string, req *dto.CreateChecklistItemRequest) (*dto.ChecklistItemResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.findBoardAsMember(userUUID, boardUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	assigneeUUID, err := s.validateAssignee(req.AssigneeID, board.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// New items are appended to the end of the checklist
+	lastPosition, err := s.repo.FindLastPosition(board.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 조회 실패", 500)
+	}
+
+	item, err := domain.NewChecklistItem(board, req.Title, assigneeUUID, util.GeneratePositionBetween(lastPosition, ""), userUUID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	if err := s.repo.Create(item); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 항목 생성 실패", 500)
+	}
+
+	return toChecklistItemResponse(item), nil
+}
+
+// ==================== Get Checklist ====================
+
+func (s *checklistService) GetChecklist(userID, boardID string) (*dto.ChecklistResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.findBoardAsMember(userUUID, boardUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.repo.FindByBoard(board.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 조회 실패", 500)
+	}
+
+	response := &dto.ChecklistResponse{
+		BoardID: board.ID.String(),
+		Items:   make([]dto.ChecklistItemResponse, 0, len(items)),
+	}
+	for i := range items {
+		response.Items = append(response.Items, *toChecklistItemResponse(&items[i]))
+		if items[i].IsDone {
+			response.Progress.Done++
+		}
+	}
+	response.Progress.Total = len(items)
+
+	return response, nil
+}
+
+// ==================== Update Item ====================
+
+func (s *checklistService) UpdateItem(userID, itemID string, req *dto.UpdateChecklistItemRequest) (*dto.ChecklistItemResponse, error) {
+	userUUID, item, err := s.findItemAsMember(userID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != nil {
+		if err := item.UpdateTitle(*req.Title); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+	if req.IsDone != nil {
+		item.SetDone(*req.IsDone, userUUID)
+	}
+	if req.AssigneeID != nil {
+		assigneeUUID, err := s.validateAssignee(req.AssigneeID, item.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		item.Assign(assigneeUUID)
+	}
+
+	if err := s.repo.Update(item); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 항목 수정 실패", 500)
+	}
+
+	return toChecklistItemResponse(item), nil
+}
+
+// ==================== Move Item ====================
+
+// MoveItem reorders an item using fractional indexing (only the moved row is updated)
+func (s *checklistService) MoveItem(userID, itemID string, req *dto.MoveChecklistItemRequest) (*dto.ChecklistItemResponse, error) {
+	_, item, err := s.findItemAsMember(userID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	var beforePos, afterPos string
+	if req.BeforePosition != nil {
+		beforePos = *req.BeforePosition
+	}
+	if req.AfterPosition != nil {
+		afterPos = *req.AfterPosition
+	}
+	if (beforePos != "" && !util.ValidatePosition(beforePos)) || (afterPos != "" && !util.ValidatePosition(afterPos)) {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "유효하지 않은 position입니다", 400)
+	}
+	if beforePos != "" && afterPos != "" && util.ComparePositions(beforePos, afterPos) >= 0 {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "beforePosition은 afterPosition보다 앞서야 합니다", 400)
+	}
+	if beforePos == "" && afterPos == "" {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "beforePosition 또는 afterPosition이 필요합니다", 400)
+	}
+
+	item.MoveTo(util.GeneratePositionBetween(beforePos, afterPos))
+
+	if err := s.repo.Update(item); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 항목 이동 실패", 500)
+	}
+
+	return toChecklistItemResponse(item), nil
+}
+
+// ==================== Delete Item ====================
+
+func (s *checklistService) DeleteItem(userID, itemID string) error {
+	_, item, err := s.findItemAsMember(userID, itemID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(item.ID); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 항목 삭제 실패", 500)
+	}
+	return nil
+}
+
+// ==================== Helpers ====================
+
+// findBoardAsMember loads the board and checks that the user is a member of its project
+func (s *checklistService) findBoardAsMember(userID, boardID uuid.UUID) (*domain.Board, error) {
+	board, err := s.boardRepo.FindByID(boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	if _, err := s.authorizer.RequireMember(userID, board.ProjectID); err != nil {
+		return nil, err
+	}
+
+	return board, nil
+}
+
+// findItemAsMember loads a checklist item and checks that the user is a member of its project
+// Items of a deleted board are not found, like the board itself.
+func (s *checklistService) findItemAsMember(userID, itemID string) (uuid.UUID, *domain.ChecklistItem, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	itemUUID, err := parser.ParseUUID(itemID, "체크리스트 항목")
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	item, err := s.repo.FindByID(itemUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "체크리스트 항목을 찾을 수 없습니다", 404)
+		}
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 항목 조회 실패", 500)
+	}
+	if _, err := s.findBoardAsMember(userUUID, item.BoardID); err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	return userUUID, item, nil
+}
+
+// validateAssignee parses an optional assignee and checks project membership
+// nil or an empty string means "no assignee"
+func (s *checklistService) validateAssignee(assigneeID *string, projectID uuid.UUID) (*uuid.UUID, error) {
+	assigneeUUID, err := parser.ParseOptionalUUID(assigneeID, "담당자")
+	if err != nil || assigneeUUID == nil {
+		return nil, err
+	}
+
+	if _, err := s.projectRepo.FindMemberByUserAndProject(*assigneeUUID, projectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "담당자가 프로젝트 멤버가 아닙니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "담당자 확인 실패", 500)
+	}
+	return assigneeUUID, nil
+}
+
+func toChecklistItemResponse(item *domain.ChecklistItem) *dto.ChecklistItemResponse {
+	var assigneeID *string
+	if item.AssigneeID != nil {
+		id := item.AssigneeID.String()
+		assigneeID = &id
+	}
+
+	return &dto.ChecklistItemResponse{
+		ItemID:      item.ID.String(),
+		BoardID:     item.BoardID.String(),
+		Title:       item.Title,
+		IsDone:      item.IsDone,
+		AssigneeID:  assigneeID,
+		Position:    item.Position,
+		CreatedBy:   item.CreatedBy.String(),
+		CompletedAt: item.CompletedAt,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+	}
+}
+
+// loadChecklistProgress batch fetches the checklist progress of the given boards, by board ID
+func loadChecklistProgress(repo repository.ChecklistRepository, logger *zap.Logger, boardIDs []uuid.UUID) map[uuid.UUID]domain.ChecklistProgress {
+	progress, err := repo.CountProgressByBoards(boardIDs)
+	if err != nil {
+		logger.Warn("Failed to fetch checklist progress", zap.Error(err))
+		return nil
+	}
+	return progress
+}
+
+// toChecklistProgress converts a progress summary for BoardResponse.Checklist
+// Boards without checklist items have no summary
+func toChecklistProgress(progress domain.ChecklistProgress) *dto.ChecklistProgress {
+	if progress.Total == 0 {
+		return nil
+	}
+	return &dto.ChecklistProgress{Done: progress.Done, Total: progress.Total}
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/testutil"
+	"board-service/internal/util"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type checklistTestSuite struct {
+	service   ChecklistService
+	repo      *testutil.MockChecklistRepository
+	boardRepo *testutil.MockBoardRepository
+	userID    uuid.UUID
+	board     *domain.Board
+}
+
+func setupChecklistTest(t *testing.T) *checklistTestSuite {
+	t.Helper()
+	suite := &checklistTestSuite{
+		repo:      new(testutil.MockChecklistRepository),
+		boardRepo: new(testutil.MockBoardRepository),
+		userID:    uuid.New(),
+	}
+	suite.board = &domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: uuid.New(),
+		Title:     "Board",
+		CreatedBy: suite.userID,
+	}
+	suite.boardRepo.On("FindByID", suite.board.ID).Return(suite.board, nil).Maybe()
+
+	projectRepo := new(testutil.MockProjectRepository)
+	member := testutil.NewTestProjectMember(suite.board.ProjectID, suite.userID, uuid.New())
+	member.Role = &domain.Role{Name: "MEMBER", Level: 10}
+	projectRepo.On("FindMemberByUserAndProject", suite.userID, suite.board.ProjectID).Return(member, nil).Maybe()
+
+	suite.service = NewChecklistService(suite.repo, suite.boardRepo, projectRepo, new(testutil.MockRoleRepository), zap.NewNop())
+	return suite
+}
+
+func (s *checklistTestSuite) newItem(position string, done bool) *domain.ChecklistItem {
+	item := &domain.ChecklistItem{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		BoardID:   s.board.ID,
+		ProjectID: s.board.ProjectID,
+		Title:     "Item",
+		IsDone:    done,
+		Position:  position,
+		CreatedBy: s.userID,
+	}
+	s.repo.On("FindByID", item.ID).Return(item, nil).Maybe()
+	return item
+}
+
+func TestChecklistService_CreateItem_AppendsAfterLastPosition(t *testing.T) {
+	suite := setupChecklistTest(t)
+
+	suite.repo.On("FindLastPosition", suite.board.ID).Return("n", nil)
+	suite.repo.On("Create", mock.AnythingOfType("*domain.ChecklistItem")).Return(nil)
+
+	response, err := suite.service.CreateItem(suite.userID.String(), suite.board.ID.String(), &dto.CreateChecklistItemRequest{Title: "Write tests"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Write tests", response.Title)
+	assert.False(t, response.IsDone)
+	assert.Equal(t, 1, util.ComparePositions(response.Position, "n"))
+	suite.repo.AssertExpectations(t)
+}
+
+func TestChecklistService_UpdateItem_TogglesDone(t *testing.T) {
+	suite := setupChecklistTest(t)
+	item := suite.newItem("n", false)
+	suite.repo.On("Update", item).Return(nil)
+
+	done := true
+	response, err := suite.service.UpdateItem(suite.userID.String(), item.ID.String(), &dto.UpdateChecklistItemRequest{IsDone: &done})
+
+	require.NoError(t, err)
+	assert.True(t, response.IsDone)
+	assert.NotNil(t, response.CompletedAt)
+	require.NotNil(t, item.CompletedBy)
+	assert.Equal(t, suite.userID, *item.CompletedBy)
+
+	// Reopening clears the completion
+	done = false
+	response, err = suite.service.UpdateItem(suite.userID.String(), item.ID.String(), &dto.UpdateChecklistItemRequest{IsDone: &done})
+
+	require.NoError(t, err)
+	assert.False(t, response.IsDone)
+	assert.Nil(t, response.CompletedAt)
+	assert.Nil(t, item.CompletedBy)
+}
+
+func TestChecklistService_ItemOfDeletedBoard(t *testing.T) {
+	suite := setupChecklistTest(t)
+	item := suite.newItem("n", false)
+	item.BoardID = uuid.New()
+	suite.boardRepo.On("FindByID", item.BoardID).Return(nil, gorm.ErrRecordNotFound)
+
+	done := true
+	_, err := suite.service.UpdateItem(suite.userID.String(), item.ID.String(), &dto.UpdateChecklistItemRequest{IsDone: &done})
+	assertStatus(t, err, 404)
+	suite.repo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestChecklistService_MoveItem_BetweenNeighbours(t *testing.T) {
+	suite := setupChecklistTest(t)
+	item := suite.newItem("z", false)
+	suite.repo.On("Update", item).Return(nil)
+
+	before, after := "a", "c"
+	response, err := suite.service.MoveItem(suite.userID.String(), item.ID.String(), &dto.MoveChecklistItemRequest{
+		BeforePosition: &before,
+		AfterPosition:  &after,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, util.ComparePositions(response.Position, before))
+	assert.Equal(t, -1, util.ComparePositions(response.Position, after))
+}
+
+func TestChecklistService_MoveItem_InvalidPositions(t *testing.T) {
+	suite := setupChecklistTest(t)
+	item := suite.newItem("n", false)
+
+	before, after := "c", "a"
+	cases := map[string]*dto.MoveChecklistItemRequest{
+		"no neighbours":  {},
+		"wrong order":    {BeforePosition: &before, AfterPosition: &after},
+		"same neighbour": {BeforePosition: &before, AfterPosition: &before},
+	}
+
+	for name, req := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := suite.service.MoveItem(suite.userID.String(), item.ID.String(), req)
+
+			require.Error(t, err)
+			assert.Equal(t, 400, err.(*apperrors.AppError).HTTPStatus)
+		})
+	}
+	suite.repo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestChecklistService_GetChecklist_Progress(t *testing.T) {
+	suite := setupChecklistTest(t)
+	suite.repo.On("FindByBoard", suite.board.ID).Return([]domain.ChecklistItem{
+		*suite.newItem("a", true),
+		*suite.newItem("b", false),
+		*suite.newItem("c", true),
+	}, nil)
+
+	response, err := suite.service.GetChecklist(suite.userID.String(), suite.board.ID.String())
+
+	require.NoError(t, err)
+	require.Len(t, response.Items, 3)
+	assert.Equal(t, dto.ChecklistProgress{Done: 2, Total: 3}, response.Progress)
+}
+
+func TestToChecklistProgress(t *testing.T) {
+	assert.Nil(t, toChecklistProgress(domain.ChecklistProgress{}))
+	assert.Equal(t, &dto.ChecklistProgress{Done: 0, Total: 2}, toChecklistProgress(domain.ChecklistProgress{Total: 2}))
+}
@@ -6,7 +6,9 @@ import (
 	"board-service/internal/client"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
+	"board-service/internal/realtime"
 	"board-service/internal/repository"
+	"board-service/internal/uow"
 	"context"
 	"errors"
 	"fmt"
@@ -19,7 +21,9 @@ import (
 // CommentService defines the interface for comment business logic.
 type CommentService interface {
 	CreateComment(ctx context.Context, req dto.CreateCommentRequest, userID uuid.UUID) (*dto.CommentResponse, error)
+	CreateReply(ctx context.Context, parentID uuid.UUID, req dto.CreateReplyRequest, userID uuid.UUID) (*dto.CommentResponse, error)
 	GetCommentsByBoardID(ctx context.Context, boardID uuid.UUID, userID uuid.UUID) ([]dto.CommentResponse, error)
+	GetReplies(ctx context.Context, commentID uuid.UUID, userID uuid.UUID) ([]dto.CommentResponse, error)
 	UpdateComment(ctx context.Context, commentID uuid.UUID, req dto.UpdateCommentRequest, userID uuid.UUID) (*dto.CommentResponse, error)
 	DeleteComment(ctx context.Context, commentID uuid.UUID, userID uuid.UUID) error
 }
@@ -30,18 +34,26 @@ type commentService struct {
 	projectRepo   repository.ProjectRepository
 	userClient    client.UserClient
 	userInfoCache cache.UserInfoCache
+	mentions      MentionService
+	notifications NotificationService
+	events        realtime.Publisher
+	uow           uow.UnitOfWork // Comment changes and their outbox events
 	logger        *zap.Logger
 	db            *gorm.DB
 }
 
 // NewCommentService creates a new instance of CommentService.
-func NewCommentService(cr repository.CommentRepository, kr repository.BoardRepository, pr repository.ProjectRepository, uc client.UserClient, uic cache.UserInfoCache, l *zap.Logger, db *gorm.DB) CommentService {
+func NewCommentService(cr repository.CommentRepository, kr repository.BoardRepository, pr repository.ProjectRepository, uc client.UserClient, uic cache.UserInfoCache, ms MentionService, ns NotificationService, ep realtime.Publisher, uw uow.UnitOfWork, l *zap.Logger, db *gorm.DB) CommentService {
 	return &commentService{
 		commentRepo:   cr,
 		boardRepo:     kr,
 		projectRepo:   pr,
 		userClient:    uc,
 		userInfoCache: uic,
+		mentions:      ms,
+		notifications: ns,
+		events:        ep,
+		uow:           uw,
 		logger:        l,
 		db:            db,
 	}
@@ -71,21 +83,58 @@ func (s *commentService) CreateComment(ctx context.Context, req dto.CreateCommen
 		Content:  req.Content,
 	}
 
-	if err := s.commentRepo.Create(comment); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to create comment", 500)
+	var parent *domain.Comment
+	if req.ParentID != nil {
+		parent, err = s.commentRepo.FindByID(*req.ParentID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, apperrors.New(apperrors.ErrCodeNotFound, fmt.Sprintf("parent comment with id %s not found", *req.ParentID), 404)
+			}
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to find parent comment", 500)
+		}
+
+		// Domain 메서드 사용: 같은 보드 여부와 depth 제한 검증
+		if err := comment.ReplyTo(parent); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
 	}
 
 	user := s.getSimpleUserWithCache(ctx, userID.String())
 
-	return &dto.CommentResponse{
-		ID:         comment.ID,
-		UserID:     comment.UserID,
-		UserName:   user.Name,
-		UserAvatar: user.AvatarURL,
-		Content:    comment.Content,
-		CreatedAt:  comment.CreatedAt,
-		UpdatedAt:  comment.UpdatedAt,
-	}, nil
+	var response *dto.CommentResponse
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Comment.Create(comment); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to create comment", 500)
+		}
+		response = toCommentResponse(comment, user)
+		return recordEvent(repos.Event, realtime.EventCommentCreated, board.ProjectID, board.ID, userID, response)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mentions := s.recordMentions(ctx, comment)
+	s.notifyComment(ctx, board, comment, parent, mentions)
+	publishEvent(s.events, s.logger, realtime.EventCommentCreated, board.ProjectID, board.ID, userID, response)
+
+	return response, nil
+}
+
+// CreateReply creates a reply to an existing comment.
+func (s *commentService) CreateReply(ctx context.Context, parentID uuid.UUID, req dto.CreateReplyRequest, userID uuid.UUID) (*dto.CommentResponse, error) {
+	parent, err := s.commentRepo.FindByID(parentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, fmt.Sprintf("comment with id %s not found", parentID), 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to find comment", 500)
+	}
+
+	return s.CreateComment(ctx, dto.CreateCommentRequest{
+		BoardID:  parent.BoardID,
+		ParentID: &parent.ID,
+		Content:  req.Content,
+	}, userID)
 }
 
 // GetCommentsByBoardID retrieves all comments for a given board.
@@ -119,24 +168,29 @@ func (s *commentService) GetCommentsByBoardID(ctx context.Context, boardID uuid.
 
 	userMap := s.getSimpleUsersBatch(ctx, userIDs)
 
-	responses := make([]dto.CommentResponse, len(comments))
-	for i, c := range comments {
-		user, ok := userMap[c.UserID.String()]
-		if !ok {
-			user = cache.SimpleUser{Name: "Unknown User", AvatarURL: ""}
-		}
-		responses[i] = dto.CommentResponse{
-			ID:         c.ID,
-			UserID:     c.UserID,
-			UserName:   user.Name,
-			UserAvatar: user.AvatarURL,
-			Content:    c.Content,
-			CreatedAt:  c.CreatedAt,
-			UpdatedAt:  c.UpdatedAt,
+	return buildCommentTree(comments, userMap), nil
+}
+
+// GetReplies retrieves the nested replies of a comment.
+func (s *commentService) GetReplies(ctx context.Context, commentID uuid.UUID, userID uuid.UUID) ([]dto.CommentResponse, error) {
+	parent, err := s.commentRepo.FindByID(commentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, fmt.Sprintf("comment with id %s not found", commentID), 404)
 		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to find comment", 500)
+	}
+
+	// Membership check is shared with the board listing
+	comments, err := s.GetCommentsByBoardID(ctx, parent.BoardID, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	return responses, nil
+	if node := findCommentInTree(comments, commentID); node != nil && node.Replies != nil {
+		return node.Replies, nil
+	}
+	return []dto.CommentResponse{}, nil
 }
 
 // UpdateComment updates an existing comment.
@@ -159,21 +213,29 @@ func (s *commentService) UpdateComment(ctx context.Context, commentID uuid.UUID,
 		return nil, apperrors.FromDomainError(err)
 	}
 
-	if err := s.commentRepo.Update(comment); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to update comment", 500)
+	board, err := s.findCommentBoard(comment)
+	if err != nil {
+		return nil, err
 	}
-
 	user := s.getSimpleUserWithCache(ctx, userID.String())
 
-	return &dto.CommentResponse{
-		ID:         comment.ID,
-		UserID:     comment.UserID,
-		UserName:   user.Name,
-		UserAvatar: user.AvatarURL,
-		Content:    comment.Content,
-		CreatedAt:  comment.CreatedAt,
-		UpdatedAt:  comment.UpdatedAt,
-	}, nil
+	var response *dto.CommentResponse
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Comment.Update(comment); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to update comment", 500)
+		}
+		response = toCommentResponse(comment, user)
+		return recordEvent(repos.Event, realtime.EventCommentUpdated, board.ProjectID, board.ID, userID, response)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Newly added mentions are recorded; existing ones are kept as they are
+	s.recordMentions(ctx, comment)
+	publishEvent(s.events, s.logger, realtime.EventCommentUpdated, board.ProjectID, board.ID, userID, response)
+
+	return response, nil
 }
 
 // DeleteComment deletes a comment.
@@ -192,7 +254,155 @@ func (s *commentService) DeleteComment(ctx context.Context, commentID uuid.UUID,
 		return apperrors.New(apperrors.ErrCodeForbidden, "user does not have permission to delete this comment", 403)
 	}
 
-	return s.commentRepo.Delete(comment.ID)
+	board, err := s.findCommentBoard(comment)
+	if err != nil {
+		return err
+	}
+
+	data := &dto.CommentResponse{ID: comment.ID, ParentID: comment.ParentID}
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Comment.Delete(comment.ID); err != nil {
+			return err
+		}
+		return recordEvent(repos.Event, realtime.EventCommentDeleted, board.ProjectID, board.ID, userID, data)
+	})
+	if err != nil {
+		return err
+	}
+
+	publishEvent(s.events, s.logger, realtime.EventCommentDeleted, board.ProjectID, board.ID, userID, data)
+	return nil
+}
+
+// recordMentions stores @mentions in the comment content and returns the new ones.
+func (s *commentService) recordMentions(ctx context.Context, comment *domain.Comment) []domain.Mention {
+	mentions, err := s.mentions.RecordMentions(ctx, comment.BoardID, domain.MentionSourceComment, comment.ID, comment.UserID, comment.Content)
+	if err != nil {
+		s.logger.Warn("Failed to record comment mentions",
+			zap.String("comment_id", comment.ID.String()),
+			zap.Error(err))
+	}
+	return mentions
+}
+
+// notifyComment notifies the board assignee, the board author and the parent comment author.
+// Users mentioned in the comment are skipped since they already got a mention notification.
+func (s *commentService) notifyComment(ctx context.Context, board *domain.Board, comment *domain.Comment, parent *domain.Comment, mentions []domain.Mention) {
+	skip := make(map[uuid.UUID]bool, len(mentions))
+	for _, m := range mentions {
+		skip[m.MentionedUserID] = true
+	}
+
+	recipients := []uuid.UUID{board.CreatedBy}
+	if board.AssigneeID != nil {
+		recipients = append(recipients, *board.AssigneeID)
+	}
+	if parent != nil {
+		recipients = append(recipients, parent.UserID)
+	}
+
+	notifications := make([]domain.Notification, 0, len(recipients))
+	for _, recipientID := range recipients {
+		if skip[recipientID] {
+			continue
+		}
+		skip[recipientID] = true
+
+		n := domain.NewNotification(recipientID, board.ProjectID, comment.UserID, domain.NotificationCommentCreated, board.Title)
+		n.BoardID = &board.ID
+		n.ResourceID = &comment.ID
+		n.SetDetail(comment.Content)
+		notifications = append(notifications, *n)
+	}
+
+	if err := s.notifications.Notify(ctx, notifications); err != nil {
+		s.logger.Warn("Failed to notify comment recipients",
+			zap.String("comment_id", comment.ID.String()),
+			zap.Error(err))
+	}
+}
+
+// findCommentBoard loads the comment's board, which resolves the project of the comment's events.
+func (s *commentService) findCommentBoard(comment *domain.Comment) (*domain.Board, error) {
+	board, err := s.boardRepo.FindByID(comment.BoardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, fmt.Sprintf("board with id %s not found", comment.BoardID), 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to find board", 500)
+	}
+	return board, nil
+}
+
+// toCommentResponse converts a single comment without its replies.
+func toCommentResponse(c *domain.Comment, user cache.SimpleUser) *dto.CommentResponse {
+	return &dto.CommentResponse{
+		ID:         c.ID,
+		UserID:     c.UserID,
+		UserName:   user.Name,
+		UserAvatar: user.AvatarURL,
+		Content:    c.Content,
+		ParentID:   c.ParentID,
+		Depth:      c.Depth,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
+
+// buildCommentTree nests replies under their parents, keeping creation order.
+// Comments whose parent is missing are returned at the top level.
+func buildCommentTree(comments []domain.Comment, userMap map[string]cache.SimpleUser) []dto.CommentResponse {
+	exists := make(map[uuid.UUID]bool, len(comments))
+	for _, c := range comments {
+		exists[c.ID] = true
+	}
+
+	children := make(map[uuid.UUID][]int)
+	roots := make([]int, 0)
+	for i, c := range comments {
+		if c.ParentID != nil && exists[*c.ParentID] {
+			children[*c.ParentID] = append(children[*c.ParentID], i)
+		} else {
+			roots = append(roots, i)
+		}
+	}
+
+	var build func(i int) dto.CommentResponse
+	build = func(i int) dto.CommentResponse {
+		c := comments[i]
+		user, ok := userMap[c.UserID.String()]
+		if !ok {
+			user = cache.SimpleUser{Name: "Unknown User", AvatarURL: ""}
+		}
+
+		response := *toCommentResponse(&c, user)
+		response.Replies = make([]dto.CommentResponse, 0, len(children[c.ID]))
+		for _, childIdx := range children[c.ID] {
+			reply := build(childIdx)
+			response.ReplyCount += 1 + reply.ReplyCount
+			response.Replies = append(response.Replies, reply)
+		}
+		return response
+	}
+
+	responses := make([]dto.CommentResponse, 0, len(roots))
+	for _, i := range roots {
+		responses = append(responses, build(i))
+	}
+	return responses
+}
+
+// findCommentInTree returns the node with the given ID from a nested comment list.
+func findCommentInTree(comments []dto.CommentResponse, id uuid.UUID) *dto.CommentResponse {
+	for i := range comments {
+		if comments[i].ID == id {
+			return &comments[i]
+		}
+		if found := findCommentInTree(comments[i].Replies, id); found != nil {
+			return found
+		}
+	}
+	return nil
 }
 
 // getSimpleUserWithCache retrieves simple user info with caching
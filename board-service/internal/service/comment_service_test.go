@@ -5,7 +5,9 @@ import (
 	"board-service/internal/client"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
+	"board-service/internal/realtime"
 	"board-service/internal/testutil"
+	"board-service/internal/uow"
 	"context"
 	"errors"
 	"testing"
@@ -20,12 +22,29 @@ import (
 
 // ==================== Test Suite Setup ====================
 
+// commentUnitOfWork runs the function against the mocked comment repository without a database
+type commentUnitOfWork struct {
+	comments *testutil.MockCommentRepository
+	events   *recordingEventRepository
+}
+
+func (u *commentUnitOfWork) Do(fn func(repos *uow.Repositories) error) error {
+	return fn(&uow.Repositories{Comment: u.comments, Event: u.events})
+}
+
+func (u *commentUnitOfWork) GetDB() *gorm.DB {
+	return nil
+}
+
 type CommentServiceTestSuite struct {
 	commentRepo   *testutil.MockCommentRepository
 	boardRepo     *testutil.MockBoardRepository
 	projectRepo   *testutil.MockProjectRepository
+	mentionRepo   *testutil.MockMentionRepository
+	notifyRepo    *testutil.MockNotificationRepository
 	userClient    *MockUserClient
 	userInfoCache *MockUserInfoCache
+	events        *recordingEventRepository
 	logger        *zap.Logger
 	service       CommentService
 }
@@ -34,16 +53,28 @@ func setupCommentServiceTest(t *testing.T) *CommentServiceTestSuite {
 	commentRepo := new(testutil.MockCommentRepository)
 	boardRepo := new(testutil.MockBoardRepository)
 	projectRepo := new(testutil.MockProjectRepository)
+	mentionRepo := new(testutil.MockMentionRepository)
+	notifyRepo := new(testutil.MockNotificationRepository)
 	userClient := new(MockUserClient)
 	userInfoCache := new(MockUserInfoCache)
+	events := &recordingEventRepository{}
 	logger := zap.NewNop()
 
+	// Notifications are a side effect of most comment operations; tests assert on them explicitly when needed
+	notifyRepo.On("FindPreferencesByUsers", mock.Anything, mock.Anything).Return([]domain.NotificationPreference{}, nil).Maybe()
+	notifyRepo.On("CreateBatch", mock.Anything).Return(nil).Maybe()
+	notificationService := NewNotificationService(notifyRepo, projectRepo, userClient, logger)
+
 	service := NewCommentService(
 		commentRepo,
 		boardRepo,
 		projectRepo,
 		userClient,
 		userInfoCache,
+		NewMentionService(mentionRepo, boardRepo, projectRepo, notificationService, userClient, logger),
+		notificationService,
+		nil, // realtime events not asserted
+		&commentUnitOfWork{comments: commentRepo, events: events},
 		logger,
 		nil, // db not used in unit tests
 	)
@@ -52,8 +83,11 @@ func setupCommentServiceTest(t *testing.T) *CommentServiceTestSuite {
 		commentRepo:   commentRepo,
 		boardRepo:     boardRepo,
 		projectRepo:   projectRepo,
+		mentionRepo:   mentionRepo,
+		notifyRepo:    notifyRepo,
 		userClient:    userClient,
 		userInfoCache: userInfoCache,
+		events:        events,
 		logger:        logger,
 		service:       service,
 	}
@@ -119,6 +153,66 @@ func TestCommentService_CreateComment_Success(t *testing.T) {
 	suite.commentRepo.AssertExpectations(t)
 }
 
+func TestCommentService_CreateComment_RecordsMentions(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+
+	// Given: Comment mentioning a nickname, a user ID, the author and a non-member
+	ctx := context.Background()
+	authorID := uuid.New()
+	aliceID := uuid.New()
+	carolID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+
+	req := dto.CreateCommentRequest{
+		BoardID: boardID,
+		Content: "@alice @" + carolID.String() + " please review, cc @bob @stranger",
+	}
+
+	board := &domain.Board{
+		BaseModel: domain.BaseModel{ID: boardID},
+		ProjectID: projectID,
+		Title:     "Test Board",
+	}
+	members := []domain.ProjectMember{
+		{UserID: authorID, ProjectID: projectID},
+		{UserID: aliceID, ProjectID: projectID},
+		{UserID: carolID, ProjectID: projectID},
+	}
+
+	suite.boardRepo.On("FindByID", boardID).Return(board, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", authorID, projectID).Return(&members[0], nil)
+	suite.projectRepo.On("FindMembersByProject", projectID).Return(members, nil)
+	suite.commentRepo.On("Create", mock.AnythingOfType("*domain.Comment")).Return(nil)
+	suite.userClient.On("GetUsersBatch", ctx, mock.Anything).Return([]client.UserInfo{
+		{UserID: authorID.String(), Name: "Bob"},
+		{UserID: aliceID.String(), Name: "Alice"},
+		{UserID: carolID.String(), Name: "Carol"},
+	}, nil)
+	suite.mentionRepo.On("FindUserIDsBySource", mock.AnythingOfType("uuid.UUID")).Return([]uuid.UUID{}, nil)
+	suite.mentionRepo.On("CreateBatch", mock.MatchedBy(func(mentions []domain.Mention) bool {
+		if len(mentions) != 2 {
+			return false
+		}
+		return mentions[0].MentionedUserID == carolID &&
+			mentions[1].MentionedUserID == aliceID &&
+			mentions[0].MentionedBy == authorID &&
+			mentions[0].SourceType == domain.MentionSourceComment &&
+			mentions[0].ProjectID == projectID
+	})).Return(nil)
+	suite.userInfoCache.On("GetSimpleUser", ctx, authorID.String()).Return(false, (*cache.SimpleUser)(nil), nil)
+	suite.userClient.On("GetSimpleUser", authorID.String()).Return(&client.SimpleUser{ID: authorID.String(), Name: "Bob"}, nil)
+	suite.userInfoCache.On("SetSimpleUser", ctx, mock.AnythingOfType("*cache.SimpleUser")).Return(nil)
+
+	// When: Create comment
+	result, err := suite.service.CreateComment(ctx, req, authorID)
+
+	// Then: Alice (by nickname) and Carol (by ID) are mentioned; the author and non-members are not
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	suite.mentionRepo.AssertExpectations(t)
+}
+
 func TestCommentService_CreateComment_BoardNotFound(t *testing.T) {
 	suite := setupCommentServiceTest(t)
 
@@ -215,6 +309,7 @@ func TestCommentService_CreateComment_RepositoryError(t *testing.T) {
 	suite.boardRepo.On("FindByID", boardID).Return(board, nil)
 	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(member, nil)
 	suite.commentRepo.On("Create", mock.AnythingOfType("*domain.Comment")).Return(errors.New("database error"))
+	suite.userInfoCache.On("GetSimpleUser", ctx, userID.String()).Return(true, &cache.SimpleUser{ID: userID.String()}, nil)
 
 	// When: Create comment
 	result, err := suite.service.CreateComment(ctx, req, userID)
@@ -439,6 +534,7 @@ func TestCommentService_UpdateComment_Success(t *testing.T) {
 	// Mock setup
 	suite.commentRepo.On("FindByID", commentID).Return(comment, nil)
 	suite.commentRepo.On("Update", mock.AnythingOfType("*domain.Comment")).Return(nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: uuid.New()}, nil)
 	suite.userInfoCache.On("GetSimpleUser", ctx, userID.String()).Return(true, simpleUser, nil)
 
 	// When: Update comment
@@ -577,12 +673,17 @@ func TestCommentService_DeleteComment_Success(t *testing.T) {
 	// Mock setup
 	suite.commentRepo.On("FindByID", commentID).Return(comment, nil)
 	suite.commentRepo.On("Delete", commentID).Return(nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: uuid.New()}, nil)
 
 	// When: Delete comment
 	err := suite.service.DeleteComment(ctx, commentID, userID)
 
-	// Then: Verify success
+	// Then: Verify success, with the event for webhooks in the outbox
 	assert.NoError(t, err)
+	if assert.Len(t, suite.events.events, 1) {
+		assert.Equal(t, string(realtime.EventCommentDeleted), suite.events.events[0].EventType)
+		assert.Equal(t, boardID, suite.events.events[0].AggregateID)
+	}
 
 	suite.commentRepo.AssertExpectations(t)
 }
@@ -639,3 +740,78 @@ func TestCommentService_DeleteComment_NotCommentAuthor(t *testing.T) {
 
 	suite.commentRepo.AssertExpectations(t)
 }
+
+// ==================== Threaded Replies Tests ====================
+
+func TestCommentService_CreateComment_ReplyDepthExceeded(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+
+	// Given: Parent comment already at max depth
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+	parentID := uuid.New()
+
+	board := &domain.Board{
+		BaseModel: domain.BaseModel{ID: boardID},
+		ProjectID: projectID,
+	}
+	member := &domain.ProjectMember{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		UserID:    userID,
+		ProjectID: projectID,
+	}
+	parent := &domain.Comment{
+		BaseModel: domain.BaseModel{ID: parentID},
+		BoardID:   boardID,
+		UserID:    uuid.New(),
+		Content:   "Deep reply",
+		Depth:     domain.MaxCommentDepth,
+	}
+
+	suite.boardRepo.On("FindByID", boardID).Return(board, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(member, nil)
+	suite.commentRepo.On("FindByID", parentID).Return(parent, nil)
+
+	// When: Reply to the parent
+	result, err := suite.service.CreateComment(ctx, dto.CreateCommentRequest{
+		BoardID:  boardID,
+		ParentID: &parentID,
+		Content:  "Too deep",
+	}, userID)
+
+	// Then: Rejected without creating the comment
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	suite.commentRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestBuildCommentTree_NestsRepliesWithCounts(t *testing.T) {
+	boardID := uuid.New()
+	userID := uuid.New()
+
+	root := domain.Comment{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: boardID, UserID: userID, Content: "root"}
+	reply := domain.Comment{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: boardID, UserID: userID, Content: "reply", ParentID: &root.ID, Depth: 1}
+	nested := domain.Comment{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: boardID, UserID: userID, Content: "nested", ParentID: &reply.ID, Depth: 2}
+	other := domain.Comment{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: boardID, UserID: userID, Content: "other root"}
+
+	userMap := map[string]cache.SimpleUser{userID.String(): {ID: userID.String(), Name: "Author"}}
+
+	result := buildCommentTree([]domain.Comment{root, reply, nested, other}, userMap)
+
+	assert.Len(t, result, 2)
+	assert.Equal(t, "root", result[0].Content)
+	assert.Equal(t, 2, result[0].ReplyCount)
+	assert.Len(t, result[0].Replies, 1)
+	assert.Equal(t, "reply", result[0].Replies[0].Content)
+	assert.Equal(t, 1, result[0].Replies[0].ReplyCount)
+	assert.Equal(t, "nested", result[0].Replies[0].Replies[0].Content)
+	assert.Equal(t, "Author", result[0].Replies[0].Replies[0].UserName)
+	assert.Equal(t, 0, result[1].ReplyCount)
+	assert.Empty(t, result[1].Replies)
+
+	found := findCommentInTree(result, reply.ID)
+	assert.NotNil(t, found)
+	assert.Equal(t, "nested", found.Replies[0].Content)
+}
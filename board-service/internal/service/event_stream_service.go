@@ -0,0 +1,136 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/realtime"
+	"board-service/internal/repository"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type EventStreamService interface {
+	// SubscribeProject opens a real-time event subscription for a project member
+	// The returned function must be called when the client disconnects
+	SubscribeProject(userID, projectID string) (<-chan *realtime.Event, func(), error)
+
+	// CheckSubscription re-checks that the subscriber is still a project member (403 once removed)
+	CheckSubscription(userID, projectID string) error
+}
+
+type eventStreamService struct {
+	projectRepo repository.ProjectRepository
+	subscriber  realtime.Subscriber
+	logger      *zap.Logger
+}
+
+func NewEventStreamService(
+	projectRepo repository.ProjectRepository,
+	subscriber realtime.Subscriber,
+	logger *zap.Logger,
+) EventStreamService {
+	return &eventStreamService{
+		projectRepo: projectRepo,
+		subscriber:  subscriber,
+		logger:      logger,
+	}
+}
+
+func (s *eventStreamService) SubscribeProject(userID, projectID string) (<-chan *realtime.Event, func(), error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Only project members can listen to project events
+	if err := s.requireMember(userUUID, projectUUID); err != nil {
+		return nil, nil, err
+	}
+
+	events, unsubscribe := s.subscriber.Subscribe(projectUUID.String())
+	return events, unsubscribe, nil
+}
+
+func (s *eventStreamService) CheckSubscription(userID, projectID string) error {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return err
+	}
+
+	return s.requireMember(userUUID, projectUUID)
+}
+
+func (s *eventStreamService) requireMember(userID, projectID uuid.UUID) error {
+	_, err := s.projectRepo.FindMemberByUserAndProject(userID, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
+		}
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
+	}
+	return nil
+}
+
+// publishEvent pushes a real-time event to the project's subscribers
+// Failures are logged only; the change itself has already been committed
+func publishEvent(
+	publisher realtime.Publisher,
+	logger *zap.Logger,
+	eventType realtime.EventType,
+	projectID, boardID, actorID uuid.UUID,
+	data interface{},
+) {
+	if publisher == nil {
+		return
+	}
+
+	event, err := realtime.NewEvent(eventType, projectID, boardID, actorID, data)
+	if err == nil {
+		err = publisher.Publish(context.Background(), event)
+	}
+	if err != nil {
+		logger.Warn("Failed to publish realtime event",
+			zap.String("event_type", string(eventType)),
+			zap.String("project_id", projectID.String()),
+			zap.Error(err))
+	}
+}
+
+// recordEvent appends a project event to the outbox in the caller's transaction
+// The outbox relay hands it to other services and creates the webhook deliveries after commit,
+// so unlike publishEvent the event is not lost if the process stops or Redis is down.
+func recordEvent(
+	events repository.DomainEventRepository,
+	eventType realtime.EventType,
+	projectID, boardID, actorID uuid.UUID,
+	data interface{},
+) error {
+	aggregateType, aggregateID := domain.AggregateProject, projectID
+	if boardID != uuid.Nil {
+		aggregateType, aggregateID = domain.AggregateBoard, boardID
+	}
+
+	event, err := domain.NewDomainEvent(aggregateType, aggregateID, projectID, actorID, string(eventType), data)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "도메인 이벤트 생성 실패", 500)
+	}
+	if err := events.Create(event); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "도메인 이벤트 저장 실패", 500)
+	}
+	return nil
+}
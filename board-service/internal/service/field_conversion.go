@@ -0,0 +1,608 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"board-service/internal/uow"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Field Type Conversion ====================
+// A field's type can change after creation when its values can be carried over. Values the new
+// type cannot hold (text that is not a number, the extra selections of a multi select, ...) are
+// removed. The values, the field, every affected board's custom_fields_cache and the saved views
+// using the field change in one transaction; the field's type-specific config starts over.
+
+// fieldConversions lists the types each field type can convert to
+var fieldConversions = map[domain.FieldType][]domain.FieldType{
+	domain.FieldTypeText:         {domain.FieldTypeSingleSelect, domain.FieldTypeNumber, domain.FieldTypeURL},
+	domain.FieldTypeNumber:       {domain.FieldTypeText},
+	domain.FieldTypeURL:          {domain.FieldTypeText},
+	domain.FieldTypeSingleSelect: {domain.FieldTypeMultiSelect, domain.FieldTypeText},
+	domain.FieldTypeMultiSelect:  {domain.FieldTypeSingleSelect},
+	domain.FieldTypeDate:         {domain.FieldTypeDateTime},
+	domain.FieldTypeDateTime:     {domain.FieldTypeDate},
+	domain.FieldTypeSingleUser:   {domain.FieldTypeMultiUser},
+	domain.FieldTypeMultiUser:    {domain.FieldTypeSingleUser},
+}
+
+// maxConversionOptions caps the options a text field converted to a select may create; the conversion
+// is rejected when the text values have more distinct labels than that
+const maxConversionOptions = 100
+
+// conversionOptionColors are the colors of options created from text values, in turn
+var conversionOptionColors = []string{"#6B7280", "#3B82F6", "#10B981", "#F59E0B", "#EF4444", "#8B5CF6", "#EC4899", "#14B8A6"}
+
+// fieldConversion is the outcome of converting a field's values, computed before anything is written
+type fieldConversion struct {
+	target     domain.FieldType
+	values     []domain.BoardFieldValue // Replace all of the field's values
+	newOptions []domain.FieldOption     // Created from distinct text values
+	boards     []uuid.UUID              // Boards that had values, in value order
+	total      int
+	lost       int
+
+	optionLabels map[string]string    // Labels of the field's options by ID, when converting from a select
+	optionIDs    map[string]uuid.UUID // IDs of the options text values convert to, by label
+	staleOptions []uuid.UUID          // Options of a select that becomes text; its values keep their labels
+	views        []viewConversion     // Saved views whose settings on the field change
+}
+
+// viewConversion is a saved view with its filters, sort keys and grouping converted for the new type
+type viewConversion struct {
+	view    domain.SavedView
+	summary dto.ConvertedView
+}
+
+// canConvertField reports whether a field of type from can convert to type to
+func canConvertField(from, to domain.FieldType) bool {
+	for _, target := range fieldConversions[from] {
+		if target == to {
+			return true
+		}
+	}
+	return false
+}
+
+// planFieldConversion converts the field's values (ordered by board, then display order) to the target type
+// options are the field's current options by ID: select values convert to their labels and the options
+// are removed, and text values convert to options left from an earlier conversion before new ones are created.
+func planFieldConversion(field *domain.ProjectField, target domain.FieldType, values []domain.BoardFieldValue, options map[uuid.UUID]domain.FieldOption) *fieldConversion {
+	plan := &fieldConversion{target: target, total: len(values), optionIDs: make(map[string]uuid.UUID)}
+	if isSelectField(field.FieldType) && !isSelectField(target) {
+		plan.optionLabels = make(map[string]string, len(options))
+		for id, option := range options {
+			plan.optionLabels[id.String()] = option.Label
+			plan.staleOptions = append(plan.staleOptions, id)
+		}
+		sort.Slice(plan.staleOptions, func(i, j int) bool { return plan.staleOptions[i].String() < plan.staleOptions[j].String() })
+	}
+	if !isSelectField(field.FieldType) && isSelectField(target) {
+		for id, option := range options {
+			plan.optionIDs[option.Label] = id
+		}
+	}
+	perBoard := make(map[uuid.UUID]int) // Converted values of each board so far
+	seenBoards := make(map[uuid.UUID]bool)
+
+	for _, value := range values {
+		if !seenBoards[value.BoardID] {
+			seenBoards[value.BoardID] = true
+			plan.boards = append(plan.boards, value.BoardID)
+		}
+
+		converted := domain.BoardFieldValue{BoardID: value.BoardID, FieldID: field.ID, DisplayOrder: perBoard[value.BoardID]}
+		ok := false
+		switch {
+		case target == domain.FieldTypeSingleSelect && field.FieldType == domain.FieldTypeText:
+			if label := strings.TrimSpace(textOf(value)); label != "" && utf8.RuneCountInString(label) <= 255 {
+				id, exists := plan.optionIDs[label]
+				if !exists {
+					id = uuid.New()
+					plan.optionIDs[label] = id
+					plan.newOptions = append(plan.newOptions, domain.FieldOption{
+						BaseModel:    domain.BaseModel{ID: id},
+						FieldID:      field.ID,
+						Label:        label,
+						Color:        conversionOptionColors[len(plan.newOptions)%len(conversionOptionColors)],
+						DisplayOrder: len(options) + len(plan.newOptions),
+					})
+				}
+				converted.ValueOptionID, ok = &id, true
+			}
+		case target == domain.FieldTypeNumber:
+			if number, err := strconv.ParseFloat(strings.TrimSpace(textOf(value)), 64); err == nil {
+				converted.ValueNumber, ok = &number, true
+			}
+		case target == domain.FieldTypeURL:
+			if text := strings.TrimSpace(textOf(value)); text != "" {
+				if _, err := url.ParseRequestURI(text); err == nil {
+					converted.ValueText, ok = &text, true
+				}
+			}
+		case target == domain.FieldTypeText:
+			var text string
+			switch {
+			case value.ValueNumber != nil:
+				text, ok = strconv.FormatFloat(*value.ValueNumber, 'f', -1, 64), true
+			case value.ValueOptionID != nil:
+				option, exists := options[*value.ValueOptionID]
+				text, ok = option.Label, exists
+			case value.ValueText != nil:
+				text, ok = *value.ValueText, true
+			}
+			converted.ValueText = &text
+		case target == domain.FieldTypeDate || target == domain.FieldTypeDateTime:
+			if value.ValueDate != nil {
+				date := value.ValueDate.UTC()
+				if target == domain.FieldTypeDate {
+					date = date.Truncate(24 * time.Hour)
+				}
+				converted.ValueDate, ok = &date, true
+			}
+		default:
+			// Between single and multi selects or users the value itself is kept
+			converted.ValueOptionID, converted.ValueUserID = value.ValueOptionID, value.ValueUserID
+			ok = converted.ValueOptionID != nil || converted.ValueUserID != nil
+		}
+
+		// A single value type keeps each board's first value only
+		if !ok || (converted.DisplayOrder > 0 && !isMultiValueField(target)) {
+			plan.lost++
+			continue
+		}
+		perBoard[value.BoardID]++
+		plan.values = append(plan.values, converted)
+	}
+	return plan
+}
+
+// isSelectField reports whether values of the field type are options
+func isSelectField(fieldType domain.FieldType) bool {
+	return fieldType == domain.FieldTypeSingleSelect || fieldType == domain.FieldTypeMultiSelect
+}
+
+// textOf returns the text of a text or URL value
+func textOf(value domain.BoardFieldValue) string {
+	if value.ValueText == nil {
+		return ""
+	}
+	return *value.ValueText
+}
+
+// preview summarizes the conversion of the field
+func (c *fieldConversion) preview(field *domain.ProjectField) dto.FieldConversionPreview {
+	preview := dto.FieldConversionPreview{
+		FieldID:         field.ID.String(),
+		FromType:        string(field.FieldType),
+		ToType:          string(c.target),
+		TotalValues:     c.total,
+		ConvertedValues: c.total - c.lost,
+		LostValues:      c.lost,
+		AffectedBoards:  len(c.boards),
+		NewOptions:      make([]string, 0, len(c.newOptions)),
+		NewOptionCount:  len(c.newOptions),
+		MaxNewOptions:   maxConversionOptions,
+	}
+	for _, option := range c.newOptions {
+		if len(preview.NewOptions) == maxConversionOptions {
+			break
+		}
+		preview.NewOptions = append(preview.NewOptions, option.Label)
+	}
+	preview.AffectedViews = make([]dto.ConvertedView, 0, len(c.views))
+	for _, view := range c.views {
+		preview.AffectedViews = append(preview.AffectedViews, view.summary)
+	}
+	return preview
+}
+
+// ==================== Saved Views ====================
+
+// convertViews converts the filters, sort keys and grouping of the views that use the field
+// Filter values are converted like the field's values (option IDs to labels, labels to the new options, ...);
+// conditions, sort keys and groupings the new type cannot express are removed. Views with filters that
+// cannot be parsed keep them. Only views that change are kept.
+func (c *fieldConversion) convertViews(field *domain.ProjectField, views []domain.SavedView) {
+	kind, _ := filterKindOf(c.target)
+	target := filterTarget{key: field.ID.String(), kind: kind, field: field.ID.String()}
+	converted := *field
+	converted.FieldType = c.target
+	byID := fieldsByID([]domain.ProjectField{converted})
+
+	for _, view := range views {
+		summary := dto.ConvertedView{ViewID: view.ID.String(), Name: view.Name}
+
+		if filters, rewritten, removed, err := c.convertViewFilters(view.Filters, field.ID, target); err == nil && rewritten+removed > 0 {
+			view.Filters = filters
+			summary.RewrittenFilters, summary.RemovedFilters = rewritten, removed
+		}
+
+		if keys, err := view.SortKeys(); err == nil {
+			kept := make([]domain.SortKey, 0, len(keys))
+			for _, key := range keys {
+				if isFieldKey(key.Field, field.ID) {
+					if _, err := resolveSortTarget(key.Field, byID, true); err != nil {
+						summary.RemovedSorts++
+						continue
+					}
+				}
+				kept = append(kept, key)
+			}
+			if summary.RemovedSorts > 0 {
+				if err := view.SetSortKeys(kept); err != nil {
+					continue
+				}
+			}
+		}
+
+		// Swimlanes only exist within groups
+		if grouping := view.Grouping(); grouping != nil && isFieldKey(grouping.Key, field.ID) && !groupsByKind(grouping, kind) {
+			summary.RemovedGrouping = true
+			view.SetGrouping(nil)
+			view.SetSwimlanes(nil)
+		} else if swimlanes := view.Swimlanes(); swimlanes != nil && isFieldKey(swimlanes.Key, field.ID) && !groupsByKind(swimlanes, kind) {
+			summary.RemovedGrouping = true
+			view.SetSwimlanes(nil)
+		}
+
+		if summary.RewrittenFilters+summary.RemovedFilters+summary.RemovedSorts > 0 || summary.RemovedGrouping {
+			c.views = append(c.views, viewConversion{view: view, summary: summary})
+		}
+	}
+}
+
+// convertViewFilters converts the conditions on the field in a view's filter JSON, flat or grouped
+// Returns the new filters and how many conditions were rewritten and removed.
+func (c *fieldConversion) convertViewFilters(filters string, fieldID uuid.UUID, target filterTarget) (string, int, int, error) {
+	if filters == "" {
+		return filters, 0, 0, nil
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(filters), &decoded); err != nil {
+		return filters, 0, 0, err
+	}
+	root, err := parseFilterTree(decoded)
+	if err != nil {
+		return filters, 0, 0, err
+	}
+
+	root, rewritten, removed := c.convertFilterNode(root, fieldID, target)
+	if rewritten+removed == 0 {
+		return filters, 0, 0, nil
+	}
+
+	// Legacy flat filters stay flat
+	var encoded interface{} = root
+	if _, ok := decoded["op"]; !ok {
+		flat := make(domain.ViewFilters)
+		if root != nil {
+			for _, condition := range root.Conditions {
+				flat[condition.Field] = domain.FilterCondition{Operator: condition.Operator, Value: condition.Value}
+			}
+		}
+		encoded = flat
+	} else if root == nil {
+		encoded = map[string]interface{}{}
+	}
+	raw, err := json.Marshal(encoded)
+	if err != nil {
+		return filters, 0, 0, err
+	}
+	return string(raw), rewritten, removed, nil
+}
+
+// convertFilterNode converts the conditions on the field; groups left empty are removed (nil if nothing is left)
+func (c *fieldConversion) convertFilterNode(node *domain.FilterNode, fieldID uuid.UUID, target filterTarget) (*domain.FilterNode, int, int) {
+	if node == nil {
+		return nil, 0, 0
+	}
+	if !node.IsGroup() {
+		if !isFieldKey(node.Field, fieldID) {
+			return node, 0, 0
+		}
+		value, ok := c.convertFilterValue(node.Value)
+		if ok {
+			_, err := buildFilterClause(target, domain.FilterCondition{Operator: node.Operator, Value: value})
+			ok = err == nil
+		}
+		if !ok {
+			return nil, 0, 1
+		}
+		if reflect.DeepEqual(value, node.Value) {
+			return node, 0, 0
+		}
+		rewritten := *node
+		rewritten.Value = value
+		return &rewritten, 1, 0
+	}
+
+	var rewritten, removed int
+	kept := make([]domain.FilterNode, 0, len(node.Conditions))
+	for i := range node.Conditions {
+		child, childRewritten, childRemoved := c.convertFilterNode(&node.Conditions[i], fieldID, target)
+		rewritten, removed = rewritten+childRewritten, removed+childRemoved
+		if child != nil {
+			kept = append(kept, *child)
+		}
+	}
+	if rewritten+removed == 0 {
+		return node, 0, 0
+	}
+	if len(kept) == 0 {
+		return nil, rewritten, removed
+	}
+	converted := *node
+	converted.Conditions = kept
+	return &converted, rewritten, removed
+}
+
+// convertFilterValue converts a filter value (a value, a list or a range) to the new type
+// List items that do not convert are left out; false when nothing is left of the value.
+func (c *fieldConversion) convertFilterValue(value interface{}) (interface{}, bool) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return c.convertFilterScalar(value)
+	}
+	converted := make([]interface{}, 0, len(list))
+	for _, item := range list {
+		if item, ok := c.convertFilterScalar(item); ok {
+			converted = append(converted, item)
+		}
+	}
+	return converted, len(converted) > 0
+}
+
+func (c *fieldConversion) convertFilterScalar(value interface{}) (interface{}, bool) {
+	switch c.target {
+	case domain.FieldTypeText:
+		switch v := value.(type) {
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), true
+		case string:
+			if label, ok := c.optionLabels[v]; ok {
+				return label, true
+			}
+		}
+	case domain.FieldTypeNumber:
+		if text, ok := value.(string); ok {
+			number, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+			return number, err == nil
+		}
+	case domain.FieldTypeSingleSelect:
+		if text, ok := value.(string); ok && len(c.optionIDs) > 0 {
+			id, ok := c.optionIDs[strings.TrimSpace(text)]
+			return id.String(), ok
+		}
+	}
+	return value, true
+}
+
+// groupsByKind reports whether a grouping can still group by a field of the kind
+func groupsByKind(grouping *domain.ViewGrouping, kind filterKind) bool {
+	return grouping.Bucket == "" || kind == filterKindTime
+}
+
+// isFieldKey reports whether a filter, sort or group key is the field's ID
+func isFieldKey(key string, fieldID uuid.UUID) bool {
+	id, err := uuid.Parse(key)
+	return err == nil && id == fieldID
+}
+
+// PreviewFieldConversion reports how many of the field's values convert to the requested type and how many are lost
+func (s *fieldService) PreviewFieldConversion(userID, fieldID string, req *dto.ConvertFieldTypeRequest) (*dto.FieldConversionPreview, error) {
+	field, target, err := s.checkConversion(userID, fieldID, req)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := planConversion(s.repo, field, target)
+	if err != nil {
+		return nil, err
+	}
+	preview := plan.preview(field)
+	return &preview, nil
+}
+
+// ConvertFieldType changes the field's type, migrating its values and rebuilding the affected boards' caches
+// The field row stays locked while the values read in the transaction are converted, so concurrent
+// conversions of the field run one after the other and each converts the values the other left.
+// Value writes share-lock the row (lockFieldType): they finish before the conversion reads the values,
+// or fail with 409 once it has changed the type.
+func (s *fieldService) ConvertFieldType(userID, fieldID string, req *dto.ConvertFieldTypeRequest) (*dto.FieldConversionResponse, error) {
+	field, target, err := s.checkConversion(userID, fieldID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan *fieldConversion
+	var preview dto.FieldConversionPreview
+	fromType := field.FieldType
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		locked, err := repos.Field.FindFieldByIDForUpdate(field.ID)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+		}
+		if locked.FieldType != fromType {
+			return fieldTypeChangedError()
+		}
+		field = locked
+
+		plan, err = planConversion(repos.Field, field, target)
+		if err != nil {
+			return err
+		}
+		preview = plan.preview(field)
+		if len(plan.newOptions) > maxConversionOptions {
+			return apperrors.New(apperrors.ErrCodeBadRequest,
+				fmt.Sprintf("변환하면 옵션이 %d개 생성됩니다. 서로 다른 값은 최대 %d개까지 옵션으로 변환할 수 있습니다", len(plan.newOptions), maxConversionOptions), 400)
+		}
+
+		field.FieldType = plan.target
+		field.Config = "{}"
+		if err := repos.Field.DeleteFieldValuesByField(field.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 필드 값 삭제 실패", 500)
+		}
+		for _, optionID := range plan.staleOptions {
+			if err := repos.Field.DeleteOption(optionID); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 삭제 실패", 500)
+			}
+		}
+		for i := range plan.newOptions {
+			if err := repos.Field.CreateOption(&plan.newOptions[i]); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 생성 실패", 500)
+			}
+		}
+		if len(plan.values) > 0 {
+			if err := repos.Field.BatchSetFieldValues(plan.values); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 변환 실패", 500)
+			}
+		}
+		if err := repos.Field.UpdateField(field); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 수정 실패", 500)
+		}
+		for i := range plan.views {
+			if err := repos.Field.UpdateView(&plan.views[i].view); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "뷰 설정 변환 실패", 500)
+			}
+		}
+		return rebuildFieldsCaches(repos, plan.boards)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache
+	ctx := context.Background()
+	if err := s.cache.InvalidateProjectFields(ctx, field.ProjectID.String()); err != nil {
+		s.logger.Warn("Failed to invalidate project fields cache", zap.Error(err))
+	}
+	if err := s.cache.InvalidateFieldOptions(ctx, field.ID.String()); err != nil {
+		s.logger.Warn("Failed to invalidate field options cache", zap.Error(err))
+	}
+	for _, boardID := range plan.boards {
+		if err := s.cache.InvalidateBoardFieldValues(ctx, boardID.String()); err != nil {
+			s.logger.Warn("Failed to invalidate board field values cache", zap.Error(err))
+		}
+	}
+	for _, view := range plan.views {
+		if err := s.cache.InvalidateViewResults(ctx, view.summary.ViewID); err != nil {
+			s.logger.Warn("Failed to invalidate view results cache", zap.Error(err))
+		}
+	}
+	if len(plan.views) > 0 {
+		s.logger.Info("Converted saved views with field type",
+			zap.String("field_id", field.ID.String()),
+			zap.String("from", string(fromType)),
+			zap.String("to", string(plan.target)),
+			zap.Int("views", len(plan.views)))
+	}
+
+	return &dto.FieldConversionResponse{Field: s.buildFieldResponse(field), Conversion: preview}, nil
+}
+
+// checkConversion checks the conversion request (ADMIN+) and returns the field and the target type
+func (s *fieldService) checkConversion(userID, fieldID string, req *dto.ConvertFieldTypeRequest) (*domain.ProjectField, domain.FieldType, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	fieldUUID, err := uuid.Parse(fieldID)
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 필드 ID", 400)
+	}
+
+	// Fetch field
+	field, err := s.repo.FindFieldByID(fieldUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, "", apperrors.New(apperrors.ErrCodeNotFound, "필드를 찾을 수 없습니다", 404)
+		}
+		return nil, "", apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+
+	// Check permissions
+	member, err := s.projectRepo.FindMemberByUserAndProject(userUUID, field.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, "", apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
+		}
+		return nil, "", apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
+	}
+
+	if member.Role == nil || member.Role.Level < 50 {
+		return nil, "", apperrors.New(apperrors.ErrCodeForbidden, "필드 타입 변경 권한이 없습니다 (ADMIN 이상)", 403)
+	}
+
+	// System default fields keep their type
+	if field.IsSystemDefault {
+		return nil, "", apperrors.New(apperrors.ErrCodeBadRequest, "시스템 기본 필드의 타입은 변경할 수 없습니다", 400)
+	}
+	target := domain.FieldType(req.FieldType)
+	if !canConvertField(field.FieldType, target) {
+		return nil, "", apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("'%s'에서 '%s'(으)로 변환할 수 없습니다", field.FieldType, target), 400)
+	}
+	return field, target, nil
+}
+
+// planConversion converts the field's values, options and saved views read through repo without writing them
+func planConversion(repo repository.FieldRepository, field *domain.ProjectField, target domain.FieldType) (*fieldConversion, error) {
+	values, err := repo.FindFieldValuesByField(field.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 조회 실패", 500)
+	}
+	fieldOptions, err := repo.FindOptionsByField(field.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 조회 실패", 500)
+	}
+	options := make(map[uuid.UUID]domain.FieldOption, len(fieldOptions))
+	for _, option := range fieldOptions {
+		options[option.ID] = option
+	}
+
+	plan := planFieldConversion(field, target, values, options)
+
+	views, err := repo.FindViewsByProject(field.ProjectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "뷰 조회 실패", 500)
+	}
+	plan.convertViews(field, views)
+	return plan, nil
+}
+
+// rebuildFieldsCaches rewrites the custom_fields_cache of the boards from their current values
+func rebuildFieldsCaches(repos *uow.Repositories, boardIDs []uuid.UUID) error {
+	if len(boardIDs) == 0 {
+		return nil
+	}
+	values, err := repos.Field.FindFieldValuesByBoards(boardIDs)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 조회 실패", 500)
+	}
+	for _, boardID := range boardIDs {
+		cacheJSON, err := json.Marshal(buildFieldsCache(values[boardID]))
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 캐시 생성 실패", 500)
+		}
+		if err := repos.Board.UpdateFieldsCache(boardID, string(cacheJSON)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 캐시 갱신 실패", 500)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/testutil"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPlanFieldConversion_TextToSingleSelect(t *testing.T) {
+	field := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: domain.FieldTypeText}
+	boardA, boardB, boardC := uuid.New(), uuid.New(), uuid.New()
+	text := func(board uuid.UUID, s string) domain.BoardFieldValue {
+		return domain.BoardFieldValue{BoardID: board, FieldID: field.ID, ValueText: &s}
+	}
+
+	// One option per distinct trimmed value; empty text is lost
+	plan := planFieldConversion(field, domain.FieldTypeSingleSelect, []domain.BoardFieldValue{
+		text(boardA, "Backend"), text(boardB, " Backend "), text(boardC, "  "),
+	}, nil)
+	require.Len(t, plan.newOptions, 1)
+	assert.Equal(t, "Backend", plan.newOptions[0].Label)
+	require.Len(t, plan.values, 2)
+	assert.Equal(t, plan.newOptions[0].ID, *plan.values[0].ValueOptionID)
+	assert.Equal(t, plan.newOptions[0].ID, *plan.values[1].ValueOptionID)
+
+	preview := plan.preview(field)
+	assert.Equal(t, dto.FieldConversionPreview{
+		FieldID: field.ID.String(), FromType: "text", ToType: "single_select",
+		TotalValues: 3, ConvertedValues: 2, LostValues: 1, AffectedBoards: 3, NewOptions: []string{"Backend"},
+		NewOptionCount: 1, MaxNewOptions: maxConversionOptions, AffectedViews: []dto.ConvertedView{},
+	}, preview)
+}
+
+func TestPlanFieldConversion_Options(t *testing.T) {
+	board := uuid.New()
+	field := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: domain.FieldTypeSingleSelect}
+	optionA := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldID: field.ID, Label: "A"}
+	optionB := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldID: field.ID, Label: "B"}
+	options := map[uuid.UUID]domain.FieldOption{optionA.ID: optionA, optionB.ID: optionB}
+
+	// A select that becomes text loses its options, used or not
+	plan := planFieldConversion(field, domain.FieldTypeText, []domain.BoardFieldValue{{BoardID: board, ValueOptionID: &optionA.ID}}, options)
+	assert.ElementsMatch(t, []uuid.UUID{optionA.ID, optionB.ID}, plan.staleOptions)
+
+	// Selects keep their options
+	plan = planFieldConversion(field, domain.FieldTypeMultiSelect, nil, options)
+	assert.Empty(t, plan.staleOptions)
+
+	// Text values reuse options left with the same label instead of creating duplicates
+	field.FieldType = domain.FieldTypeText
+	a, c := "A", "C"
+	plan = planFieldConversion(field, domain.FieldTypeSingleSelect, []domain.BoardFieldValue{
+		{BoardID: board, ValueText: &a}, {BoardID: uuid.New(), ValueText: &c},
+	}, options)
+	require.Len(t, plan.values, 2)
+	assert.Equal(t, optionA.ID, *plan.values[0].ValueOptionID)
+	require.Len(t, plan.newOptions, 1)
+	assert.Equal(t, "C", plan.newOptions[0].Label)
+	assert.Equal(t, 2, plan.newOptions[0].DisplayOrder)
+}
+
+func TestPlanFieldConversion(t *testing.T) {
+	board := uuid.New()
+	optionA := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, Label: "A"}
+	optionB := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, Label: "B"}
+	options := map[uuid.UUID]domain.FieldOption{optionA.ID: optionA, optionB.ID: optionB}
+	number, text, url := 3.5, "12", "not a url"
+	date := time.Date(2026, 10, 17, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		from, to  domain.FieldType
+		values    []domain.BoardFieldValue
+		converted int
+		check     func(t *testing.T, values []domain.BoardFieldValue)
+	}{
+		{"number to text", domain.FieldTypeNumber, domain.FieldTypeText, []domain.BoardFieldValue{{BoardID: board, ValueNumber: &number}}, 1,
+			func(t *testing.T, values []domain.BoardFieldValue) { assert.Equal(t, "3.5", *values[0].ValueText) }},
+		{"text to number", domain.FieldTypeText, domain.FieldTypeNumber, []domain.BoardFieldValue{{BoardID: board, ValueText: &text}}, 1,
+			func(t *testing.T, values []domain.BoardFieldValue) { assert.Equal(t, 12.0, *values[0].ValueNumber) }},
+		{"text to url loses invalid urls", domain.FieldTypeText, domain.FieldTypeURL, []domain.BoardFieldValue{{BoardID: board, ValueText: &url}}, 0, nil},
+		{"single to multi select", domain.FieldTypeSingleSelect, domain.FieldTypeMultiSelect, []domain.BoardFieldValue{{BoardID: board, ValueOptionID: &optionA.ID}}, 1,
+			func(t *testing.T, values []domain.BoardFieldValue) {
+				assert.Equal(t, optionA.ID, *values[0].ValueOptionID)
+			}},
+		{"multi to single select keeps the first", domain.FieldTypeMultiSelect, domain.FieldTypeSingleSelect, []domain.BoardFieldValue{
+			{BoardID: board, ValueOptionID: &optionA.ID}, {BoardID: board, ValueOptionID: &optionB.ID, DisplayOrder: 1},
+		}, 1, func(t *testing.T, values []domain.BoardFieldValue) {
+			assert.Equal(t, optionA.ID, *values[0].ValueOptionID)
+		}},
+		{"single select to text", domain.FieldTypeSingleSelect, domain.FieldTypeText, []domain.BoardFieldValue{{BoardID: board, ValueOptionID: &optionB.ID}}, 1,
+			func(t *testing.T, values []domain.BoardFieldValue) { assert.Equal(t, "B", *values[0].ValueText) }},
+		{"datetime to date", domain.FieldTypeDateTime, domain.FieldTypeDate, []domain.BoardFieldValue{{BoardID: board, ValueDate: &date}}, 1,
+			func(t *testing.T, values []domain.BoardFieldValue) {
+				assert.Equal(t, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), *values[0].ValueDate)
+			}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.True(t, canConvertField(tt.from, tt.to))
+			field := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: tt.from}
+			plan := planFieldConversion(field, tt.to, tt.values, options)
+			require.Len(t, plan.values, tt.converted)
+			assert.Equal(t, len(tt.values)-tt.converted, plan.lost)
+			for _, value := range plan.values {
+				assert.Equal(t, field.ID, value.FieldID)
+			}
+			if tt.check != nil {
+				tt.check(t, plan.values)
+			}
+		})
+	}
+
+	assert.False(t, canConvertField(domain.FieldTypeCheckbox, domain.FieldTypeText))
+	assert.False(t, canConvertField(domain.FieldTypeDate, domain.FieldTypeNumber))
+}
+
+func TestFieldService_PreviewFieldConversion(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+	projectRepo := new(testutil.MockProjectRepository)
+	s := &fieldService{repo: fieldRepo, projectRepo: projectRepo, logger: zap.NewNop()}
+
+	adminID, memberID, projectID := uuid.New(), uuid.New(), uuid.New()
+	field := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, FieldType: domain.FieldTypeNumber}
+	stage := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, FieldType: domain.FieldTypeSingleSelect, IsSystemDefault: true}
+	number := 7.0
+
+	fieldRepo.On("FindFieldByID", field.ID).Return(field, nil)
+	fieldRepo.On("FindFieldByID", stage.ID).Return(stage, nil)
+	fieldRepo.On("FindFieldValuesByField", field.ID).Return([]domain.BoardFieldValue{{BoardID: uuid.New(), FieldID: field.ID, ValueNumber: &number}}, nil)
+	fieldRepo.On("FindOptionsByField", field.ID).Return([]domain.FieldOption{}, nil)
+	fieldRepo.On("FindViewsByProject", projectID).Return([]domain.SavedView{}, nil)
+	projectRepo.On("FindMemberByUserAndProject", adminID, projectID).Return(&domain.ProjectMember{Role: &domain.Role{Name: "ADMIN", Level: 50}}, nil)
+	projectRepo.On("FindMemberByUserAndProject", memberID, projectID).Return(&domain.ProjectMember{Role: &domain.Role{Name: "MEMBER", Level: 10}}, nil)
+
+	preview, err := s.PreviewFieldConversion(adminID.String(), field.ID.String(), &dto.ConvertFieldTypeRequest{FieldType: "text"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, preview.ConvertedValues)
+	assert.Equal(t, 1, preview.AffectedBoards)
+
+	// ADMIN+ only; unsupported conversions and system default fields are rejected
+	_, err = s.PreviewFieldConversion(memberID.String(), field.ID.String(), &dto.ConvertFieldTypeRequest{FieldType: "text"})
+	assertStatus(t, err, 403)
+	_, err = s.PreviewFieldConversion(adminID.String(), field.ID.String(), &dto.ConvertFieldTypeRequest{FieldType: "checkbox"})
+	assertStatus(t, err, 400)
+	_, err = s.PreviewFieldConversion(adminID.String(), stage.ID.String(), &dto.ConvertFieldTypeRequest{FieldType: "multi_select"})
+	assertStatus(t, err, 400)
+}
+
+func TestFieldConversion_ConvertViews(t *testing.T) {
+	field := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: domain.FieldTypeText}
+	other := uuid.New().String()
+	key := field.ID.String()
+	board := uuid.New()
+	backend := "Backend"
+	plan := planFieldConversion(field, domain.FieldTypeSingleSelect, []domain.BoardFieldValue{{BoardID: board, FieldID: field.ID, ValueText: &backend}}, nil)
+	option := plan.newOptions[0].ID.String()
+
+	grouped := domain.SavedView{
+		BaseModel: domain.BaseModel{ID: uuid.New()}, Name: "Grouped",
+		Filters: `{"op":"and","conditions":[` +
+			`{"field":"` + key + `","operator":"in","value":["Backend","Unknown"]},` +
+			`{"op":"not","conditions":[{"field":"` + key + `","operator":"contains","value":"end"}]},` +
+			`{"field":"` + other + `","operator":"contains","value":"x"}]}`,
+	}
+	flat := domain.SavedView{
+		BaseModel: domain.BaseModel{ID: uuid.New()}, Name: "Flat",
+		Filters: `{"` + key + `":{"operator":"contains","value":"end"}}`,
+	}
+	untouched := domain.SavedView{BaseModel: domain.BaseModel{ID: uuid.New()}, Filters: `{"` + other + `":{"operator":"eq","value":"x"}}`}
+	plan.convertViews(field, []domain.SavedView{grouped, flat, untouched})
+
+	// Labels become the new options, text operators a select cannot use are removed with their empty groups
+	require.Len(t, plan.views, 2)
+	assert.Equal(t, dto.ConvertedView{ViewID: grouped.ID.String(), Name: "Grouped", RewrittenFilters: 1, RemovedFilters: 1}, plan.views[0].summary)
+	assert.JSONEq(t, `{"op":"and","conditions":[`+
+		`{"field":"`+key+`","operator":"in","value":["`+option+`"]},`+
+		`{"field":"`+other+`","operator":"contains","value":"x"}]}`, plan.views[0].view.Filters)
+	assert.Equal(t, 1, plan.views[1].summary.RemovedFilters)
+	assert.JSONEq(t, `{}`, plan.views[1].view.Filters)
+
+	// Sort keys on a field that becomes multi-valued are removed
+	single := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: domain.FieldTypeSingleSelect}
+	sorted := domain.SavedView{BaseModel: domain.BaseModel{ID: uuid.New()}, Filters: "{}"}
+	require.NoError(t, sorted.SetSortKeys([]domain.SortKey{{Field: single.ID.String(), Direction: "desc"}, {Field: "title"}}))
+	plan = planFieldConversion(single, domain.FieldTypeMultiSelect, nil, nil)
+	plan.convertViews(single, []domain.SavedView{sorted})
+	require.Len(t, plan.views, 1)
+	assert.Equal(t, 1, plan.views[0].summary.RemovedSorts)
+	keys, err := plan.views[0].view.SortKeys()
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SortKey{{Field: "title"}}, keys)
+
+	// Option IDs become labels and numbers text
+	optionA := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, Label: "A"}
+	single.FieldType = domain.FieldTypeSingleSelect
+	plan = planFieldConversion(single, domain.FieldTypeText, nil, map[uuid.UUID]domain.FieldOption{optionA.ID: optionA})
+	filtered := domain.SavedView{BaseModel: domain.BaseModel{ID: uuid.New()}, Filters: `{"` + single.ID.String() + `":{"operator":"eq","value":"` + optionA.ID.String() + `"}}`}
+	plan.convertViews(single, []domain.SavedView{filtered})
+	require.Len(t, plan.views, 1)
+	assert.JSONEq(t, `{"`+single.ID.String()+`":{"operator":"eq","value":"A"}}`, plan.views[0].view.Filters)
+}
+
+func TestFieldService_ConvertFieldType(t *testing.T) {
+	f := newOptionTestFixture()
+	f.field.FieldType = domain.FieldTypeSingleSelect
+	option := f.option("Backend")
+	board := uuid.New()
+	values := []domain.BoardFieldValue{{BoardID: board, FieldID: f.field.ID, ValueOptionID: &option.ID}}
+
+	// Planned from the locked field and the values read in the transaction
+	locked := *f.field
+	f.fieldRepo.On("FindFieldByIDForUpdate", f.field.ID).Return(&locked, nil).Once()
+	f.fieldRepo.On("FindFieldValuesByField", f.field.ID).Return(values, nil)
+	f.fieldRepo.On("FindOptionsByField", f.field.ID).Return([]domain.FieldOption{*option}, nil)
+	f.fieldRepo.On("FindViewsByProject", f.field.ProjectID).Return([]domain.SavedView{}, nil)
+	f.fieldRepo.On("DeleteFieldValuesByField", f.field.ID).Return(nil)
+	f.fieldRepo.On("DeleteOption", option.ID).Return(nil)
+	f.fieldRepo.On("BatchSetFieldValues", mock.Anything).Return(nil)
+	f.fieldRepo.On("UpdateField", mock.Anything).Return(nil)
+	f.fieldRepo.On("FindFieldValuesByBoards", []uuid.UUID{board}).Return(map[uuid.UUID][]domain.BoardFieldValue{}, nil)
+	f.boardRepo.On("UpdateFieldsCache", board, "{}").Return(nil)
+
+	result, err := f.service.ConvertFieldType(f.adminID.String(), f.field.ID.String(), &dto.ConvertFieldTypeRequest{FieldType: "text"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Conversion.ConvertedValues)
+	f.fieldRepo.AssertCalled(t, "DeleteOption", option.ID)
+	f.fieldRepo.AssertCalled(t, "BatchSetFieldValues", mock.MatchedBy(func(values []domain.BoardFieldValue) bool {
+		return len(values) == 1 && *values[0].ValueText == "Backend"
+	}))
+
+	// A conversion that changed the type meanwhile wins
+	changed := *f.field
+	changed.FieldType = domain.FieldTypeMultiSelect
+	f.fieldRepo.On("FindFieldByIDForUpdate", f.field.ID).Return(&changed, nil).Once()
+	_, err = f.service.ConvertFieldType(f.adminID.String(), f.field.ID.String(), &dto.ConvertFieldTypeRequest{FieldType: "text"})
+	assertStatus(t, err, 409)
+}
+
+func TestFieldService_ConvertFieldType_TooManyOptions(t *testing.T) {
+	f := newOptionTestFixture()
+	f.field.FieldType = domain.FieldTypeText
+	values := make([]domain.BoardFieldValue, 0, maxConversionOptions+1)
+	for i := 0; i <= maxConversionOptions; i++ {
+		label := fmt.Sprintf("value %d", i)
+		values = append(values, domain.BoardFieldValue{BoardID: uuid.New(), FieldID: f.field.ID, ValueText: &label})
+	}
+
+	locked := *f.field
+	f.fieldRepo.On("FindFieldByIDForUpdate", f.field.ID).Return(&locked, nil)
+	f.fieldRepo.On("FindFieldValuesByField", f.field.ID).Return(values, nil)
+	f.fieldRepo.On("FindOptionsByField", f.field.ID).Return([]domain.FieldOption{}, nil)
+	f.fieldRepo.On("FindViewsByProject", f.field.ProjectID).Return([]domain.SavedView{}, nil)
+
+	// The preview reports the count and lists labels up to the cap
+	preview, err := f.service.PreviewFieldConversion(f.adminID.String(), f.field.ID.String(), &dto.ConvertFieldTypeRequest{FieldType: "single_select"})
+	require.NoError(t, err)
+	assert.Equal(t, maxConversionOptions+1, preview.NewOptionCount)
+	assert.Len(t, preview.NewOptions, maxConversionOptions)
+
+	// Converting is rejected before anything is written
+	_, err = f.service.ConvertFieldType(f.adminID.String(), f.field.ID.String(), &dto.ConvertFieldTypeRequest{FieldType: "single_select"})
+	assertStatus(t, err, 400)
+	f.fieldRepo.AssertNotCalled(t, "DeleteFieldValuesByField", mock.Anything)
+}
+
+func TestLockFieldType(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+	field := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: domain.FieldTypeText}
+
+	// Values validated against the current type are written
+	same := *field
+	fieldRepo.On("FindFieldByIDForShare", field.ID).Return(&same, nil).Once()
+	assert.NoError(t, lockFieldType(fieldRepo, field))
+
+	// A conversion committed since validation rejects the write
+	converted := *field
+	converted.FieldType = domain.FieldTypeSingleSelect
+	fieldRepo.On("FindFieldByIDForShare", field.ID).Return(&converted, nil).Once()
+	assertStatus(t, lockFieldType(fieldRepo, field), 409)
+}
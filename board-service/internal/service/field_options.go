@@ -0,0 +1,323 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/uow"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Option Archive & Merge ====================
+// Options still used by boards are not deleted. Archiving hides an option from pickers and kanban
+// columns while boards keep it and history still resolves its label; archived options cannot be
+// chosen as new values. Merging moves every value of an option to another option of the same
+// field, points the field's default and saved view filters at the target, and deletes the source.
+
+// ArchiveOption hides the option from pickers and kanban columns, keeping existing values
+func (s *fieldService) ArchiveOption(userID, optionID string) (*dto.OptionResponse, error) {
+	field, option, err := s.findOptionForAdmin(userID, optionID, "옵션 보관 권한이 없습니다 (ADMIN 이상)")
+	if err != nil {
+		return nil, err
+	}
+	if option.IsArchived {
+		return s.buildOptionResponse(option), nil
+	}
+
+	// The default value and the last active option of a required select stay usable
+	if optionIDIn(fieldConfigDefault(field, s.logger), option.ID.String()) {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "필드 기본값으로 지정된 옵션은 보관할 수 없습니다", 400)
+	}
+	if field.IsRequired {
+		options, err := s.repo.FindOptionsByField(field.ID)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 조회 실패", 500)
+		}
+		if len(activeOptions(options)) <= 1 {
+			return nil, apperrors.New(apperrors.ErrCodeBadRequest, "필수 필드의 마지막 옵션은 보관할 수 없습니다", 400)
+		}
+	}
+
+	option.IsArchived = true
+	if err := s.repo.UpdateOption(option); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 보관 실패", 500)
+	}
+	s.invalidateOptionCaches(field, nil)
+
+	return s.buildOptionResponse(option), nil
+}
+
+// UnarchiveOption makes an archived option selectable again
+func (s *fieldService) UnarchiveOption(userID, optionID string) (*dto.OptionResponse, error) {
+	field, option, err := s.findOptionForAdmin(userID, optionID, "옵션 보관 해제 권한이 없습니다 (ADMIN 이상)")
+	if err != nil {
+		return nil, err
+	}
+	if !option.IsArchived {
+		return s.buildOptionResponse(option), nil
+	}
+
+	option.IsArchived = false
+	if err := s.repo.UpdateOption(option); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 보관 해제 실패", 500)
+	}
+	s.invalidateOptionCaches(field, nil)
+
+	return s.buildOptionResponse(option), nil
+}
+
+// MergeOption moves every value of the option to the target option and deletes it
+// Boards holding both options of a multi select keep the target once. The field's default value and
+// saved view filters referencing the option are rewritten in the same transaction.
+func (s *fieldService) MergeOption(userID, optionID string, req *dto.MergeOptionRequest) (*dto.MergeOptionResponse, error) {
+	field, source, err := s.findOptionForAdmin(userID, optionID, "옵션 병합 권한이 없습니다 (ADMIN 이상)")
+	if err != nil {
+		return nil, err
+	}
+
+	targetUUID, err := uuid.Parse(req.TargetOptionID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 옵션 ID", 400)
+	}
+	if targetUUID == source.ID {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "같은 옵션으로는 병합할 수 없습니다", 400)
+	}
+	target, err := s.repo.FindOptionByID(targetUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "대상 옵션을 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 조회 실패", 500)
+	}
+	if target.FieldID != source.FieldID {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "같은 필드의 옵션끼리만 병합할 수 있습니다", 400)
+	}
+	if target.IsArchived {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "보관된 옵션으로는 병합할 수 없습니다", 400)
+	}
+
+	from, to := source.ID.String(), target.ID.String()
+	var boards []uuid.UUID
+	var updatedViews []uuid.UUID
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		remapped, err := repos.Field.RemapOptionValues(field.ID, source.ID, target.ID)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 값 병합 실패", 500)
+		}
+		boards = remapped
+
+		if err := repos.Field.DeleteOption(source.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 삭제 실패", 500)
+		}
+
+		// Field default value
+		var config map[string]interface{}
+		if err := json.Unmarshal([]byte(field.Config), &config); err == nil {
+			if value, changed := replaceOptionID(config["default_value"], from, to); changed {
+				config["default_value"] = value
+				configJSON, err := json.Marshal(config)
+				if err != nil {
+					return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 설정 변환 실패", 500)
+				}
+				field.Config = string(configJSON)
+				if err := repos.Field.UpdateField(field); err != nil {
+					return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 수정 실패", 500)
+				}
+			}
+		}
+
+		// Saved view filters
+		views, err := repos.Field.FindViewsByProject(field.ProjectID)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "뷰 조회 실패", 500)
+		}
+		for i := range views {
+			filters, changed, err := replaceFilterOptionID(views[i].Filters, from, to)
+			if err != nil {
+				s.logger.Warn("Failed to parse view filters", zap.Error(err), zap.String("view_id", views[i].ID.String()))
+				continue
+			}
+			if !changed {
+				continue
+			}
+			views[i].Filters = filters
+			if err := repos.Field.UpdateView(&views[i]); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "뷰 필터 수정 실패", 500)
+			}
+			updatedViews = append(updatedViews, views[i].ID)
+		}
+
+		return rebuildFieldsCaches(repos, boards)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateOptionCaches(field, boards)
+	ctx := context.Background()
+	for _, viewID := range updatedViews {
+		if err := s.cache.InvalidateViewResults(ctx, viewID.String()); err != nil {
+			s.logger.Warn("Failed to invalidate view results cache", zap.Error(err))
+		}
+	}
+
+	return &dto.MergeOptionResponse{
+		Option:         s.buildOptionResponse(target),
+		AffectedBoards: len(boards),
+		UpdatedViews:   len(updatedViews),
+	}, nil
+}
+
+// findOptionForAdmin fetches the option and its field, requiring ADMIN+ in the field's project
+func (s *fieldService) findOptionForAdmin(userID, optionID, forbidden string) (*domain.ProjectField, *domain.FieldOption, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	optionUUID, err := uuid.Parse(optionID)
+	if err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 옵션 ID", 400)
+	}
+
+	// Fetch option
+	option, err := s.repo.FindOptionByID(optionUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "옵션을 찾을 수 없습니다", 404)
+		}
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 조회 실패", 500)
+	}
+
+	// Check permissions
+	field, err := s.repo.FindFieldByID(option.FieldID)
+	if err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+
+	member, err := s.projectRepo.FindMemberByUserAndProject(userUUID, field.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
+		}
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
+	}
+
+	if member.Role == nil || member.Role.Level < 50 {
+		return nil, nil, apperrors.New(apperrors.ErrCodeForbidden, forbidden, 403)
+	}
+	return field, option, nil
+}
+
+// invalidateOptionCaches drops the cached options and fields of the field and the field values of boards
+func (s *fieldService) invalidateOptionCaches(field *domain.ProjectField, boards []uuid.UUID) {
+	ctx := context.Background()
+	if err := s.cache.InvalidateFieldOptions(ctx, field.ID.String()); err != nil {
+		s.logger.Warn("Failed to invalidate field options cache", zap.Error(err))
+	}
+	if err := s.cache.InvalidateProjectFields(ctx, field.ProjectID.String()); err != nil {
+		s.logger.Warn("Failed to invalidate project fields cache", zap.Error(err))
+	}
+	for _, boardID := range boards {
+		if err := s.cache.InvalidateBoardFieldValues(ctx, boardID.String()); err != nil {
+			s.logger.Warn("Failed to invalidate board field values cache", zap.Error(err))
+		}
+	}
+}
+
+// activeOptions returns the options that are not archived, keeping their order
+func activeOptions(options []domain.FieldOption) []domain.FieldOption {
+	active := make([]domain.FieldOption, 0, len(options))
+	for _, option := range options {
+		if !option.IsArchived {
+			active = append(active, option)
+		}
+	}
+	return active
+}
+
+// archivedOptionError is returned when an archived option is chosen as a new value
+func archivedOptionError(option *domain.FieldOption) error {
+	return apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("보관된 옵션은 선택할 수 없습니다: %s", option.Label), 400)
+}
+
+// fieldConfigDefault returns the raw default_value of the field's config
+func fieldConfigDefault(field *domain.ProjectField, logger *zap.Logger) interface{} {
+	var config domain.FieldConfig
+	if err := json.Unmarshal([]byte(field.Config), &config); err != nil {
+		logger.Warn("Failed to parse config", zap.Error(err), zap.String("field_id", field.ID.String()))
+	}
+	return config.DefaultValue
+}
+
+// optionIDIn reports whether the option ID is the value or one of the values of a select default
+func optionIDIn(value interface{}, optionID string) bool {
+	_, changed := replaceOptionID(value, optionID, optionID)
+	return changed
+}
+
+// replaceOptionID replaces the option ID from with to in a decoded JSON value, dropping duplicates
+// the replacement creates in arrays. changed reports whether from occurred.
+func replaceOptionID(value interface{}, from, to string) (interface{}, bool) {
+	switch v := value.(type) {
+	case string:
+		if v == from {
+			return to, true
+		}
+	case []interface{}:
+		changed := false
+		seen := make(map[string]bool, len(v))
+		replaced := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			item, itemChanged := replaceOptionID(item, from, to)
+			changed = changed || itemChanged
+			if s, ok := item.(string); ok {
+				if seen[s] {
+					continue
+				}
+				seen[s] = true
+			}
+			replaced = append(replaced, item)
+		}
+		if changed {
+			return replaced, true
+		}
+	case map[string]interface{}:
+		changed := false
+		for key, item := range v {
+			if item, itemChanged := replaceOptionID(item, from, to); itemChanged {
+				v[key] = item
+				changed = true
+			}
+		}
+		return v, changed
+	}
+	return value, false
+}
+
+// replaceFilterOptionID replaces the option ID in the values of a view's filter JSON, flat or grouped
+func replaceFilterOptionID(filters, from, to string) (string, bool, error) {
+	if filters == "" {
+		return filters, false, nil
+	}
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(filters), &decoded); err != nil {
+		return filters, false, err
+	}
+	replaced, changed := replaceOptionID(decoded, from, to)
+	if !changed {
+		return filters, false, nil
+	}
+	encoded, err := json.Marshal(replaced)
+	if err != nil {
+		return filters, false, err
+	}
+	return string(encoded), true, nil
+}
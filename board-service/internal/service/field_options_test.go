@@ -0,0 +1,266 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/testutil"
+	"board-service/internal/uow"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// noopFieldCache always misses and ignores writes
+type noopFieldCache struct{}
+
+func (noopFieldCache) GetProjectFields(ctx context.Context, projectID string) ([]byte, error) {
+	return nil, errors.New("cache miss")
+}
+func (noopFieldCache) SetProjectFields(ctx context.Context, projectID string, fieldsJSON []byte, ttl time.Duration) error {
+	return nil
+}
+func (noopFieldCache) InvalidateProjectFields(ctx context.Context, projectID string) error {
+	return nil
+}
+func (noopFieldCache) GetFieldOptions(ctx context.Context, fieldID string) ([]byte, error) {
+	return nil, errors.New("cache miss")
+}
+func (noopFieldCache) SetFieldOptions(ctx context.Context, fieldID string, optionsJSON []byte, ttl time.Duration) error {
+	return nil
+}
+func (noopFieldCache) InvalidateFieldOptions(ctx context.Context, fieldID string) error {
+	return nil
+}
+func (noopFieldCache) GetBoardFieldValues(ctx context.Context, boardID string) (map[string]interface{}, error) {
+	return nil, errors.New("cache miss")
+}
+func (noopFieldCache) SetBoardFieldValues(ctx context.Context, boardID string, values map[string]interface{}, ttl time.Duration) error {
+	return nil
+}
+func (noopFieldCache) InvalidateBoardFieldValues(ctx context.Context, boardID string) error {
+	return nil
+}
+func (noopFieldCache) GetViewResults(ctx context.Context, viewID, filterHash string) ([]byte, error) {
+	return nil, errors.New("cache miss")
+}
+func (noopFieldCache) SetViewResults(ctx context.Context, viewID, filterHash string, resultsJSON []byte, ttl time.Duration) error {
+	return nil
+}
+func (noopFieldCache) InvalidateViewResults(ctx context.Context, viewID string) error {
+	return nil
+}
+
+// fieldUnitOfWork runs the function against the mocked field and board repositories without a database
+type fieldUnitOfWork struct {
+	fields *testutil.MockFieldRepository
+	boards *testutil.MockBoardRepository
+}
+
+func (u *fieldUnitOfWork) Do(fn func(repos *uow.Repositories) error) error {
+	return fn(&uow.Repositories{Field: u.fields, Board: u.boards})
+}
+
+func (u *fieldUnitOfWork) GetDB() *gorm.DB {
+	return nil
+}
+
+type optionTestFixture struct {
+	service   *fieldService
+	fieldRepo *testutil.MockFieldRepository
+	boardRepo *testutil.MockBoardRepository
+	adminID   uuid.UUID
+	memberID  uuid.UUID
+	field     *domain.ProjectField
+}
+
+func newOptionTestFixture() *optionTestFixture {
+	f := &optionTestFixture{
+		fieldRepo: new(testutil.MockFieldRepository),
+		boardRepo: new(testutil.MockBoardRepository),
+		adminID:   uuid.New(),
+		memberID:  uuid.New(),
+	}
+	projectRepo := new(testutil.MockProjectRepository)
+	f.service = &fieldService{
+		repo:        f.fieldRepo,
+		projectRepo: projectRepo,
+		cache:       noopFieldCache{},
+		uow:         &fieldUnitOfWork{fields: f.fieldRepo, boards: f.boardRepo},
+		logger:      zap.NewNop(),
+	}
+
+	f.field = &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), FieldType: domain.FieldTypeMultiSelect, Config: "{}"}
+	f.fieldRepo.On("FindFieldByID", f.field.ID).Return(f.field, nil)
+	projectRepo.On("FindMemberByUserAndProject", f.adminID, f.field.ProjectID).Return(&domain.ProjectMember{Role: &domain.Role{Name: "ADMIN", Level: 50}}, nil)
+	projectRepo.On("FindMemberByUserAndProject", f.memberID, f.field.ProjectID).Return(&domain.ProjectMember{Role: &domain.Role{Name: "MEMBER", Level: 10}}, nil)
+	return f
+}
+
+func (f *optionTestFixture) option(label string) *domain.FieldOption {
+	option := &domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldID: f.field.ID, Label: label}
+	f.fieldRepo.On("FindOptionByID", option.ID).Return(option, nil)
+	return option
+}
+
+func TestFieldService_ArchiveOption(t *testing.T) {
+	f := newOptionTestFixture()
+	todo, done := f.option("Todo"), f.option("Done")
+	f.fieldRepo.On("UpdateOption", mock.Anything).Return(nil)
+
+	// ADMIN+ only
+	_, err := f.service.ArchiveOption(f.memberID.String(), done.ID.String())
+	assertStatus(t, err, 403)
+
+	response, err := f.service.ArchiveOption(f.adminID.String(), done.ID.String())
+	require.NoError(t, err)
+	assert.True(t, response.IsArchived)
+	f.fieldRepo.On("FindOptionsByField", f.field.ID).Return([]domain.FieldOption{*todo, *done}, nil)
+
+	// Archived options are left out of pickers unless asked for
+	options, err := f.service.GetOptionsByField(f.adminID.String(), f.field.ID.String(), false)
+	require.NoError(t, err)
+	require.Len(t, options, 1)
+	assert.Equal(t, todo.ID.String(), options[0].OptionID)
+	options, err = f.service.GetOptionsByField(f.adminID.String(), f.field.ID.String(), true)
+	require.NoError(t, err)
+	assert.Len(t, options, 2)
+
+	// Archived options cannot be chosen as new values
+	_, err = singleSelectFieldValue(f.fieldRepo, domain.BoardFieldValue{FieldID: f.field.ID}, done.ID.String())
+	assertStatus(t, err, 400)
+
+	response, err = f.service.UnarchiveOption(f.adminID.String(), done.ID.String())
+	require.NoError(t, err)
+	assert.False(t, response.IsArchived)
+
+	// The field's default and the last active option of a required field stay usable
+	f.field.Config = `{"default_value":["` + todo.ID.String() + `"]}`
+	_, err = f.service.ArchiveOption(f.adminID.String(), todo.ID.String())
+	assertStatus(t, err, 400)
+	f.field.Config = "{}"
+	f.field.IsRequired = true
+	_, err = f.service.ArchiveOption(f.adminID.String(), todo.ID.String())
+	assertStatus(t, err, 400)
+}
+
+func TestMultiSelectFieldValues_ValidatesOptions(t *testing.T) {
+	f := newOptionTestFixture()
+	todo, done := f.option("Todo"), f.option("Done")
+	archived := f.option("Old")
+	archived.IsArchived = true
+	other := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldID: uuid.New(), Label: "Other"}
+	missing := uuid.New()
+	f.fieldRepo.On("FindOptionsByIDs", mock.Anything).Return([]domain.FieldOption{*todo, *done, *archived, other}, nil)
+	base := domain.BoardFieldValue{BoardID: uuid.New(), FieldID: f.field.ID}
+
+	values, err := multiSelectFieldValues(f.fieldRepo, base, []interface{}{done.ID.String(), todo.ID.String()}, domain.FieldConfig{})
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+	assert.Equal(t, done.ID, *values[0].ValueOptionID)
+	assert.Equal(t, 1, values[1].DisplayOrder)
+
+	// Unknown options, other fields' options and archived options are rejected
+	for _, id := range []uuid.UUID{missing, other.ID, archived.ID} {
+		_, err = multiSelectFieldValues(f.fieldRepo, base, []interface{}{todo.ID.String(), id.String()}, domain.FieldConfig{})
+		assertStatus(t, err, 400)
+	}
+}
+
+func TestFieldService_DeleteOptionInUse(t *testing.T) {
+	f := newOptionTestFixture()
+	used, unused := f.option("Used"), f.option("Unused")
+	f.fieldRepo.On("CountOptionValues", f.field.ID, used.ID).Return(int64(3), nil)
+	f.fieldRepo.On("CountOptionValues", f.field.ID, unused.ID).Return(int64(0), nil)
+	f.fieldRepo.On("DeleteOption", unused.ID).Return(nil)
+
+	err := f.service.DeleteOption(f.adminID.String(), used.ID.String())
+	assertStatus(t, err, 409)
+	f.fieldRepo.AssertNotCalled(t, "DeleteOption", used.ID)
+
+	// The field's default cannot be deleted, used or not
+	f.field.Config = `{"default_value":["` + unused.ID.String() + `"]}`
+	err = f.service.DeleteOption(f.adminID.String(), unused.ID.String())
+	assertStatus(t, err, 409)
+	f.fieldRepo.AssertNotCalled(t, "DeleteOption", unused.ID)
+
+	f.field.Config = "{}"
+	assert.NoError(t, f.service.DeleteOption(f.adminID.String(), unused.ID.String()))
+}
+
+func TestFieldService_MergeOption(t *testing.T) {
+	f := newOptionTestFixture()
+	bug, defect := f.option("Bug"), f.option("Defect")
+	board := uuid.New()
+
+	f.field.Config = `{"default_value":["` + defect.ID.String() + `"]}`
+	view := domain.SavedView{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		Filters:   `{"` + f.field.ID.String() + `":{"operator":"in","value":["` + defect.ID.String() + `","` + bug.ID.String() + `"]}}`,
+	}
+	untouched := domain.SavedView{BaseModel: domain.BaseModel{ID: uuid.New()}, Filters: "{}"}
+
+	f.fieldRepo.On("RemapOptionValues", f.field.ID, defect.ID, bug.ID).Return([]uuid.UUID{board}, nil)
+	f.fieldRepo.On("DeleteOption", defect.ID).Return(nil)
+	f.fieldRepo.On("UpdateField", f.field).Return(nil)
+	f.fieldRepo.On("FindViewsByProject", f.field.ProjectID).Return([]domain.SavedView{view, untouched}, nil)
+	f.fieldRepo.On("UpdateView", mock.Anything).Return(nil)
+	f.fieldRepo.On("FindFieldValuesByBoards", []uuid.UUID{board}).Return(map[uuid.UUID][]domain.BoardFieldValue{
+		board: {{BoardID: board, FieldID: f.field.ID, ValueOptionID: &bug.ID}},
+	}, nil)
+	f.boardRepo.On("UpdateFieldsCache", board, `{"`+f.field.ID.String()+`":"`+bug.ID.String()+`"}`).Return(nil)
+
+	result, err := f.service.MergeOption(f.adminID.String(), defect.ID.String(), &dto.MergeOptionRequest{TargetOptionID: bug.ID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, bug.ID.String(), result.Option.OptionID)
+	assert.Equal(t, 1, result.AffectedBoards)
+	assert.Equal(t, 1, result.UpdatedViews)
+
+	// The default and the view filter point at the target, without duplicates
+	assert.JSONEq(t, `{"default_value":["`+bug.ID.String()+`"]}`, f.field.Config)
+	f.fieldRepo.AssertCalled(t, "UpdateView", mock.MatchedBy(func(v *domain.SavedView) bool {
+		return v.ID == view.ID && v.Filters == `{"`+f.field.ID.String()+`":{"operator":"in","value":["`+bug.ID.String()+`"]}}`
+	}))
+	f.fieldRepo.AssertNumberOfCalls(t, "UpdateView", 1)
+	f.boardRepo.AssertExpectations(t)
+
+	// The target must be another active option of the same field
+	other := &domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldID: uuid.New()}
+	f.fieldRepo.On("FindOptionByID", other.ID).Return(other, nil)
+	_, err = f.service.MergeOption(f.adminID.String(), bug.ID.String(), &dto.MergeOptionRequest{TargetOptionID: other.ID.String()})
+	assertStatus(t, err, 400)
+	_, err = f.service.MergeOption(f.adminID.String(), bug.ID.String(), &dto.MergeOptionRequest{TargetOptionID: bug.ID.String()})
+	assertStatus(t, err, 400)
+	archived := f.option("Old")
+	archived.IsArchived = true
+	_, err = f.service.MergeOption(f.adminID.String(), bug.ID.String(), &dto.MergeOptionRequest{TargetOptionID: archived.ID.String()})
+	assertStatus(t, err, 400)
+	_, err = f.service.MergeOption(f.memberID.String(), defect.ID.String(), &dto.MergeOptionRequest{TargetOptionID: bug.ID.String()})
+	assertStatus(t, err, 403)
+}
+
+func TestReplaceFilterOptionID(t *testing.T) {
+	from, to := uuid.New().String(), uuid.New().String()
+
+	// Grouped filters are rewritten at any depth
+	filters, changed, err := replaceFilterOptionID(`{"op":"or","children":[{"field":"f","operator":"eq","value":"`+from+`"}]}`, from, to)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.JSONEq(t, `{"op":"or","children":[{"field":"f","operator":"eq","value":"`+to+`"}]}`, filters)
+
+	// Filters without the option are left as they are
+	filters, changed, err = replaceFilterOptionID(`{"f":{"operator":"eq","value":"x"}}`, from, to)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, `{"f":{"operator":"eq","value":"x"}}`, filters)
+
+	_, _, err = replaceFilterOptionID(`{`, from, to)
+	assert.Error(t, err)
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/testutil"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestProjectField_AllowsEditBy(t *testing.T) {
+	admin := &domain.Role{Name: "ADMIN", Level: 50}
+	member := &domain.Role{Name: "MEMBER", Level: 10}
+
+	tests := []struct {
+		name  string
+		roles []string
+		role  *domain.Role
+		want  bool
+	}{
+		{"no restriction", nil, member, true},
+		{"role name", []string{"ADMIN"}, admin, true},
+		{"role name is case-insensitive", []string{"member"}, member, true},
+		{"other role", []string{"ADMIN"}, member, false},
+		{"minimum level", []string{"50"}, admin, true},
+		{"below minimum level", []string{"50"}, member, false},
+		{"any of the roles", []string{"50", "MEMBER"}, member, true},
+		{"no role", []string{"MEMBER"}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field := &domain.ProjectField{}
+			field.SetEditRoles(tt.roles)
+			assert.Equal(t, tt.want, field.AllowsEditBy(tt.role))
+		})
+	}
+}
+
+func TestCheckFieldEditor(t *testing.T) {
+	field := &domain.ProjectField{Name: "Stage"}
+	field.SetEditRoles([]string{"ADMIN"})
+
+	// Owners can always edit
+	assert.NoError(t, auth.CheckFieldEditor(&domain.Role{Name: "OWNER", Level: 100}, field))
+
+	err := auth.CheckFieldEditor(&domain.Role{Name: "MEMBER", Level: 10}, field)
+	var appErr *apperrors.AppError
+	require.True(t, errors.As(err, &appErr))
+	assert.Equal(t, 403, appErr.HTTPStatus)
+	assert.Equal(t, apperrors.ErrCodeFieldEditForbidden, appErr.Code)
+	assert.Contains(t, appErr.Message, "'Stage'")
+}
+
+func TestFieldValueService_ProtectedField(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+	boardRepo := new(testutil.MockBoardRepository)
+	projectRepo := new(testutil.MockProjectRepository)
+	s := &fieldValueService{
+		repo:        fieldRepo,
+		boardRepo:   boardRepo,
+		projectRepo: projectRepo,
+		authorizer:  auth.NewProjectAuthorizer(projectRepo, new(testutil.MockRoleRepository)),
+		logger:      zap.NewNop(),
+	}
+
+	userID, projectID, boardID := uuid.New(), uuid.New(), uuid.New()
+	field := &domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Name: "Stage", FieldType: domain.FieldTypeMultiSelect}
+	field.SetEditRoles([]string{"ADMIN"})
+
+	boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{Role: &domain.Role{Name: "MEMBER", Level: 10}}, nil)
+	fieldRepo.On("FindFieldByID", field.ID).Return(field, nil)
+
+	// Values are neither written nor removed
+	err := s.SetFieldValue(userID.String(), &dto.SetFieldValueRequest{BoardID: boardID.String(), FieldID: field.ID.String()})
+	assertStatus(t, err, 403)
+	err = s.SetMultiSelectValue(userID.String(), &dto.SetMultiSelectValueRequest{BoardID: boardID.String(), FieldID: field.ID.String()})
+	assertStatus(t, err, 403)
+	err = s.DeleteFieldValue(userID.String(), boardID.String(), field.ID.String())
+	assertStatus(t, err, 403)
+	fieldRepo.AssertNotCalled(t, "DeleteFieldValue", boardID, field.ID)
+}
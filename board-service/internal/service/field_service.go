@@ -6,10 +6,12 @@ import (
 	"board-service/internal/domain"
 	"board-service/internal/dto"
 	"board-service/internal/repository"
+	"board-service/internal/uow"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,26 +29,39 @@ type FieldService interface {
 	DeleteField(userID, fieldID string) error
 	UpdateFieldOrder(userID, projectID string, req *dto.UpdateFieldOrderRequest) error
 
+	// Field-level edit permissions
+	UpdateFieldEditRoles(userID, fieldID string, req *dto.UpdateFieldEditRolesRequest) (*dto.FieldResponse, error)
+
+	// Field type conversion
+	PreviewFieldConversion(userID, fieldID string, req *dto.ConvertFieldTypeRequest) (*dto.FieldConversionPreview, error)
+	ConvertFieldType(userID, fieldID string, req *dto.ConvertFieldTypeRequest) (*dto.FieldConversionResponse, error)
+
 	// Option CRUD
 	CreateOption(userID string, req *dto.CreateOptionRequest) (*dto.OptionResponse, error)
-	GetOptionsByField(userID, fieldID string) ([]dto.OptionResponse, error)
+	GetOptionsByField(userID, fieldID string, includeArchived bool) ([]dto.OptionResponse, error)
 	GetOption(userID, optionID string) (*dto.OptionResponse, error)
 	UpdateOption(userID, optionID string, req *dto.UpdateOptionRequest) (*dto.OptionResponse, error)
 	DeleteOption(userID, optionID string) error
 	UpdateOptionOrder(userID, fieldID string, req *dto.UpdateOptionOrderRequest) error
+	ArchiveOption(userID, optionID string) (*dto.OptionResponse, error)
+	UnarchiveOption(userID, optionID string) (*dto.OptionResponse, error)
+	MergeOption(userID, optionID string, req *dto.MergeOptionRequest) (*dto.MergeOptionResponse, error)
 }
 
 type fieldService struct {
 	repo        repository.FieldRepository
 	projectRepo repository.ProjectRepository
+	roleRepo    repository.RoleRepository
 	cache       cache.FieldCache
 	logger      *zap.Logger
 	db          *gorm.DB
+	uow         uow.UnitOfWork // Field type conversions
 }
 
 func NewFieldService(
 	repo repository.FieldRepository,
 	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
 	cache cache.FieldCache,
 	logger *zap.Logger,
 	db *gorm.DB,
@@ -54,9 +69,11 @@ func NewFieldService(
 	return &fieldService{
 		repo:        repo,
 		projectRepo: projectRepo,
+		roleRepo:    roleRepo,
 		cache:       cache,
 		logger:      logger,
 		db:          db,
+		uow:         uow.NewUnitOfWork(db),
 	}
 }
 
@@ -331,6 +348,77 @@ func (s *fieldService) DeleteField(userID, fieldID string) error {
 	return nil
 }
 
+// UpdateFieldEditRoles replaces the roles allowed to edit the field's values; no roles lets every member edit
+// Roles are role names or minimum role levels ("50" allows ADMIN and above).
+func (s *fieldService) UpdateFieldEditRoles(userID, fieldID string, req *dto.UpdateFieldEditRolesRequest) (*dto.FieldResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	fieldUUID, err := uuid.Parse(fieldID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 필드 ID", 400)
+	}
+
+	// Fetch field
+	field, err := s.repo.FindFieldByID(fieldUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "필드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+
+	// Check permissions
+	member, err := s.projectRepo.FindMemberByUserAndProject(userUUID, field.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
+	}
+
+	if member.Role == nil || member.Role.Level < 50 {
+		return nil, apperrors.New(apperrors.ErrCodeForbidden, "필드 권한 수정 권한이 없습니다 (ADMIN 이상)", 403)
+	}
+
+	// Validate roles (names are stored as the role's canonical name)
+	roles := make([]string, 0, len(req.Roles))
+	seen := make(map[string]bool, len(req.Roles))
+	for _, entry := range req.Roles {
+		entry = strings.TrimSpace(entry)
+		if _, err := strconv.Atoi(entry); err != nil {
+			role, err := s.roleRepo.FindByName(strings.ToUpper(entry))
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil, apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("존재하지 않는 역할입니다: %s", entry), 400)
+				}
+				return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "역할 조회 실패", 500)
+			}
+			entry = role.Name
+		}
+		if !seen[entry] {
+			seen[entry] = true
+			roles = append(roles, entry)
+		}
+	}
+	field.SetEditRoles(roles)
+
+	// Save
+	if err := s.repo.UpdateField(field); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 수정 실패", 500)
+	}
+
+	// Invalidate cache
+	ctx := context.Background()
+	if err := s.cache.InvalidateProjectFields(ctx, field.ProjectID.String()); err != nil {
+		s.logger.Warn("Failed to invalidate project fields cache", zap.Error(err))
+	}
+
+	return s.buildFieldResponse(field), nil
+}
+
 func (s *fieldService) UpdateFieldOrder(userID, projectID string, req *dto.UpdateFieldOrderRequest) error {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
@@ -448,7 +536,8 @@ func (s *fieldService) CreateOption(userID string, req *dto.CreateOptionRequest)
 	return s.buildOptionResponse(option), nil
 }
 
-func (s *fieldService) GetOptionsByField(userID, fieldID string) ([]dto.OptionResponse, error) {
+// GetOptionsByField returns the field's options; archived options are left out unless includeArchived
+func (s *fieldService) GetOptionsByField(userID, fieldID string, includeArchived bool) ([]dto.OptionResponse, error) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
@@ -482,7 +571,7 @@ func (s *fieldService) GetOptionsByField(userID, fieldID string) ([]dto.OptionRe
 	if cachedData, err := s.cache.GetFieldOptions(ctx, fieldID); err == nil {
 		var responses []dto.OptionResponse
 		if json.Unmarshal(cachedData, &responses) == nil {
-			return responses, nil
+			return filterArchivedOptions(responses, includeArchived), nil
 		}
 	}
 
@@ -504,7 +593,7 @@ func (s *fieldService) GetOptionsByField(userID, fieldID string) ([]dto.OptionRe
 		}
 	}
 
-	return responses, nil
+	return filterArchivedOptions(responses, includeArchived), nil
 }
 
 func (s *fieldService) GetOption(userID, optionID string) (*dto.OptionResponse, error) {
@@ -645,6 +734,20 @@ func (s *fieldService) DeleteOption(userID, optionID string) error {
 		return apperrors.New(apperrors.ErrCodeForbidden, "옵션 삭제 권한이 없습니다 (ADMIN 이상)", 403)
 	}
 
+	// The default value stays an existing option, so new boards can still get it
+	if optionIDIn(fieldConfigDefault(field, s.logger), option.ID.String()) {
+		return apperrors.New(apperrors.ErrCodeConflict, "필드 기본값으로 지정된 옵션은 삭제할 수 없습니다. 기본값을 먼저 변경하세요", 409)
+	}
+
+	// Options in use are archived or merged instead, so boards never point at a deleted option
+	inUse, err := s.repo.CountOptionValues(option.FieldID, optionUUID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 사용 여부 확인 실패", 500)
+	}
+	if inUse > 0 {
+		return apperrors.New(apperrors.ErrCodeConflict, fmt.Sprintf("%d개의 보드가 사용 중인 옵션입니다. 보관하거나 다른 옵션으로 병합하세요", inUse), 409)
+	}
+
 	// Soft delete
 	if err := s.repo.DeleteOption(optionUUID); err != nil {
 		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 삭제 실패", 500)
@@ -718,6 +821,20 @@ func (s *fieldService) UpdateOptionOrder(userID, fieldID string, req *dto.Update
 
 // ==================== Helper Methods ====================
 
+// filterArchivedOptions leaves archived options out of option responses unless includeArchived
+func filterArchivedOptions(responses []dto.OptionResponse, includeArchived bool) []dto.OptionResponse {
+	if includeArchived {
+		return responses
+	}
+	active := make([]dto.OptionResponse, 0, len(responses))
+	for _, response := range responses {
+		if !response.IsArchived {
+			active = append(active, response)
+		}
+	}
+	return active
+}
+
 func (s *fieldService) buildFieldResponse(field *domain.ProjectField) *dto.FieldResponse {
 	// Parse config JSON
 	var config map[string]interface{}
@@ -726,12 +843,6 @@ func (s *fieldService) buildFieldResponse(field *domain.ProjectField) *dto.Field
 		config = make(map[string]interface{})
 	}
 
-	// Parse can_edit_roles
-	var canEditRoles []string
-	if field.CanEditRoles != nil && *field.CanEditRoles != "" {
-		canEditRoles = strings.Split(*field.CanEditRoles, ",")
-	}
-
 	return &dto.FieldResponse{
 		FieldID:         field.ID.String(),
 		ProjectID:       field.ProjectID.String(),
@@ -742,7 +853,7 @@ func (s *fieldService) buildFieldResponse(field *domain.ProjectField) *dto.Field
 		IsRequired:      field.IsRequired,
 		IsSystemDefault: field.IsSystemDefault,
 		Config:          config,
-		CanEditRoles:    canEditRoles,
+		CanEditRoles:    field.EditRoles(),
 		CreatedAt:       field.CreatedAt,
 		UpdatedAt:       field.UpdatedAt,
 	}
@@ -756,6 +867,7 @@ func (s *fieldService) buildOptionResponse(option *domain.FieldOption) *dto.Opti
 		Color:        option.Color,
 		Description:  option.Description,
 		DisplayOrder: option.DisplayOrder,
+		IsArchived:   option.IsArchived,
 		CreatedAt:    option.CreatedAt,
 		UpdatedAt:    option.UpdatedAt,
 	}
@@ -795,6 +907,9 @@ func (s *fieldService) validateAndSerializeConfig(fieldType string, config map[s
 			}
 		}
 	}
+	if err := validateDefaultValue(domain.FieldType(fieldType), config["default_value"]); err != nil {
+		return "", err
+	}
 
 	// Serialize to JSON
 	configJSON, err := json.Marshal(config)
@@ -3,14 +3,18 @@ package service
 import (
 	"board-service/internal/apperrors"
 	"board-service/internal/cache"
+	"board-service/internal/common/auth"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
+	"board-service/internal/realtime"
 	"board-service/internal/repository"
+	"board-service/internal/uow"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -34,18 +38,23 @@ type FieldValueService interface {
 }
 
 type fieldValueService struct {
-	repo         repository.FieldRepository
-	boardRepo    repository.BoardRepository
-	projectRepo  repository.ProjectRepository
-	cache        cache.FieldCache
-	logger       *zap.Logger
-	db           *gorm.DB
+	repo        repository.FieldRepository
+	boardRepo   repository.BoardRepository
+	projectRepo repository.ProjectRepository
+	authorizer  auth.ProjectAuthorizer // Field-level edit permissions (CanEditRoles)
+	events      realtime.Publisher
+	cache       cache.FieldCache
+	uow         uow.UnitOfWork // Value writes, the board cache, activity and outbox events
+	logger      *zap.Logger
+	db          *gorm.DB
 }
 
 func NewFieldValueService(
 	repo repository.FieldRepository,
 	boardRepo repository.BoardRepository,
 	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	eventPublisher realtime.Publisher,
 	cache cache.FieldCache,
 	logger *zap.Logger,
 	db *gorm.DB,
@@ -54,7 +63,10 @@ func NewFieldValueService(
 		repo:        repo,
 		boardRepo:   boardRepo,
 		projectRepo: projectRepo,
+		authorizer:  auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		events:      eventPublisher,
 		cache:       cache,
+		uow:         uow.NewUnitOfWork(db),
 		logger:      logger,
 		db:          db,
 	}
@@ -87,16 +99,7 @@ func (s *fieldValueService) SetFieldValue(userID string, req *dto.SetFieldValueR
 		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
 	}
 
-	// 2. Check project membership
-	_, err = s.projectRepo.FindMemberByUserAndProject(userUUID, board.ProjectID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
-		}
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
-	}
-
-	// 3. Fetch field
+	// 2. Fetch field
 	field, err := s.repo.FindFieldByID(fieldUUID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -105,21 +108,50 @@ func (s *fieldValueService) SetFieldValue(userID string, req *dto.SetFieldValueR
 		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
 	}
 
-	// 4. Validate field belongs to board's project
+	// 3. Validate field belongs to board's project and the user is a member whose role may edit it
 	if field.ProjectID != board.ProjectID {
 		return apperrors.New(apperrors.ErrCodeBadRequest, "필드가 보드의 프로젝트에 속하지 않습니다", 400)
 	}
+	if _, err := s.authorizer.RequireFieldEditor(userUUID, field); err != nil {
+		return err
+	}
 
-	// 5. Validate and set value based on field type
-	if err := s.setValueByType(boardUUID, fieldUUID, field.FieldType, field.Config, req.Value, req.Values); err != nil {
+	// Previous values for activity history
+	previousValues, err := s.repo.FindFieldValuesByBoardAndField(boardUUID, fieldUUID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 값 조회 실패", 500)
+	}
+
+	// 4. Validate value based on field type
+	values, err := buildFieldValues(s.repo, boardUUID, field, req.Value, req.Values, s.logger)
+	if err != nil {
 		return err
 	}
+	if len(values) == 0 && field.IsRequired {
+		return requiredFieldError(field)
+	}
 
-	// 6. Update board's custom_fields_cache
-	if err := s.updateBoardCache(boardUUID); err != nil {
-		s.logger.Warn("Failed to update board cache", zap.Error(err))
+	// 5. Set value, update board's custom_fields_cache and record the activity and event together
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := lockFieldType(repos.Field, field); err != nil {
+			return err
+		}
+		if err := writeFieldValues(repos.Field, boardUUID, field, values); err != nil {
+			return err
+		}
+		if err := recordFieldValueActivity(repos, board, field, userUUID, previousValues, values); err != nil {
+			return err
+		}
+		return recordFieldValueChange(repos, boardUUID, userUUID)
+	})
+	if err != nil {
+		return err
 	}
 
+	// 6. Notify project subscribers
+	s.invalidateBoardCache(boardUUID)
+	publishEvent(s.events, s.logger, realtime.EventBoardUpdated, board.ProjectID, board.ID, userUUID, req)
+
 	return nil
 }
 
@@ -148,16 +180,7 @@ func (s *fieldValueService) SetMultiSelectValue(userID string, req *dto.SetMulti
 		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
 	}
 
-	// 2. Check project membership
-	_, err = s.projectRepo.FindMemberByUserAndProject(userUUID, board.ProjectID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
-		}
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
-	}
-
-	// 3. Fetch field
+	// 2. Fetch field
 	field, err := s.repo.FindFieldByID(fieldUUID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -166,17 +189,27 @@ func (s *fieldValueService) SetMultiSelectValue(userID string, req *dto.SetMulti
 		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
 	}
 
-	// 4. Validate field type
+	// 3. Validate field type, project and the user's permission to edit it
 	if field.FieldType != domain.FieldTypeMultiSelect && field.FieldType != domain.FieldTypeMultiUser {
 		return apperrors.New(apperrors.ErrCodeBadRequest, "Multi-select 또는 Multi-user 필드만 지원합니다", 400)
 	}
+	if field.ProjectID != board.ProjectID {
+		return apperrors.New(apperrors.ErrCodeBadRequest, "필드가 보드의 프로젝트에 속하지 않습니다", 400)
+	}
+	if _, err := s.authorizer.RequireFieldEditor(userUUID, field); err != nil {
+		return err
+	}
+	if len(req.Values) == 0 && field.IsRequired {
+		return requiredFieldError(field)
+	}
 
-	// 5. Delete existing values
-	if err := s.repo.BatchDeleteFieldValues(boardUUID, fieldUUID); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 값 삭제 실패", 500)
+	// Previous values for activity history
+	previousValues, err := s.repo.FindFieldValuesByBoardAndField(boardUUID, fieldUUID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 값 조회 실패", 500)
 	}
 
-	// 6. Set new ordered values
+	// 4. Build new ordered values
 	values := make([]domain.BoardFieldValue, 0, len(req.Values))
 	for _, orderedVal := range req.Values {
 		valueUUID, err := uuid.Parse(orderedVal.ValueID)
@@ -198,16 +231,36 @@ func (s *fieldValueService) SetMultiSelectValue(userID string, req *dto.SetMulti
 
 		values = append(values, value)
 	}
-
-	if err := s.repo.BatchSetFieldValues(values); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "값 설정 실패", 500)
+	if field.FieldType == domain.FieldTypeMultiSelect {
+		if err := validateFieldOptions(s.repo, fieldUUID, values); err != nil {
+			return err
+		}
 	}
 
-	// 7. Update board cache
-	if err := s.updateBoardCache(boardUUID); err != nil {
-		s.logger.Warn("Failed to update board cache", zap.Error(err))
+	// 5. Replace existing values, update board cache and record the activity and event together
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := lockFieldType(repos.Field, field); err != nil {
+			return err
+		}
+		if err := repos.Field.BatchDeleteFieldValues(boardUUID, fieldUUID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 값 삭제 실패", 500)
+		}
+		if err := repos.Field.BatchSetFieldValues(values); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "값 설정 실패", 500)
+		}
+		if err := recordFieldValueActivity(repos, board, field, userUUID, previousValues, values); err != nil {
+			return err
+		}
+		return recordFieldValueChange(repos, boardUUID, userUUID)
+	})
+	if err != nil {
+		return err
 	}
 
+	// 6. Notify project subscribers
+	s.invalidateBoardCache(boardUUID)
+	publishEvent(s.events, s.logger, realtime.EventBoardUpdated, board.ProjectID, board.ID, userUUID, req)
+
 	return nil
 }
 
@@ -324,25 +377,56 @@ func (s *fieldValueService) DeleteFieldValue(userID, boardID, fieldID string) er
 		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
 	}
 
-	// 2. Check project membership
-	_, err = s.projectRepo.FindMemberByUserAndProject(userUUID, board.ProjectID)
+	// 2. Fetch field and check the user's permission to edit it
+	field, err := s.repo.FindFieldByID(fieldUUID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
+			return apperrors.New(apperrors.ErrCodeNotFound, "필드를 찾을 수 없습니다", 404)
 		}
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	if field.ProjectID != board.ProjectID {
+		return apperrors.New(apperrors.ErrCodeBadRequest, "필드가 보드의 프로젝트에 속하지 않습니다", 400)
+	}
+	if _, err := s.authorizer.RequireFieldEditor(userUUID, field); err != nil {
+		return err
 	}
 
-	// 3. Delete field value
-	if err := s.repo.DeleteFieldValue(boardUUID, fieldUUID); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 삭제 실패", 500)
+	// Required fields keep their value; it can only be replaced
+	if field.IsRequired {
+		return requiredFieldError(field)
 	}
 
-	// 4. Update board cache
-	if err := s.updateBoardCache(boardUUID); err != nil {
-		s.logger.Warn("Failed to update board cache", zap.Error(err))
+	// Previous values for activity history
+	previousValues, err := s.repo.FindFieldValuesByBoardAndField(boardUUID, fieldUUID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 값 조회 실패", 500)
 	}
 
+	// 3. Delete field value, update board cache and record the activity and event together
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := lockFieldType(repos.Field, field); err != nil {
+			return err
+		}
+		if err := repos.Field.DeleteFieldValue(boardUUID, fieldUUID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 삭제 실패", 500)
+		}
+		if err := recordFieldValueActivity(repos, board, field, userUUID, previousValues, nil); err != nil {
+			return err
+		}
+		return recordFieldValueChange(repos, boardUUID, userUUID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// 4. Notify project subscribers (value cleared)
+	s.invalidateBoardCache(boardUUID)
+	publishEvent(s.events, s.logger, realtime.EventBoardUpdated, board.ProjectID, board.ID, userUUID, &dto.SetFieldValueRequest{
+		BoardID: boardID,
+		FieldID: fieldID,
+	})
+
 	return nil
 }
 
@@ -352,58 +436,119 @@ func (s *fieldValueService) UpdateMultiSelectOrder(userID, boardID, fieldID stri
 
 // ==================== Helper Methods ====================
 
-func (s *fieldValueService) setValueByType(boardID, fieldID uuid.UUID, fieldType domain.FieldType, configJSON string, singleValue, multiValue interface{}) error {
+// writeFieldValues stores the validated values of a field
+// Multi-value fields replace all their values; single values are upserted
+func writeFieldValues(repo repository.FieldRepository, boardID uuid.UUID, field *domain.ProjectField, values []domain.BoardFieldValue) error {
+	if isMultiValueField(field.FieldType) {
+		if err := repo.BatchDeleteFieldValues(boardID, field.ID); err != nil {
+			return err
+		}
+		return repo.BatchSetFieldValues(values)
+	}
+	return repo.SetFieldValue(&values[0])
+}
+
+// lockFieldType share-locks the field row for the rest of the transaction, so a type conversion waits
+// for the values being written, and rejects the write if the field was converted since it was validated
+func lockFieldType(repo repository.FieldRepository, field *domain.ProjectField) error {
+	locked, err := repo.FindFieldByIDForShare(field.ID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	if locked.FieldType != field.FieldType {
+		return fieldTypeChangedError()
+	}
+	return nil
+}
+
+func fieldTypeChangedError() error {
+	return apperrors.New(apperrors.ErrCodeConflict, "필드 타입이 다른 요청으로 변경되었습니다. 다시 시도해 주세요", 409)
+}
+
+// recordFieldValueChange rebuilds the board's custom_fields_cache and records board.updated in the outbox
+// The event carries the updated board snapshot, like the ones written by board updates
+func recordFieldValueChange(repos *uow.Repositories, boardID, actorID uuid.UUID) error {
+	if err := rebuildFieldsCaches(repos, []uuid.UUID{boardID}); err != nil {
+		return err
+	}
+
+	board, err := repos.Board.FindByID(boardID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	event, err := domain.NewBoardDomainEvent(domain.EventTypeBoardUpdated, board, actorID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "도메인 이벤트 생성 실패", 500)
+	}
+	if err := repos.Event.Create(event); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "도메인 이벤트 저장 실패", 500)
+	}
+	return nil
+}
+
+// isMultiValueField reports whether the field type holds an ordered list of values
+func isMultiValueField(fieldType domain.FieldType) bool {
+	return fieldType == domain.FieldTypeMultiSelect || fieldType == domain.FieldTypeMultiUser
+}
+
+// buildFieldValues validates a value of the field and returns the rows that store it, without writing them
+// Single-value types read singleValue and multi-value types read multiValue; chosen options must be
+// active options of the field.
+func buildFieldValues(repo repository.FieldRepository, boardID uuid.UUID, field *domain.ProjectField, singleValue, multiValue interface{}, logger *zap.Logger) ([]domain.BoardFieldValue, error) {
 	// Parse config
 	var config domain.FieldConfig
-	if err := json.Unmarshal([]byte(configJSON), &config); err != nil {
-		s.logger.Warn("Failed to parse config", zap.Error(err))
+	if err := json.Unmarshal([]byte(field.Config), &config); err != nil {
+		logger.Warn("Failed to parse config", zap.Error(err))
 	}
 
-	switch fieldType {
+	base := domain.BoardFieldValue{BoardID: boardID, FieldID: field.ID}
+	single := func(val domain.BoardFieldValue, err error) ([]domain.BoardFieldValue, error) {
+		if err != nil {
+			return nil, err
+		}
+		return []domain.BoardFieldValue{val}, nil
+	}
+
+	switch field.FieldType {
 	case domain.FieldTypeText:
-		return s.setTextValue(boardID, fieldID, singleValue, config)
+		return single(textFieldValue(base, singleValue, config))
 	case domain.FieldTypeNumber:
-		return s.setNumberValue(boardID, fieldID, singleValue, config)
+		return single(numberFieldValue(base, singleValue, config))
 	case domain.FieldTypeSingleSelect:
-		return s.setSingleSelectValue(boardID, fieldID, singleValue)
+		return single(singleSelectFieldValue(repo, base, singleValue))
 	case domain.FieldTypeMultiSelect:
-		return s.setMultiSelectValues(boardID, fieldID, multiValue, config)
+		return multiSelectFieldValues(repo, base, multiValue, config)
 	case domain.FieldTypeDate, domain.FieldTypeDateTime:
-		return s.setDateValue(boardID, fieldID, singleValue)
+		return single(dateFieldValue(base, singleValue))
 	case domain.FieldTypeSingleUser:
-		return s.setSingleUserValue(boardID, fieldID, singleValue)
+		return single(singleUserFieldValue(base, singleValue))
 	case domain.FieldTypeMultiUser:
-		return s.setMultiUserValues(boardID, fieldID, multiValue, config)
+		return multiUserFieldValues(base, multiValue, config)
 	case domain.FieldTypeCheckbox:
-		return s.setCheckboxValue(boardID, fieldID, singleValue)
+		return single(checkboxFieldValue(base, singleValue))
 	case domain.FieldTypeURL:
-		return s.setURLValue(boardID, fieldID, singleValue)
+		return single(urlFieldValue(base, singleValue))
 	default:
-		return apperrors.New(apperrors.ErrCodeBadRequest, "지원하지 않는 필드 타입입니다", 400)
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "지원하지 않는 필드 타입입니다", 400)
 	}
 }
 
-func (s *fieldValueService) setTextValue(boardID, fieldID uuid.UUID, value interface{}, config domain.FieldConfig) error {
+func textFieldValue(val domain.BoardFieldValue, value interface{}, config domain.FieldConfig) (domain.BoardFieldValue, error) {
 	strVal, ok := value.(string)
 	if !ok {
-		return apperrors.New(apperrors.ErrCodeBadRequest, "텍스트 값이 필요합니다", 400)
+		return val, apperrors.New(apperrors.ErrCodeBadRequest, "텍스트 값이 필요합니다", 400)
 	}
 
 	// Validate max length
 	if config.MaxLength != nil && len(strVal) > *config.MaxLength {
-		return apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("텍스트 길이가 최대값(%d)을 초과했습니다", *config.MaxLength), 400)
-	}
-
-	val := &domain.BoardFieldValue{
-		BoardID:   boardID,
-		FieldID:   fieldID,
-		ValueText: &strVal,
+		return val, apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("텍스트 길이가 최대값(%d)을 초과했습니다", *config.MaxLength), 400)
 	}
 
-	return s.repo.SetFieldValue(val)
+	val.ValueText = &strVal
+	return val, nil
 }
 
-func (s *fieldValueService) setNumberValue(boardID, fieldID uuid.UUID, value interface{}, config domain.FieldConfig) error {
+func numberFieldValue(val domain.BoardFieldValue, value interface{}, config domain.FieldConfig) (domain.BoardFieldValue, error) {
 	var numVal float64
 	switch v := value.(type) {
 	case float64:
@@ -411,218 +556,232 @@ func (s *fieldValueService) setNumberValue(boardID, fieldID uuid.UUID, value int
 	case int:
 		numVal = float64(v)
 	default:
-		return apperrors.New(apperrors.ErrCodeBadRequest, "숫자 값이 필요합니다", 400)
+		return val, apperrors.New(apperrors.ErrCodeBadRequest, "숫자 값이 필요합니다", 400)
 	}
 
 	// Validate range
 	if config.Min != nil && numVal < *config.Min {
-		return apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("값이 최소값(%.2f)보다 작습니다", *config.Min), 400)
+		return val, apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("값이 최소값(%.2f)보다 작습니다", *config.Min), 400)
 	}
 	if config.Max != nil && numVal > *config.Max {
-		return apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("값이 최대값(%.2f)보다 큽니다", *config.Max), 400)
-	}
-
-	val := &domain.BoardFieldValue{
-		BoardID:     boardID,
-		FieldID:     fieldID,
-		ValueNumber: &numVal,
+		return val, apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("값이 최대값(%.2f)보다 큽니다", *config.Max), 400)
 	}
 
-	return s.repo.SetFieldValue(val)
+	val.ValueNumber = &numVal
+	return val, nil
 }
 
-func (s *fieldValueService) setSingleSelectValue(boardID, fieldID uuid.UUID, value interface{}) error {
+func singleSelectFieldValue(repo repository.FieldRepository, val domain.BoardFieldValue, value interface{}) (domain.BoardFieldValue, error) {
 	optionIDStr, ok := value.(string)
 	if !ok {
-		return apperrors.New(apperrors.ErrCodeBadRequest, "옵션 ID가 필요합니다", 400)
+		return val, apperrors.New(apperrors.ErrCodeBadRequest, "옵션 ID가 필요합니다", 400)
 	}
 
 	optionID, err := uuid.Parse(optionIDStr)
 	if err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 옵션 ID", 400)
+		return val, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 옵션 ID", 400)
 	}
 
 	// Validate option exists and belongs to field
-	option, err := s.repo.FindOptionByID(optionID)
-	if err != nil || option.FieldID != fieldID {
-		return apperrors.New(apperrors.ErrCodeBadRequest, "유효하지 않은 옵션입니다", 400)
+	option, err := repo.FindOptionByID(optionID)
+	if err != nil || option.FieldID != val.FieldID {
+		return val, apperrors.New(apperrors.ErrCodeBadRequest, "유효하지 않은 옵션입니다", 400)
 	}
-
-	val := &domain.BoardFieldValue{
-		BoardID:       boardID,
-		FieldID:       fieldID,
-		ValueOptionID: &optionID,
+	if option.IsArchived {
+		return val, archivedOptionError(option)
 	}
 
-	return s.repo.SetFieldValue(val)
+	val.ValueOptionID = &optionID
+	return val, nil
 }
 
-func (s *fieldValueService) setMultiSelectValues(boardID, fieldID uuid.UUID, values interface{}, config domain.FieldConfig) error {
+func multiSelectFieldValues(repo repository.FieldRepository, base domain.BoardFieldValue, values interface{}, config domain.FieldConfig) ([]domain.BoardFieldValue, error) {
 	optionIDs, ok := values.([]interface{})
 	if !ok {
-		return apperrors.New(apperrors.ErrCodeBadRequest, "옵션 ID 배열이 필요합니다", 400)
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "옵션 ID 배열이 필요합니다", 400)
 	}
 
 	// Validate max selections
 	if config.MaxSelections != nil && len(optionIDs) > *config.MaxSelections {
-		return apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("선택 개수가 최대값(%d)을 초과했습니다", *config.MaxSelections), 400)
-	}
-
-	// Delete existing values
-	if err := s.repo.BatchDeleteFieldValues(boardID, fieldID); err != nil {
-		return err
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("선택 개수가 최대값(%d)을 초과했습니다", *config.MaxSelections), 400)
 	}
 
-	// Set new values
 	fieldValues := make([]domain.BoardFieldValue, 0, len(optionIDs))
 	for i, optionIDVal := range optionIDs {
 		optionIDStr, ok := optionIDVal.(string)
 		if !ok {
-			return apperrors.New(apperrors.ErrCodeBadRequest, "잘못된 옵션 ID 형식", 400)
+			return nil, apperrors.New(apperrors.ErrCodeBadRequest, "잘못된 옵션 ID 형식", 400)
 		}
 
 		optionID, err := uuid.Parse(optionIDStr)
 		if err != nil {
-			return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 옵션 ID", 400)
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 옵션 ID", 400)
 		}
 
-		fieldValues = append(fieldValues, domain.BoardFieldValue{
-			BoardID:       boardID,
-			FieldID:       fieldID,
-			ValueOptionID: &optionID,
-			DisplayOrder:  i,
-		})
+		val := base
+		val.ValueOptionID = &optionID
+		val.DisplayOrder = i
+		fieldValues = append(fieldValues, val)
 	}
+	if err := validateFieldOptions(repo, base.FieldID, fieldValues); err != nil {
+		return nil, err
+	}
+	return fieldValues, nil
+}
 
-	return s.repo.BatchSetFieldValues(fieldValues)
+// validateFieldOptions checks that the options of the values exist, belong to the field and are not archived
+func validateFieldOptions(repo repository.FieldRepository, fieldID uuid.UUID, values []domain.BoardFieldValue) error {
+	if len(values) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		ids = append(ids, *value.ValueOptionID)
+	}
+	options, err := repo.FindOptionsByIDs(ids)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 조회 실패", 500)
+	}
+
+	byID := make(map[uuid.UUID]*domain.FieldOption, len(options))
+	for i := range options {
+		byID[options[i].ID] = &options[i]
+	}
+	for _, id := range ids {
+		option, ok := byID[id]
+		if !ok || option.FieldID != fieldID {
+			return apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("유효하지 않은 옵션입니다: %s", id), 400)
+		}
+		if option.IsArchived {
+			return archivedOptionError(option)
+		}
+	}
+	return nil
 }
 
-func (s *fieldValueService) setDateValue(boardID, fieldID uuid.UUID, value interface{}) error {
+func dateFieldValue(val domain.BoardFieldValue, value interface{}) (domain.BoardFieldValue, error) {
 	dateStr, ok := value.(string)
 	if !ok {
-		return apperrors.New(apperrors.ErrCodeBadRequest, "날짜 문자열이 필요합니다 (ISO 8601)", 400)
+		return val, apperrors.New(apperrors.ErrCodeBadRequest, "날짜 문자열이 필요합니다 (ISO 8601)", 400)
 	}
 
 	dateVal, err := time.Parse(time.RFC3339, dateStr)
 	if err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 날짜 형식입니다 (ISO 8601)", 400)
-	}
-
-	val := &domain.BoardFieldValue{
-		BoardID:   boardID,
-		FieldID:   fieldID,
-		ValueDate: &dateVal,
+		return val, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 날짜 형식입니다 (ISO 8601)", 400)
 	}
 
-	return s.repo.SetFieldValue(val)
+	val.ValueDate = &dateVal
+	return val, nil
 }
 
-func (s *fieldValueService) setSingleUserValue(boardID, fieldID uuid.UUID, value interface{}) error {
+func singleUserFieldValue(val domain.BoardFieldValue, value interface{}) (domain.BoardFieldValue, error) {
 	userIDStr, ok := value.(string)
 	if !ok {
-		return apperrors.New(apperrors.ErrCodeBadRequest, "사용자 ID가 필요합니다", 400)
+		return val, apperrors.New(apperrors.ErrCodeBadRequest, "사용자 ID가 필요합니다", 400)
 	}
 
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
-	}
-
-	val := &domain.BoardFieldValue{
-		BoardID:     boardID,
-		FieldID:     fieldID,
-		ValueUserID: &userID,
+		return val, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
 	}
 
-	return s.repo.SetFieldValue(val)
+	val.ValueUserID = &userID
+	return val, nil
 }
 
-func (s *fieldValueService) setMultiUserValues(boardID, fieldID uuid.UUID, values interface{}, config domain.FieldConfig) error {
+func multiUserFieldValues(base domain.BoardFieldValue, values interface{}, config domain.FieldConfig) ([]domain.BoardFieldValue, error) {
 	userIDs, ok := values.([]interface{})
 	if !ok {
-		return apperrors.New(apperrors.ErrCodeBadRequest, "사용자 ID 배열이 필요합니다", 400)
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "사용자 ID 배열이 필요합니다", 400)
 	}
 
 	// Validate max users
 	if config.MaxUsers != nil && len(userIDs) > *config.MaxUsers {
-		return apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("사용자 개수가 최대값(%d)을 초과했습니다", *config.MaxUsers), 400)
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("사용자 개수가 최대값(%d)을 초과했습니다", *config.MaxUsers), 400)
 	}
 
-	// Delete existing values
-	if err := s.repo.BatchDeleteFieldValues(boardID, fieldID); err != nil {
-		return err
-	}
-
-	// Set new values
 	fieldValues := make([]domain.BoardFieldValue, 0, len(userIDs))
 	for i, userIDVal := range userIDs {
 		userIDStr, ok := userIDVal.(string)
 		if !ok {
-			return apperrors.New(apperrors.ErrCodeBadRequest, "잘못된 사용자 ID 형식", 400)
+			return nil, apperrors.New(apperrors.ErrCodeBadRequest, "잘못된 사용자 ID 형식", 400)
 		}
 
 		userID, err := uuid.Parse(userIDStr)
 		if err != nil {
-			return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
 		}
 
-		fieldValues = append(fieldValues, domain.BoardFieldValue{
-			BoardID:      boardID,
-			FieldID:      fieldID,
-			ValueUserID:  &userID,
-			DisplayOrder: i,
-		})
+		val := base
+		val.ValueUserID = &userID
+		val.DisplayOrder = i
+		fieldValues = append(fieldValues, val)
 	}
-
-	return s.repo.BatchSetFieldValues(fieldValues)
+	return fieldValues, nil
 }
 
-func (s *fieldValueService) setCheckboxValue(boardID, fieldID uuid.UUID, value interface{}) error {
+func checkboxFieldValue(val domain.BoardFieldValue, value interface{}) (domain.BoardFieldValue, error) {
 	boolVal, ok := value.(bool)
 	if !ok {
-		return apperrors.New(apperrors.ErrCodeBadRequest, "불린 값이 필요합니다", 400)
+		return val, apperrors.New(apperrors.ErrCodeBadRequest, "불린 값이 필요합니다", 400)
 	}
 
-	val := &domain.BoardFieldValue{
-		BoardID:      boardID,
-		FieldID:      fieldID,
-		ValueBoolean: &boolVal,
-	}
-
-	return s.repo.SetFieldValue(val)
+	val.ValueBoolean = &boolVal
+	return val, nil
 }
 
-func (s *fieldValueService) setURLValue(boardID, fieldID uuid.UUID, value interface{}) error {
+func urlFieldValue(val domain.BoardFieldValue, value interface{}) (domain.BoardFieldValue, error) {
 	urlStr, ok := value.(string)
 	if !ok {
-		return apperrors.New(apperrors.ErrCodeBadRequest, "URL 문자열이 필요합니다", 400)
+		return val, apperrors.New(apperrors.ErrCodeBadRequest, "URL 문자열이 필요합니다", 400)
 	}
 
 	// Validate URL format
 	if _, err := url.ParseRequestURI(urlStr); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "유효하지 않은 URL 형식입니다", 400)
+		return val, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "유효하지 않은 URL 형식입니다", 400)
 	}
 
-	val := &domain.BoardFieldValue{
-		BoardID:   boardID,
-		FieldID:   fieldID,
-		ValueText: &urlStr,
-	}
-
-	return s.repo.SetFieldValue(val)
+	val.ValueText = &urlStr
+	return val, nil
 }
 
-func (s *fieldValueService) updateBoardCache(boardID uuid.UUID) error {
-	// Fetch all field values for the board
-	values, err := s.repo.FindFieldValuesByBoard(boardID)
+// recordFieldValueActivity records the diff between the previous and written values of a field
+// within the current UnitOfWork transaction; unchanged values record nothing
+func recordFieldValueActivity(
+	repos *uow.Repositories,
+	board *domain.Board,
+	field *domain.ProjectField,
+	actorID uuid.UUID,
+	previousValues, values []domain.BoardFieldValue,
+) error {
+	// Written values are compared in display order, as previous values are read
+	after := append([]domain.BoardFieldValue(nil), values...)
+	sort.SliceStable(after, func(i, j int) bool { return after[i].DisplayOrder < after[j].DisplayOrder })
+
+	change := buildFieldValueChange(repos.Field, field, previousValues, after)
+	if change == nil {
+		return nil
+	}
+
+	activity, err := domain.NewBoardActivity(board, actorID, domain.ActivityFieldValueChanged, []domain.ActivityChange{*change})
 	if err != nil {
-		return err
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "활동 기록 생성 실패", 500)
 	}
+	if err := repos.Activity.Create(activity); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "활동 기록 저장 실패", 500)
+	}
+	return nil
+}
 
-	// Build cache map
-	cache := make(map[string]interface{})
-	multiValueFields := make(map[string][]interface{})
+// buildFieldsCache builds a board's custom_fields_cache from all of its field values
+// Fields with several values, or ordered after the first, are cached as arrays.
+func buildFieldsCache(values []domain.BoardFieldValue) map[string]interface{} {
+	counts := make(map[uuid.UUID]int, len(values))
+	for _, val := range values {
+		counts[val.FieldID]++
+	}
 
+	cache := make(map[string]interface{})
 	for _, val := range values {
 		fieldIDStr := val.FieldID.String()
 
@@ -641,43 +800,25 @@ func (s *fieldValueService) updateBoardCache(boardID uuid.UUID) error {
 			actualValue = val.ValueUserID.String()
 		}
 
-		// Check if this is a multi-value field
-		if _, exists := multiValueFields[fieldIDStr]; exists || val.DisplayOrder > 0 {
-			multiValueFields[fieldIDStr] = append(multiValueFields[fieldIDStr], actualValue)
+		// Multi-value fields accumulate as arrays
+		if counts[val.FieldID] > 1 || val.DisplayOrder > 0 {
+			arr, _ := cache[fieldIDStr].([]interface{})
+			cache[fieldIDStr] = append(arr, actualValue)
 		} else {
-			// First value - check if there are more
-			nextValues, _ := s.repo.FindFieldValuesByBoardAndField(boardID, val.FieldID)
-			if len(nextValues) > 1 {
-				multiValueFields[fieldIDStr] = []interface{}{actualValue}
-			} else {
-				cache[fieldIDStr] = actualValue
-			}
+			cache[fieldIDStr] = actualValue
 		}
 	}
+	return cache
+}
 
-	// Merge multi-value fields
-	for fieldID, values := range multiValueFields {
-		cache[fieldID] = values
-	}
-
-	// Serialize to JSON
-	cacheJSON, err := json.Marshal(cache)
-	if err != nil {
-		return err
-	}
-
-	// Update board's custom_fields_cache
-	if err := s.db.Model(&domain.Board{}).
-		Where("id = ?", boardID).
-		Update("custom_fields_cache", string(cacheJSON)).Error; err != nil {
-		return err
-	}
+// requiredFieldError rejects leaving a required field without a value
+func requiredFieldError(field *domain.ProjectField) error {
+	return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("'%s' 필드는 필수입니다", field.Name), 400)
+}
 
-	// Invalidate Redis cache
-	ctx := context.Background()
-	if err := s.cache.InvalidateBoardFieldValues(ctx, boardID.String()); err != nil {
+// invalidateBoardCache drops the board's field values from Redis, so the next read loads them from the database
+func (s *fieldValueService) invalidateBoardCache(boardID uuid.UUID) {
+	if err := s.cache.InvalidateBoardFieldValues(context.Background(), boardID.String()); err != nil {
 		s.logger.Warn("Failed to invalidate board field values cache", zap.Error(err))
 	}
-
-	return nil
 }
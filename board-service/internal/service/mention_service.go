@@ -0,0 +1,326 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/client"
+	"board-service/internal/common/pagination"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type MentionService interface {
+	// RecordMentions parses @mentions in text, resolves them against project members and stores them
+	// Returns the newly mentioned users' mentions (the author is never mentioned) and notifies them
+	RecordMentions(ctx context.Context, boardID uuid.UUID, sourceType domain.MentionSourceType, sourceID, authorID uuid.UUID, text string) ([]domain.Mention, error)
+
+	// Inbox
+	GetMyMentions(userID string, req *dto.GetMentionsRequest) (*dto.MentionInboxResponse, error)
+	MarkAsRead(userID, mentionID string) (*dto.MentionResponse, error)
+	MarkAllAsRead(userID string) (*dto.MarkAllMentionsReadResponse, error)
+}
+
+type mentionService struct {
+	repo          repository.MentionRepository
+	boardRepo     repository.BoardRepository
+	projectRepo   repository.ProjectRepository
+	notifications NotificationService
+	userClient    client.UserClient
+	logger        *zap.Logger
+}
+
+func NewMentionService(
+	repo repository.MentionRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	notificationService NotificationService,
+	userClient client.UserClient,
+	logger *zap.Logger,
+) MentionService {
+	return &mentionService{
+		repo:          repo,
+		boardRepo:     boardRepo,
+		projectRepo:   projectRepo,
+		notifications: notificationService,
+		userClient:    userClient,
+		logger:        logger,
+	}
+}
+
+// ==================== Record Mentions ====================
+
+func (s *mentionService) RecordMentions(
+	ctx context.Context,
+	boardID uuid.UUID,
+	sourceType domain.MentionSourceType,
+	sourceID, authorID uuid.UUID,
+	text string,
+) ([]domain.Mention, error) {
+	// 1. Parse tokens (no DB access when there is nothing to resolve)
+	tokens := parser.ExtractMentions(text)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	// 2. Fetch board and project members
+	board, err := s.boardRepo.FindByID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.projectRepo.FindMembersByProject(board.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Resolve tokens to member user IDs
+	userIDs := s.resolveMentionTokens(ctx, tokens, members)
+
+	// Users already mentioned in this source (e.g. before an edit) are not mentioned again
+	existing, err := s.repo.FindUserIDsBySource(sourceID)
+	if err != nil {
+		return nil, err
+	}
+	alreadyMentioned := make(map[uuid.UUID]bool, len(existing))
+	for _, id := range existing {
+		alreadyMentioned[id] = true
+	}
+
+	mentions := make([]domain.Mention, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if userID == authorID || alreadyMentioned[userID] {
+			continue
+		}
+		mentions = append(mentions, *domain.NewMention(board, sourceType, sourceID, userID, authorID, text))
+	}
+	if len(mentions) == 0 {
+		return nil, nil
+	}
+
+	// 4. Store
+	if err := s.repo.CreateBatch(mentions); err != nil {
+		return nil, err
+	}
+
+	// 5. Notify mentioned users (notification failures do not undo the mentions)
+	notifications := make([]domain.Notification, 0, len(mentions))
+	for i := range mentions {
+		m := &mentions[i]
+		n := domain.NewNotification(m.MentionedUserID, m.ProjectID, authorID, domain.NotificationMentioned, board.Title)
+		n.BoardID = &board.ID
+		n.ResourceID = &m.SourceID
+		n.SetDetail(m.Excerpt)
+		notifications = append(notifications, *n)
+	}
+	if err := s.notifications.Notify(ctx, notifications); err != nil {
+		s.logger.Warn("Failed to notify mentioned users", zap.Error(err))
+	}
+
+	return mentions, nil
+}
+
+// resolveMentionTokens matches tokens against project members by user ID or nickname (case-insensitive)
+// Unknown tokens are ignored; a nickname shared by several members mentions all of them
+func (s *mentionService) resolveMentionTokens(ctx context.Context, tokens []string, members []domain.ProjectMember) []uuid.UUID {
+	memberIDs := make(map[uuid.UUID]bool, len(members))
+	for _, member := range members {
+		memberIDs[member.UserID] = true
+	}
+
+	resolved := make([]uuid.UUID, 0, len(tokens))
+	seen := make(map[uuid.UUID]bool)
+	add := func(id uuid.UUID) {
+		if !seen[id] {
+			seen[id] = true
+			resolved = append(resolved, id)
+		}
+	}
+
+	nicknames := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if id, err := uuid.Parse(token); err == nil {
+			if memberIDs[id] {
+				add(id)
+			}
+			continue
+		}
+		nicknames = append(nicknames, token)
+	}
+
+	if len(nicknames) == 0 {
+		return resolved
+	}
+
+	// Nicknames live in User Service
+	ids := make([]string, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.UserID.String())
+	}
+	users, err := s.userClient.GetUsersBatch(ctx, ids)
+	if err != nil {
+		s.logger.Warn("Failed to fetch members for mention resolution", zap.Error(err))
+		return resolved
+	}
+
+	for _, nickname := range nicknames {
+		for _, user := range users {
+			if user.Name == "" || !strings.EqualFold(user.Name, nickname) {
+				continue
+			}
+			if id, err := uuid.Parse(user.UserID); err == nil && memberIDs[id] {
+				add(id)
+			}
+		}
+	}
+
+	return resolved
+}
+
+// ==================== Mention Inbox ====================
+
+func (s *mentionService) GetMyMentions(userID string, req *dto.GetMentionsRequest) (*dto.MentionInboxResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
+
+	mentions, total, err := s.repo.FindByUser(userUUID, req.Unread, page, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멘션 조회 실패", 500)
+	}
+
+	unreadCount, err := s.repo.CountUnread(userUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "읽지 않은 멘션 수 조회 실패", 500)
+	}
+
+	// Batch fetch authors
+	authorIDs := make([]string, 0, len(mentions))
+	for _, m := range mentions {
+		authorIDs = append(authorIDs, m.MentionedBy.String())
+	}
+	userMap := s.getUserInfoMap(authorIDs)
+
+	responses := make([]dto.MentionResponse, 0, len(mentions))
+	for i := range mentions {
+		responses = append(responses, toMentionResponse(&mentions[i], userMap))
+	}
+
+	return &dto.MentionInboxResponse{
+		Mentions:    responses,
+		Total:       total,
+		UnreadCount: unreadCount,
+		Page:        page,
+		Limit:       limit,
+	}, nil
+}
+
+func (s *mentionService) MarkAsRead(userID, mentionID string) (*dto.MentionResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	mentionUUID, err := parser.ParseUUID(mentionID, "멘션")
+	if err != nil {
+		return nil, err
+	}
+
+	mention, err := s.repo.FindByID(mentionUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "멘션을 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멘션 조회 실패", 500)
+	}
+
+	// Other users' mentions are reported as not found
+	if !mention.IsFor(userUUID) {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "멘션을 찾을 수 없습니다", 404)
+	}
+
+	if !mention.IsRead {
+		mention.MarkAsRead()
+		if err := s.repo.Update(mention); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멘션 읽음 처리 실패", 500)
+		}
+	}
+
+	userMap := s.getUserInfoMap([]string{mention.MentionedBy.String()})
+	response := toMentionResponse(mention, userMap)
+	return &response, nil
+}
+
+func (s *mentionService) MarkAllAsRead(userID string) (*dto.MarkAllMentionsReadResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.MarkAllAsRead(userUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멘션 읽음 처리 실패", 500)
+	}
+
+	return &dto.MarkAllMentionsReadResponse{Updated: updated}, nil
+}
+
+// ==================== Helper Methods ====================
+
+func (s *mentionService) getUserInfoMap(userIDs []string) map[string]client.UserInfo {
+	userMap := make(map[string]client.UserInfo)
+	if len(userIDs) == 0 {
+		return userMap
+	}
+
+	users, err := s.userClient.GetUsersBatch(context.Background(), userIDs)
+	if err != nil {
+		s.logger.Warn("Failed to fetch users from User Service", zap.Error(err))
+		return userMap
+	}
+	for _, user := range users {
+		userMap[user.UserID] = user
+	}
+	return userMap
+}
+
+func toMentionResponse(m *domain.Mention, userMap map[string]client.UserInfo) dto.MentionResponse {
+	response := dto.MentionResponse{
+		MentionID:  m.ID.String(),
+		ProjectID:  m.ProjectID.String(),
+		BoardID:    m.BoardID.String(),
+		SourceType: string(m.SourceType),
+		SourceID:   m.SourceID.String(),
+		Excerpt:    m.Excerpt,
+		IsRead:     m.IsRead,
+		ReadAt:     m.ReadAt,
+		CreatedAt:  m.CreatedAt,
+	}
+
+	if author, ok := userMap[m.MentionedBy.String()]; ok {
+		response.MentionedBy = dto.UserInfo{
+			UserID:   author.UserID,
+			Name:     author.Name,
+			Email:    author.Email,
+			IsActive: author.IsActive,
+		}
+	} else {
+		// Fallback if user not found
+		response.MentionedBy = dto.UserInfo{
+			UserID: m.MentionedBy.String(),
+			Name:   "Unknown User",
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,348 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/client"
+	"board-service/internal/common/pagination"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type NotificationService interface {
+	// Notify stores notifications after dropping self-notifications and types disabled by the recipient
+	Notify(ctx context.Context, notifications []domain.Notification) error
+
+	// Inbox
+	GetMyNotifications(userID string, req *dto.GetNotificationsRequest) (*dto.NotificationListResponse, error)
+	MarkAsRead(userID, notificationID string) (*dto.NotificationResponse, error)
+	MarkAllAsRead(userID string) (*dto.MarkAllNotificationsReadResponse, error)
+
+	// Preferences (per user, per project)
+	GetPreference(userID, projectID string) (*dto.NotificationPreferenceResponse, error)
+	UpdatePreference(userID, projectID string, req *dto.UpdateNotificationPreferenceRequest) (*dto.NotificationPreferenceResponse, error)
+}
+
+type notificationService struct {
+	repo        repository.NotificationRepository
+	projectRepo repository.ProjectRepository
+	userClient  client.UserClient
+	logger      *zap.Logger
+}
+
+func NewNotificationService(
+	repo repository.NotificationRepository,
+	projectRepo repository.ProjectRepository,
+	userClient client.UserClient,
+	logger *zap.Logger,
+) NotificationService {
+	return &notificationService{
+		repo:        repo,
+		projectRepo: projectRepo,
+		userClient:  userClient,
+		logger:      logger,
+	}
+}
+
+// ==================== Notify ====================
+
+func (s *notificationService) Notify(ctx context.Context, notifications []domain.Notification) error {
+	// 1. Drop self-notifications (users are never notified about their own actions)
+	type prefKey struct{ userID, projectID uuid.UUID }
+	pending := make([]domain.Notification, 0, len(notifications))
+	recipientsByProject := make(map[uuid.UUID][]uuid.UUID)
+	seen := make(map[prefKey]bool)
+	for _, n := range notifications {
+		if n.UserID == n.ActorID {
+			continue
+		}
+		pending = append(pending, n)
+		if key := (prefKey{n.UserID, n.ProjectID}); !seen[key] {
+			seen[key] = true
+			recipientsByProject[n.ProjectID] = append(recipientsByProject[n.ProjectID], n.UserID)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// 2. Load recipients' preferences (missing preference = everything enabled)
+	preferences := make(map[prefKey]*domain.NotificationPreference)
+	for projectID, userIDs := range recipientsByProject {
+		prefs, err := s.repo.FindPreferencesByUsers(userIDs, projectID)
+		if err != nil {
+			return err
+		}
+		for i := range prefs {
+			preferences[prefKey{prefs[i].UserID, prefs[i].ProjectID}] = &prefs[i]
+		}
+	}
+
+	allowed := make([]domain.Notification, 0, len(pending))
+	for _, n := range pending {
+		if pref, ok := preferences[prefKey{n.UserID, n.ProjectID}]; ok && !pref.Allows(n.Type) {
+			continue
+		}
+		allowed = append(allowed, n)
+	}
+
+	// 3. Store
+	return s.repo.CreateBatch(allowed)
+}
+
+// ==================== Notification Inbox ====================
+
+func (s *notificationService) GetMyNotifications(userID string, req *dto.GetNotificationsRequest) (*dto.NotificationListResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
+
+	notifications, total, err := s.repo.FindByUser(userUUID, req.Unread, page, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "알림 조회 실패", 500)
+	}
+
+	unreadCount, err := s.repo.CountUnread(userUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "읽지 않은 알림 수 조회 실패", 500)
+	}
+
+	// Batch fetch actors
+	actorIDs := make([]string, 0, len(notifications))
+	for _, n := range notifications {
+		actorIDs = append(actorIDs, n.ActorID.String())
+	}
+	userMap := s.getUserInfoMap(actorIDs)
+
+	responses := make([]dto.NotificationResponse, 0, len(notifications))
+	for i := range notifications {
+		responses = append(responses, toNotificationResponse(&notifications[i], userMap))
+	}
+
+	return &dto.NotificationListResponse{
+		Notifications: responses,
+		Total:         total,
+		UnreadCount:   unreadCount,
+		Page:          page,
+		Limit:         limit,
+	}, nil
+}
+
+func (s *notificationService) MarkAsRead(userID, notificationID string) (*dto.NotificationResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationUUID, err := parser.ParseUUID(notificationID, "알림")
+	if err != nil {
+		return nil, err
+	}
+
+	notification, err := s.repo.FindByID(notificationUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "알림을 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "알림 조회 실패", 500)
+	}
+
+	// Other users' notifications are reported as not found
+	if !notification.IsFor(userUUID) {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "알림을 찾을 수 없습니다", 404)
+	}
+
+	if !notification.IsRead {
+		notification.MarkAsRead()
+		if err := s.repo.Update(notification); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "알림 읽음 처리 실패", 500)
+		}
+	}
+
+	userMap := s.getUserInfoMap([]string{notification.ActorID.String()})
+	response := toNotificationResponse(notification, userMap)
+	return &response, nil
+}
+
+func (s *notificationService) MarkAllAsRead(userID string) (*dto.MarkAllNotificationsReadResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.MarkAllAsRead(userUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "알림 읽음 처리 실패", 500)
+	}
+
+	return &dto.MarkAllNotificationsReadResponse{Updated: updated}, nil
+}
+
+// ==================== Notification Preferences ====================
+
+func (s *notificationService) GetPreference(userID, projectID string) (*dto.NotificationPreferenceResponse, error) {
+	userUUID, projectUUID, err := s.parseMemberIDs(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	preference, err := s.findPreference(userUUID, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toNotificationPreferenceResponse(preference), nil
+}
+
+func (s *notificationService) UpdatePreference(userID, projectID string, req *dto.UpdateNotificationPreferenceRequest) (*dto.NotificationPreferenceResponse, error) {
+	userUUID, projectUUID, err := s.parseMemberIDs(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	preference, err := s.findPreference(userUUID, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Partial update: only provided fields are changed
+	if req.Assignments != nil {
+		preference.Assignments = *req.Assignments
+	}
+	if req.DueDates != nil {
+		preference.DueDates = *req.DueDates
+	}
+	if req.Comments != nil {
+		preference.Comments = *req.Comments
+	}
+	if req.Mentions != nil {
+		preference.Mentions = *req.Mentions
+	}
+	if req.JoinRequests != nil {
+		preference.JoinRequests = *req.JoinRequests
+	}
+	if req.RoleChanges != nil {
+		preference.RoleChanges = *req.RoleChanges
+	}
+
+	if err := s.repo.SavePreference(preference); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "알림 설정 저장 실패", 500)
+	}
+
+	return toNotificationPreferenceResponse(preference), nil
+}
+
+// ==================== Helper Methods ====================
+
+// parseMemberIDs parses the IDs and checks that the user is a member of the project
+func (s *notificationService) parseMemberIDs(userID, projectID string) (uuid.UUID, uuid.UUID, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	_, err = s.projectRepo.FindMemberByUserAndProject(userUUID, projectUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, uuid.Nil, apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
+		}
+		return uuid.Nil, uuid.Nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
+	}
+
+	return userUUID, projectUUID, nil
+}
+
+// findPreference returns the stored preference or the default one if the user has not configured the project
+func (s *notificationService) findPreference(userID, projectID uuid.UUID) (*domain.NotificationPreference, error) {
+	preference, err := s.repo.FindPreference(userID, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.DefaultNotificationPreference(userID, projectID), nil
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "알림 설정 조회 실패", 500)
+	}
+	return preference, nil
+}
+
+func (s *notificationService) getUserInfoMap(userIDs []string) map[string]client.UserInfo {
+	userMap := make(map[string]client.UserInfo)
+	if len(userIDs) == 0 {
+		return userMap
+	}
+
+	users, err := s.userClient.GetUsersBatch(context.Background(), userIDs)
+	if err != nil {
+		s.logger.Warn("Failed to fetch users from User Service", zap.Error(err))
+		return userMap
+	}
+	for _, user := range users {
+		userMap[user.UserID] = user
+	}
+	return userMap
+}
+
+func toNotificationResponse(n *domain.Notification, userMap map[string]client.UserInfo) dto.NotificationResponse {
+	response := dto.NotificationResponse{
+		NotificationID: n.ID.String(),
+		ProjectID:      n.ProjectID.String(),
+		Type:           string(n.Type),
+		Title:          n.Title,
+		Detail:         n.Detail,
+		IsRead:         n.IsRead,
+		ReadAt:         n.ReadAt,
+		CreatedAt:      n.CreatedAt,
+	}
+
+	if n.BoardID != nil {
+		boardID := n.BoardID.String()
+		response.BoardID = &boardID
+	}
+	if n.ResourceID != nil {
+		resourceID := n.ResourceID.String()
+		response.ResourceID = &resourceID
+	}
+
+	if actor, ok := userMap[n.ActorID.String()]; ok {
+		response.Actor = dto.UserInfo{
+			UserID:   actor.UserID,
+			Name:     actor.Name,
+			Email:    actor.Email,
+			IsActive: actor.IsActive,
+		}
+	} else {
+		// Fallback if user not found
+		response.Actor = dto.UserInfo{
+			UserID: n.ActorID.String(),
+			Name:   "Unknown User",
+		}
+	}
+
+	return response
+}
+
+func toNotificationPreferenceResponse(p *domain.NotificationPreference) *dto.NotificationPreferenceResponse {
+	return &dto.NotificationPreferenceResponse{
+		ProjectID:    p.ProjectID.String(),
+		Assignments:  p.Assignments,
+		DueDates:     p.DueDates,
+		Comments:     p.Comments,
+		Mentions:     p.Mentions,
+		JoinRequests: p.JoinRequests,
+		RoleChanges:  p.RoleChanges,
+	}
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/testutil"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestNotificationService_Notify_FiltersSelfAndDisabledTypes(t *testing.T) {
+	repo := new(testutil.MockNotificationRepository)
+	svc := NewNotificationService(repo, nil, nil, zap.NewNop())
+
+	// Given: actor, a user who muted comments, and a user without preferences
+	projectID := uuid.New()
+	actorID := uuid.New()
+	mutedID := uuid.New()
+	defaultID := uuid.New()
+
+	muted := domain.DefaultNotificationPreference(mutedID, projectID)
+	muted.Comments = false
+
+	notifications := []domain.Notification{
+		*domain.NewNotification(actorID, projectID, actorID, domain.NotificationCommentCreated, "Board"),
+		*domain.NewNotification(mutedID, projectID, actorID, domain.NotificationCommentCreated, "Board"),
+		*domain.NewNotification(mutedID, projectID, actorID, domain.NotificationMentioned, "Board"),
+		*domain.NewNotification(defaultID, projectID, actorID, domain.NotificationCommentCreated, "Board"),
+	}
+
+	repo.On("FindPreferencesByUsers", []uuid.UUID{mutedID, defaultID}, projectID).
+		Return([]domain.NotificationPreference{*muted}, nil)
+	repo.On("CreateBatch", mock.MatchedBy(func(stored []domain.Notification) bool {
+		return len(stored) == 2 &&
+			stored[0].UserID == mutedID && stored[0].Type == domain.NotificationMentioned &&
+			stored[1].UserID == defaultID && stored[1].Type == domain.NotificationCommentCreated
+	})).Return(nil)
+
+	// When
+	err := svc.Notify(context.Background(), notifications)
+
+	// Then: self-notification and muted comment are dropped
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestNotificationService_Notify_OnlySelfSkipsRepository(t *testing.T) {
+	repo := new(testutil.MockNotificationRepository)
+	svc := NewNotificationService(repo, nil, nil, zap.NewNop())
+
+	actorID := uuid.New()
+	n := domain.NewNotification(actorID, uuid.New(), actorID, domain.NotificationBoardAssigned, "Board")
+
+	err := svc.Notify(context.Background(), []domain.Notification{*n})
+
+	assert.NoError(t, err)
+	repo.AssertNotCalled(t, "FindPreferencesByUsers", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "CreateBatch", mock.Anything)
+}
+
+func TestNewBoardChangeNotification(t *testing.T) {
+	assignee := uuid.New()
+	other := uuid.New()
+	actor := uuid.New()
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	later := due.AddDate(0, 0, 3)
+
+	board := func(assigneeID *uuid.UUID, dueDate *time.Time) *domain.Board {
+		return &domain.Board{
+			BaseModel:  domain.BaseModel{ID: uuid.New()},
+			ProjectID:  uuid.New(),
+			Title:      "Board",
+			AssigneeID: assigneeID,
+			DueDate:    dueDate,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		before       *domain.Board
+		after        *domain.Board
+		expectedType domain.NotificationType
+		expectedUser uuid.UUID
+	}{
+		{"created with assignee", nil, board(&assignee, nil), domain.NotificationBoardAssigned, assignee},
+		{"created without assignee", nil, board(nil, nil), "", uuid.Nil},
+		{"reassigned", board(&other, &due), board(&assignee, &due), domain.NotificationBoardAssigned, assignee},
+		{"due date changed", board(&assignee, &due), board(&assignee, &later), domain.NotificationBoardDueDateChanged, assignee},
+		{"nothing relevant changed", board(&assignee, &due), board(&assignee, &due), "", uuid.Nil},
+		{"unassigned", board(&assignee, &due), board(nil, &due), "", uuid.Nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := domain.NewBoardChangeNotification(tt.before, tt.after, actor)
+			if tt.expectedType == "" {
+				assert.Nil(t, n)
+				return
+			}
+			assert.NotNil(t, n)
+			assert.Equal(t, tt.expectedType, n.Type)
+			assert.Equal(t, tt.expectedUser, n.UserID)
+			assert.Equal(t, tt.after.ID, *n.BoardID)
+		})
+	}
+}
@@ -52,6 +52,22 @@ func (m *MockProjectFieldRepository) FindByIDs(ids []uuid.UUID) ([]domain.Projec
 	return args.Get(0).([]domain.ProjectField), args.Error(1)
 }
 
+func (m *MockProjectFieldRepository) FindByIDForUpdate(id uuid.UUID) (*domain.ProjectField, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ProjectField), args.Error(1)
+}
+
+func (m *MockProjectFieldRepository) FindByIDForShare(id uuid.UUID) (*domain.ProjectField, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ProjectField), args.Error(1)
+}
+
 func (m *MockProjectFieldRepository) UpdateOrder(fieldID uuid.UUID, newOrder int) error {
 	args := m.Called(fieldID, newOrder)
 	return args.Error(0)
@@ -173,6 +189,24 @@ func (m *MockViewRepository) FindDefault(projectID uuid.UUID) (*domain.SavedView
 	return args.Get(0).(*domain.SavedView), args.Error(1)
 }
 
+func (m *MockViewRepository) FindUserSettings(viewID, userID uuid.UUID) (*domain.SavedViewUserSettings, error) {
+	args := m.Called(viewID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SavedViewUserSettings), args.Error(1)
+}
+
+func (m *MockViewRepository) SaveUserSettings(settings *domain.SavedViewUserSettings) error {
+	args := m.Called(settings)
+	return args.Error(0)
+}
+
+func (m *MockViewRepository) DeleteUserSettings(viewID, userID uuid.UUID) error {
+	args := m.Called(viewID, userID)
+	return args.Error(0)
+}
+
 // ==================== GetProjectInitSettings Tests ====================
 
 func TestProjectService_GetProjectInitSettings_Success(t *testing.T) {
@@ -199,6 +233,8 @@ func TestProjectService_GetProjectInitSettings_Success(t *testing.T) {
 		fieldOptionRepo,
 		boardOrderRepo,
 		viewRepo,
+		nil, // notificationService
+		nil, // eventPublisher
 		userClient,
 		workspaceCache,
 		userInfoCache,
@@ -311,7 +347,7 @@ func TestProjectService_GetProjectInitSettings_ProjectNotFound(t *testing.T) {
 
 	service := NewProjectService(
 		projectRepo,
-		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 		logger,
 		nil,
 	)
@@ -337,7 +373,7 @@ func TestProjectService_GetProjectInitSettings_NotProjectMember(t *testing.T) {
 
 	service := NewProjectService(
 		projectRepo,
-		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 		logger,
 		nil,
 	)
@@ -6,11 +6,11 @@ import (
 	"board-service/internal/client"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
+	"board-service/internal/realtime"
 	"board-service/internal/repository"
 	"context"
 	"encoding/json"
 	"errors"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -49,6 +49,8 @@ type projectService struct {
 	fieldOptionRepo  repository.FieldOptionRepository
 	boardOrderRepo   repository.BoardOrderRepository
 	viewRepo         repository.ViewRepository
+	notifications    NotificationService
+	events           realtime.Publisher // Member events (SSE stream, webhooks)
 	userClient       client.UserClient
 	workspaceCache   cache.WorkspaceCache
 	userInfoCache    cache.UserInfoCache
@@ -65,6 +67,8 @@ func NewProjectService(
 	fieldOptionRepo repository.FieldOptionRepository,
 	boardOrderRepo repository.BoardOrderRepository,
 	viewRepo repository.ViewRepository,
+	notificationService NotificationService,
+	eventPublisher realtime.Publisher,
 	userClient client.UserClient,
 	workspaceCache cache.WorkspaceCache,
 	userInfoCache cache.UserInfoCache,
@@ -80,6 +84,8 @@ func NewProjectService(
 		fieldOptionRepo:  fieldOptionRepo,
 		boardOrderRepo:   boardOrderRepo,
 		viewRepo:         viewRepo,
+		notifications:    notificationService,
+		events:           eventPublisher,
 		userClient:       userClient,
 		workspaceCache:   workspaceCache,
 		userInfoCache:    userInfoCache,
@@ -116,6 +122,8 @@ func (s *projectService) CreateProject(userID string, token string, req *dto.Cre
 	// Create project and member in transaction
 	var project *domain.Project
 	err = s.db.Transaction(func(tx *gorm.DB) error {
+		txRepo := repository.NewProjectRepository(tx)
+
 		// Create project
 		project = &domain.Project{
 			WorkspaceID: workspaceUUID,
@@ -123,7 +131,7 @@ func (s *projectService) CreateProject(userID string, token string, req *dto.Cre
 			Description: req.Description,
 			OwnerID:     userUUID,
 		}
-		if err := s.repo.Create(project); err != nil {
+		if err := txRepo.Create(project); err != nil {
 			return err
 		}
 
@@ -134,7 +142,7 @@ func (s *projectService) CreateProject(userID string, token string, req *dto.Cre
 			RoleID:    ownerRole.ID,
 			JoinedAt:  time.Now(),
 		}
-		if err := s.repo.CreateMember(member); err != nil {
+		if err := txRepo.CreateMember(member); err != nil {
 			return err
 		}
 
@@ -144,7 +152,12 @@ func (s *projectService) CreateProject(userID string, token string, req *dto.Cre
 			return err
 		}
 
-		return nil
+		// Record project.created in the outbox (published by the outbox relay after commit)
+		event, err := domain.NewDomainEvent(domain.AggregateProject, project.ID, project.ID, userUUID, domain.EventTypeProjectCreated, project)
+		if err != nil {
+			return err
+		}
+		return repository.NewDomainEventRepository(tx).Create(event)
 	})
 
 	if err != nil {
@@ -507,28 +520,55 @@ func (s *projectService) UpdateJoinRequest(requestID, userID string, req *dto.Up
 	joinReq.Status = domain.ProjectJoinRequestStatus(req.Status)
 
 	// If approved, create member
+	var addedMember *domain.ProjectMember
+	var memberResponse *dto.ProjectMemberResponse
 	if req.Status == "APPROVED" {
 		memberRole, err := s.roleRepo.FindByName("MEMBER")
 		if err != nil {
 			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "권한 조회 실패", 500)
 		}
 
-		member := &domain.ProjectMember{
+		// The ID is set up front so the event payload can be built outside the transaction
+		addedMember = &domain.ProjectMember{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
 			ProjectID: joinReq.ProjectID,
 			UserID:    joinReq.UserID,
 			RoleID:    memberRole.ID,
 			JoinedAt:  time.Now(),
 		}
+		memberResponse, err = s.toMemberResponse(addedMember)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "권한 조회 실패", 500)
+		}
+	}
 
-		if err := s.repo.CreateMember(member); err != nil {
-			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 생성 실패", 500)
+	// The member, the decision and the member.added event are saved together
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		txRepo := repository.NewProjectRepository(tx)
+		if addedMember != nil {
+			if err := txRepo.CreateMember(addedMember); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 생성 실패", 500)
+			}
+			if err := recordEvent(repository.NewDomainEventRepository(tx), realtime.EventMemberAdded, joinReq.ProjectID, uuid.Nil, userUUID, memberResponse); err != nil {
+				return err
+			}
+		}
+		if err := txRepo.UpdateJoinRequest(joinReq); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "참여 신청 처리 실패", 500)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.repo.UpdateJoinRequest(joinReq); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "참여 신청 처리 실패", 500)
+	if addedMember != nil {
+		publishEvent(s.events, s.logger, realtime.EventMemberAdded, joinReq.ProjectID, uuid.Nil, userUUID, memberResponse)
 	}
 
+	// Notify requester about the decision
+	s.notifyMember(joinReq.UserID, joinReq.ProjectID, userUUID, domain.NotificationJoinRequestDecided, joinReq.ID, string(joinReq.Status))
+
 	return s.toJoinRequestResponse(joinReq)
 }
 
@@ -638,11 +678,26 @@ func (s *projectService) UpdateMemberRole(projectID, memberID, requestUserID str
 	}
 
 	member.RoleID = newRole.ID
-	if err := s.repo.UpdateMember(member); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 권한 수정 실패", 500)
+	response, err := s.toMemberResponse(member)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := repository.NewProjectRepository(tx).UpdateMember(member); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 권한 수정 실패", 500)
+		}
+		return recordEvent(repository.NewDomainEventRepository(tx), realtime.EventMemberRoleChanged, projUUID, uuid.Nil, reqUserUUID, response)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return s.toMemberResponse(member)
+	// Notify member about the new role
+	s.notifyMember(member.UserID, projUUID, reqUserUUID, domain.NotificationMemberRoleChanged, member.ID, newRole.Name)
+
+	publishEvent(s.events, s.logger, realtime.EventMemberRoleChanged, projUUID, uuid.Nil, reqUserUUID, response)
+	return response, nil
 }
 
 // RemoveMember removes a member from project
@@ -686,10 +741,23 @@ func (s *projectService) RemoveMember(projectID, memberID, requestUserID string)
 		return apperrors.New(apperrors.ErrCodeBadRequest, "OWNER는 삭제할 수 없습니다", 400)
 	}
 
-	if err := s.repo.DeleteMember(memberUUID); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 삭제 실패", 500)
+	data := &dto.ProjectMemberResponse{
+		ID:        member.ID.String(),
+		ProjectID: member.ProjectID.String(),
+		UserID:    member.UserID.String(),
+		JoinedAt:  member.JoinedAt,
+	}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := repository.NewProjectRepository(tx).DeleteMember(memberUUID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 삭제 실패", 500)
+		}
+		return recordEvent(repository.NewDomainEventRepository(tx), realtime.EventMemberRemoved, projUUID, uuid.Nil, reqUserUUID, data)
+	})
+	if err != nil {
+		return err
 	}
 
+	publishEvent(s.events, s.logger, realtime.EventMemberRemoved, projUUID, uuid.Nil, reqUserUUID, data)
 	return nil
 }
 
@@ -874,6 +942,26 @@ func (s *projectService) getUserInfoBatch(ctx context.Context, userIDs []string)
 	return userMap
 }
 
+// notifyMember sends a project-level notification, titled with the project name, to a single user
+func (s *projectService) notifyMember(userID, projectID, actorID uuid.UUID, notificationType domain.NotificationType, resourceID uuid.UUID, detail string) {
+	// Project name is used as the notification title
+	title := ""
+	if project, err := s.repo.FindByID(projectID); err == nil {
+		title = project.Name
+	}
+
+	n := domain.NewNotification(userID, projectID, actorID, notificationType, title)
+	n.ResourceID = &resourceID
+	n.SetDetail(detail)
+
+	if err := s.notifications.Notify(context.Background(), []domain.Notification{*n}); err != nil {
+		s.logger.Warn("Failed to send project notification",
+			zap.String("project_id", projectID.String()),
+			zap.String("type", string(notificationType)),
+			zap.Error(err))
+	}
+}
+
 func (s *projectService) checkProjectOwnerPermission(userID, projectID uuid.UUID) error {
 	member, err := s.repo.FindMemberByUserAndProject(userID, projectID)
 	if err != nil {
@@ -1179,12 +1267,6 @@ func (s *projectService) GetProjectInitSettings(projectID, userID string) (*dto.
 			config = make(map[string]interface{})
 		}
 
-		// Parse canEditRoles
-		var canEditRoles []string
-		if field.CanEditRoles != nil && *field.CanEditRoles != "" {
-			canEditRoles = strings.Split(*field.CanEditRoles, ",")
-		}
-
 		fieldsWithOptions = append(fieldsWithOptions, dto.FieldWithOptionsResponse{
 			FieldID:         field.ID.String(),
 			ProjectID:       field.ProjectID.String(),
@@ -1195,7 +1277,7 @@ func (s *projectService) GetProjectInitSettings(projectID, userID string) (*dto.
 			IsRequired:      field.IsRequired,
 			IsSystemDefault: field.IsSystemDefault,
 			Config:          config,
-			CanEditRoles:    canEditRoles,
+			CanEditRoles:    field.EditRoles(),
 			Options:         optionResponses,
 			CreatedAt:       field.CreatedAt,
 			UpdatedAt:       field.UpdatedAt,
@@ -47,6 +47,8 @@ func setupProjectServiceTest(t *testing.T) *ProjectServiceTestSuite {
 		nil, // fieldOptionRepo
 		nil, // boardOrderRepo
 		nil, // viewRepo
+		nil, // notificationService - not reached by these tests
+		nil, // eventPublisher - not reached by these tests
 		userClient,
 		workspaceCache,
 		userInfoCache,
@@ -0,0 +1,448 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ==================== Calendar View ====================
+// A calendar places the view's boards on the day of their date: Board.DueDate or a date/datetime field.
+// With a start (Board.StartDate or another date field), a board spans every day from its start to its date.
+// Times are bucketed in the requested time zone; date fields hold calendar dates and keep their own date
+// in every time zone.
+// Dragging a board writes the new dates through BoardService and FieldValueService, so they are
+// validated (and recorded) the same way as any other edit.
+
+const (
+	calendarDateLayout = "2006-01-02"
+	maxCalendarDays    = 62   // Days of one calendar request (two months)
+	maxCalendarBoards  = 1000 // Boards of one calendar request; more are reported as truncated
+)
+
+// calendarBuiltInDates are the board columns a calendar can use
+var calendarBuiltInDates = map[string]bool{
+	"due_date":   true,
+	"start_date": true,
+}
+
+// calendarSource is a date a calendar reads from boards
+type calendarSource struct {
+	key    string
+	target filterTarget
+	field  *domain.ProjectField // nil for board columns
+}
+
+// calendarSpan is the days a board covers, as dates at UTC midnight
+type calendarSpan struct {
+	boardID string
+	first   time.Time
+	last    time.Time
+}
+
+// resolveCalendarSources validates the calendar date and the optional start field against the project fields
+func resolveCalendarSources(dateKey, startKey string, fields []domain.ProjectField) (*calendarSource, *calendarSource, error) {
+	byID := fieldsByID(fields)
+	date, err := resolveCalendarSource(dateKey, byID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if startKey == "" {
+		return date, nil, nil
+	}
+
+	start, err := resolveCalendarSource(startKey, byID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if start.key == date.key {
+		return nil, nil, calendarError("시작 필드는 날짜 필드와 달라야 합니다")
+	}
+	return date, start, nil
+}
+
+func resolveCalendarSource(key string, byID map[string]*domain.ProjectField) (*calendarSource, error) {
+	if calendarBuiltInDates[key] {
+		return &calendarSource{key: key, target: builtInFilterTargets[key]}, nil
+	}
+	if _, err := uuid.Parse(key); err != nil {
+		return nil, calendarError(fmt.Sprintf("캘린더에 사용할 수 없는 필드입니다: %s", key))
+	}
+
+	field := byID[key]
+	if field == nil {
+		return nil, calendarError(fmt.Sprintf("캘린더 필드를 찾을 수 없습니다: %s", key))
+	}
+	if field.FieldType != domain.FieldTypeDate && field.FieldType != domain.FieldTypeDateTime {
+		return nil, calendarError(fmt.Sprintf("날짜 필드만 캘린더에 사용할 수 있습니다: %s", field.Name))
+	}
+	return &calendarSource{key: key, target: filterTarget{key: key, kind: filterKindTime, field: key}, field: field}, nil
+}
+
+// dateOnly reports whether the source holds calendar dates rather than points in time
+func (c *calendarSource) dateOnly() bool {
+	return c.field != nil && c.field.FieldType == domain.FieldTypeDate
+}
+
+// value reads the source's time from a board; customFields is its parsed custom_fields_cache
+func (c *calendarSource) value(board *domain.Board, customFields map[string]interface{}) *time.Time {
+	switch {
+	case c.key == "start_date":
+		return board.StartDate
+	case c.field == nil:
+		return board.DueDate
+	}
+	raw, ok := customFields[c.key].(string)
+	if !ok {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// day is the calendar day of t
+func (c *calendarSource) day(t time.Time, loc *time.Location) time.Time {
+	if !c.dateOnly() {
+		t = t.In(loc)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// shift moves t by days, keeping its time of day in loc
+func (c *calendarSource) shift(t time.Time, days int, loc *time.Location) time.Time {
+	if !c.dateOnly() {
+		t = t.In(loc)
+	}
+	return t.AddDate(0, 0, days)
+}
+
+// boardSpan returns the days a board covers; false when it has neither date
+// A board with only one of the dates covers that day, and a start after the date is ignored.
+func boardSpan(board *domain.Board, customFields map[string]interface{}, date, start *calendarSource, loc *time.Location) (calendarSpan, bool) {
+	var first, last *time.Time
+	if t := date.value(board, customFields); t != nil {
+		day := date.day(*t, loc)
+		last = &day
+	}
+	if start != nil {
+		if t := start.value(board, customFields); t != nil {
+			day := start.day(*t, loc)
+			first = &day
+		}
+	}
+
+	switch {
+	case first == nil && last == nil:
+		return calendarSpan{}, false
+	case last == nil:
+		last = first
+	case first == nil || first.After(*last):
+		first = last
+	}
+	return calendarSpan{boardID: board.ID.String(), first: *first, last: *last}, true
+}
+
+// placeOnCalendar lists every day from from to to with the spans covering it, in span order
+func placeOnCalendar(from, to time.Time, spans []calendarSpan) []dto.CalendarDay {
+	days := make([]dto.CalendarDay, 0, daysBetween(from, to)+1)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		days = append(days, dto.CalendarDay{Date: day.Format(calendarDateLayout), Entries: []dto.CalendarEntry{}})
+	}
+
+	for _, span := range spans {
+		first, last := span.first, span.last
+		if first.Before(from) {
+			first = from
+		}
+		if last.After(to) {
+			last = to
+		}
+		for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+			i := daysBetween(from, day)
+			days[i].Entries = append(days[i].Entries, dto.CalendarEntry{
+				BoardID: span.boardID,
+				Start:   span.first.Format(calendarDateLayout),
+				End:     span.last.Format(calendarDateLayout),
+				IsStart: day.Equal(span.first),
+				IsEnd:   day.Equal(span.last),
+			})
+		}
+	}
+	return days
+}
+
+// daysBetween counts the days between two dates at UTC midnight
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// parseDateRange parses an inclusive range of YYYY-MM-DD dates of at most maxDays days
+func parseDateRange(fromDate, toDate string, maxDays int) (time.Time, time.Time, error) {
+	from, err := time.Parse(calendarDateLayout, fromDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, apperrors.New(apperrors.ErrCodeValidation, "from은 YYYY-MM-DD 형식이어야 합니다", 400)
+	}
+	to, err := time.Parse(calendarDateLayout, toDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, apperrors.New(apperrors.ErrCodeValidation, "to는 YYYY-MM-DD 형식이어야 합니다", 400)
+	}
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, apperrors.New(apperrors.ErrCodeValidation, "to는 from보다 이전일 수 없습니다", 400)
+	}
+	if daysBetween(from, to) >= maxDays {
+		return time.Time{}, time.Time{}, apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("한 번에 최대 %d일까지 조회할 수 있습니다", maxDays), 400)
+	}
+	return from, to, nil
+}
+
+// loadTimeZone loads an IANA time zone; the default is UTC
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("알 수 없는 시간대입니다: %s", name), 400)
+	}
+	return loc, nil
+}
+
+// parseCustomFields parses a board's custom_fields_cache; unreadable caches read as empty
+func parseCustomFields(board *domain.Board) map[string]interface{} {
+	var customFields map[string]interface{}
+	if board.CustomFieldsCache != "" && board.CustomFieldsCache != "{}" {
+		_ = json.Unmarshal([]byte(board.CustomFieldsCache), &customFields)
+	}
+	return customFields
+}
+
+// GetCalendar returns the view's boards on the days from req.From to req.To
+func (s *viewService) GetCalendar(userID, viewID string, req *dto.GetCalendarRequest) (*dto.CalendarResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	viewUUID, err := uuid.Parse(viewID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 뷰 ID", 400)
+	}
+
+	from, to, err := parseDateRange(req.From, req.To, maxCalendarDays)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := loadTimeZone(req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := s.findAccessibleView(userUUID, viewUUID)
+	if err != nil {
+		return nil, err
+	}
+	filters, sorts := s.viewSettings(view)
+	q, err := s.prepareViewQuery(userUUID, view.ProjectID, viewUUID, filters, sorts)
+	if err != nil {
+		return nil, err
+	}
+	date, start, err := resolveCalendarSources(view.CalendarDateKey(), view.CalendarStart, q.fields)
+	if err != nil {
+		return nil, err
+	}
+
+	// Boards whose dates may fall in the range; date fields are not shifted into loc,
+	// so the bounds are a day wider and the exact days are decided below
+	lower := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, -1)
+	upper := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 2)
+	first, last := date.target.timeExpr(), date.target.timeExpr()
+	if start != nil {
+		first = fmt.Sprintf("LEAST(%s, %s)", start.target.timeExpr(), date.target.timeExpr())
+		last = fmt.Sprintf("GREATEST(%s, %s)", start.target.timeExpr(), date.target.timeExpr())
+	}
+
+	var boards []domain.Board
+	if err := q.filteredBoards().
+		Where(first+" < ? AND "+last+" >= ?", upper, lower).
+		Order(q.order).Limit(maxCalendarBoards + 1).
+		Find(&boards).Error; err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	truncated := len(boards) > maxCalendarBoards
+	if truncated {
+		boards = boards[:maxCalendarBoards]
+	}
+
+	placed := make([]domain.Board, 0, len(boards))
+	spans := make([]calendarSpan, 0, len(boards))
+	for i := range boards {
+		span, ok := boardSpan(&boards[i], parseCustomFields(&boards[i]), date, start, loc)
+		if !ok || span.last.Before(from) || span.first.After(to) {
+			continue
+		}
+		placed = append(placed, boards[i])
+		spans = append(spans, span)
+	}
+
+	boardIDs := make([]uuid.UUID, len(placed))
+	for i, board := range placed {
+		boardIDs[i] = board.ID
+	}
+
+	progressMap, positionMap := s.loadBoardDetails(viewUUID, userUUID, boardIDs)
+
+	response := &dto.CalendarResponse{
+		From:      req.From,
+		To:        req.To,
+		TimeZone:  loc.String(),
+		DateField: date.key,
+		Days:      placeOnCalendar(from, to, spans),
+		Boards:    s.toBoardResponses(placed, positionMap, progressMap),
+		Truncated: truncated,
+	}
+	if start != nil {
+		response.StartField = start.key
+	}
+	return response, nil
+}
+
+// MoveCalendarBoard moves a board to another day of a calendar view
+// req.Date becomes the last day of the board's span; the start moves by the same number of days
+// and times keep their time of day in req.TimeZone. A board without dates is placed at the start of the day.
+func (s *viewService) MoveCalendarBoard(userID, viewID, boardID string, req *dto.MoveCalendarBoardRequest) (*dto.MoveCalendarBoardResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	viewUUID, err := uuid.Parse(viewID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 뷰 ID", 400)
+	}
+
+	boardUUID, err := uuid.Parse(boardID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 보드 ID", 400)
+	}
+
+	target, err := time.Parse(calendarDateLayout, req.Date)
+	if err != nil {
+		return nil, calendarError("date는 YYYY-MM-DD 형식이어야 합니다")
+	}
+	loc, err := loadTimeZone(req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := s.findAccessibleView(userUUID, viewUUID)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := s.repo.FindFieldsByProject(view.ProjectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	date, start, err := resolveCalendarSources(view.CalendarDateKey(), view.CalendarStart, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.boardRepo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	if board.ProjectID != view.ProjectID || board.IsDeleted {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+	}
+
+	// New dates: shift the existing ones, or place an undated board at the start of the day
+	customFields := parseCustomFields(board)
+	var newDate, newStart *time.Time
+	span, ok := boardSpan(board, customFields, date, start, loc)
+	if !ok {
+		day := target
+		if !date.dateOnly() {
+			day = time.Date(target.Year(), target.Month(), target.Day(), 0, 0, 0, 0, loc)
+		}
+		newDate = &day
+		span = calendarSpan{first: target, last: target}
+	} else {
+		delta := daysBetween(span.last, target)
+		if t := date.value(board, customFields); t != nil {
+			shifted := date.shift(*t, delta, loc)
+			newDate = &shifted
+		}
+		if start != nil {
+			if t := start.value(board, customFields); t != nil {
+				shifted := start.shift(*t, delta, loc)
+				newStart = &shifted
+			}
+		}
+		span.first, span.last = span.first.AddDate(0, 0, delta), target
+	}
+
+	// Write both dates in one board update (validation, permissions, activity, events), so a move
+	// either lands completely or not at all
+	response := &dto.MoveCalendarBoardResponse{
+		BoardID: boardUUID.String(),
+		Start:   span.first.Format(calendarDateLayout),
+		End:     span.last.Format(calendarDateLayout),
+	}
+	update := &dto.UpdateBoardRequest{}
+	response.Date = setCalendarDate(update, date, newDate)
+	if start != nil {
+		response.StartDate = setCalendarDate(update, start, newStart)
+	}
+	if _, err := s.boardService.UpdateBoard(board.ID.String(), userID, update); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// setCalendarDate puts a new date column or date field value into a board update as RFC 3339
+// Returns the written value, or nil when value is nil and the source is left as it is.
+func setCalendarDate(update *dto.UpdateBoardRequest, source *calendarSource, value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	formatted := value.Format(time.RFC3339)
+	switch {
+	case source.field != nil:
+		if update.FieldValues == nil {
+			update.FieldValues = make(map[string]interface{})
+		}
+		update.FieldValues[source.key] = formatted
+	case source.key == "start_date":
+		update.StartDate = &formatted
+	default:
+		update.DueDate = &formatted
+	}
+	return &formatted
+}
+
+// validateViewCalendar checks the calendar fields against the project fields before a view is saved
+func (s *viewService) validateViewCalendar(projectID uuid.UUID, dateKey, startKey string) error {
+	fields, err := s.repo.FindFieldsByProject(projectID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	_, _, err = resolveCalendarSources(dateKey, startKey, fields)
+	return err
+}
+
+func calendarError(message string) error {
+	return apperrors.New(apperrors.ErrCodeValidation, "캘린더: "+message, 400)
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/testutil"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestResolveCalendarSources(t *testing.T) {
+	fields := testProjectFields()
+	number, date := fields[0].ID.String(), fields[1].ID.String()
+
+	due, start, err := resolveCalendarSources(domain.CalendarDueDate, date, fields)
+	require.NoError(t, err)
+	assert.Equal(t, "due_date", due.target.timeExpr())
+	assert.False(t, due.dateOnly())
+	assert.True(t, start.dateOnly())
+
+	due, start, err = resolveCalendarSources(domain.CalendarDueDate, "start_date", fields)
+	require.NoError(t, err)
+	assert.Equal(t, "start_date", start.target.timeExpr())
+	assert.Equal(t, "due_date", due.key)
+
+	tests := []struct {
+		name  string
+		date  string
+		start string
+	}{
+		{"raw SQL", "due_date; DROP TABLE boards", ""},
+		{"column outside the whitelist", "created_at", ""},
+		{"field of another project", uuid.New().String(), ""},
+		{"not a date field", number, ""},
+		{"start is the date", date, date},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := resolveCalendarSources(tt.date, tt.start, fields)
+			assertStatus(t, err, 400)
+		})
+	}
+}
+
+func TestBoardSpan_TimeZones(t *testing.T) {
+	fields := testProjectFields()
+	date := fields[1].ID.String()
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+
+	due, start, err := resolveCalendarSources(domain.CalendarDueDate, date, fields)
+	require.NoError(t, err)
+
+	// 20:00 UTC is the next day in Seoul; a date field keeps its date
+	dueDate := time.Date(2026, 10, 16, 20, 0, 0, 0, time.UTC)
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, DueDate: &dueDate}
+	customFields := map[string]interface{}{date: "2026-10-14T00:00:00Z"}
+
+	span, ok := boardSpan(board, customFields, due, start, seoul)
+	require.True(t, ok)
+	assert.Equal(t, "2026-10-14", span.first.Format(calendarDateLayout))
+	assert.Equal(t, "2026-10-17", span.last.Format(calendarDateLayout))
+
+	span, ok = boardSpan(board, customFields, due, start, time.UTC)
+	require.True(t, ok)
+	assert.Equal(t, "2026-10-16", span.last.Format(calendarDateLayout))
+
+	// A start after the date is ignored
+	span, ok = boardSpan(board, map[string]interface{}{date: "2026-10-20T00:00:00Z"}, due, start, time.UTC)
+	require.True(t, ok)
+	assert.Equal(t, span.last, span.first)
+
+	_, ok = boardSpan(&domain.Board{}, nil, due, start, time.UTC)
+	assert.False(t, ok)
+}
+
+func TestPlaceOnCalendar(t *testing.T) {
+	day := func(s string) time.Time {
+		d, _ := time.Parse(calendarDateLayout, s)
+		return d
+	}
+
+	days := placeOnCalendar(day("2026-10-01"), day("2026-10-03"), []calendarSpan{
+		{boardID: "a", first: day("2026-09-30"), last: day("2026-10-02")}, // Starts before the range
+		{boardID: "b", first: day("2026-10-03"), last: day("2026-10-03")},
+	})
+
+	require.Len(t, days, 3)
+	assert.Equal(t, "2026-10-01", days[0].Date)
+	assert.Equal(t, []dto.CalendarEntry{{BoardID: "a", Start: "2026-09-30", End: "2026-10-02"}}, days[0].Entries)
+	assert.Equal(t, []dto.CalendarEntry{{BoardID: "a", Start: "2026-09-30", End: "2026-10-02", IsEnd: true}}, days[1].Entries)
+	assert.Equal(t, []dto.CalendarEntry{{BoardID: "b", Start: "2026-10-03", End: "2026-10-03", IsStart: true, IsEnd: true}}, days[2].Entries)
+}
+
+// recordingBoardUpdates records the board updates a calendar move makes
+type recordingBoardUpdates struct {
+	BoardService
+	requests []dto.UpdateBoardRequest
+}
+
+func (r *recordingBoardUpdates) UpdateBoard(boardID, userID string, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error) {
+	r.requests = append(r.requests, *req)
+	return nil, nil
+}
+
+func TestViewService_MoveCalendarBoard_KeepsSpan(t *testing.T) {
+	fields := testProjectFields()
+	startsAt := domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: domain.FieldTypeDateTime}
+	fields = append(fields, startsAt)
+	date := fields[1].ID.String()
+
+	fieldRepo := new(testutil.MockFieldRepository)
+	projectRepo := new(testutil.MockProjectRepository)
+	boardRepo := new(testutil.MockBoardRepository)
+	boards := &recordingBoardUpdates{}
+	s := &viewService{repo: fieldRepo, projectRepo: projectRepo, boardRepo: boardRepo, boardService: boards, logger: zap.NewNop()}
+
+	userID, viewID, projectID, boardID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	fieldRepo.On("FindViewByID", viewID).Return(&domain.SavedView{
+		BaseModel: domain.BaseModel{ID: viewID}, ProjectID: projectID, CreatedBy: userID,
+		CalendarField: date, CalendarStart: startsAt.ID.String(),
+	}, nil)
+	fieldRepo.On("FindFieldsByProject", projectID).Return(fields, nil)
+	projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{}, nil)
+	boardRepo.On("FindByID", boardID).Return(&domain.Board{
+		BaseModel:         domain.BaseModel{ID: boardID},
+		ProjectID:         projectID,
+		CustomFieldsCache: `{"` + date + `": "2026-10-16T00:00:00Z", "` + startsAt.ID.String() + `": "2026-10-14T00:30:00Z"}`,
+	}, nil)
+
+	// Seoul: 10-14 09:30 to 10-16, moved so that it ends on 10-20
+	result, err := s.MoveCalendarBoard(userID.String(), viewID.String(), boardID.String(), &dto.MoveCalendarBoardRequest{Date: "2026-10-20", TimeZone: "Asia/Seoul"})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-18", result.Start)
+	assert.Equal(t, "2026-10-20", result.End)
+
+	// Both dates move in a single board update
+	require.Len(t, boards.requests, 1)
+	assert.Equal(t, map[string]interface{}{
+		date:                 "2026-10-20T00:00:00Z",
+		startsAt.ID.String(): "2026-10-18T09:30:00+09:00",
+	}, boards.requests[0].FieldValues)
+	assert.Nil(t, boards.requests[0].DueDate)
+	require.NotNil(t, result.StartDate)
+	assert.Equal(t, "2026-10-18T09:30:00+09:00", *result.StartDate)
+}
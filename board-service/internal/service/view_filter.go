@@ -0,0 +1,813 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ==================== View Filter Compilation ====================
+// Saved view filters are a boolean expression tree compiled to one SQL condition on the boards table.
+// Built-in columns are compared directly; custom fields are read from custom_fields_cache (JSONB),
+// where a multi-value field holding a single value is stored as a scalar instead of an array.
+
+// filterKind decides which operators a filter target supports and how its values are compared
+type filterKind int
+
+const (
+	filterKindText     filterKind = iota // text, url, title, description
+	filterKindNumber                     // number
+	filterKindTime                       // date, datetime, due_date, created_at, updated_at
+	filterKindRef                        // single_select, single_user, assignee, author (IDs)
+	filterKindMultiRef                   // multi_select, multi_user (sets of IDs)
+	filterKindBool                       // checkbox
+)
+
+// filterOperators lists the supported operators per kind
+var filterOperators = map[filterKind][]string{
+	filterKindText: {
+		domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpContains, domain.FilterOpIn, domain.FilterOpNotIn,
+		domain.FilterOpIsNull, domain.FilterOpIsNotNull,
+	},
+	filterKindNumber: {
+		domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpGt, domain.FilterOpGte, domain.FilterOpLt, domain.FilterOpLte,
+		domain.FilterOpBetween, domain.FilterOpIn, domain.FilterOpNotIn, domain.FilterOpIsNull, domain.FilterOpIsNotNull,
+	},
+	filterKindTime: {
+		domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpGt, domain.FilterOpGte, domain.FilterOpLt, domain.FilterOpLte,
+		domain.FilterOpBetween, domain.FilterOpIsNull, domain.FilterOpIsNotNull,
+	},
+	filterKindRef: {
+		domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpIn, domain.FilterOpNotIn,
+		domain.FilterOpIsNull, domain.FilterOpIsNotNull,
+	},
+	filterKindMultiRef: {
+		domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpContains, domain.FilterOpIn, domain.FilterOpNotIn,
+		domain.FilterOpIsNull, domain.FilterOpIsNotNull,
+	},
+	filterKindBool: {
+		domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpIsNull, domain.FilterOpIsNotNull,
+	},
+}
+
+// filterTarget is what a filter key resolves to: a built-in column or a custom field
+type filterTarget struct {
+	key    string // Filter key as sent by the client (used in error messages)
+	kind   filterKind
+	column string // Built-in column name (empty for custom fields)
+	field  string // Custom field ID (key in custom_fields_cache)
+}
+
+// builtInFilterTargets maps filter keys to board columns
+var builtInFilterTargets = map[string]filterTarget{
+	"title":       {kind: filterKindText, column: "title"},
+	"description": {kind: filterKindText, column: "description"},
+	"assignee_id": {kind: filterKindRef, column: "assignee_id"},
+	"assignee":    {kind: filterKindRef, column: "assignee_id"},
+	"created_by":  {kind: filterKindRef, column: "created_by"},
+	"author":      {kind: filterKindRef, column: "created_by"},
+	"due_date":    {kind: filterKindTime, column: "due_date"},
+	"start_date":  {kind: filterKindTime, column: "start_date"},
+	"created_at":  {kind: filterKindTime, column: "created_at"},
+	"updated_at":  {kind: filterKindTime, column: "updated_at"},
+}
+
+// filterKindOf maps a custom field type to its filter kind
+func filterKindOf(fieldType domain.FieldType) (filterKind, bool) {
+	switch fieldType {
+	case domain.FieldTypeText, domain.FieldTypeURL:
+		return filterKindText, true
+	case domain.FieldTypeNumber:
+		return filterKindNumber, true
+	case domain.FieldTypeDate, domain.FieldTypeDateTime:
+		return filterKindTime, true
+	case domain.FieldTypeSingleSelect, domain.FieldTypeSingleUser:
+		return filterKindRef, true
+	case domain.FieldTypeMultiSelect, domain.FieldTypeMultiUser:
+		return filterKindMultiRef, true
+	case domain.FieldTypeCheckbox:
+		return filterKindBool, true
+	}
+	return 0, false
+}
+
+// filterClause is a compiled SQL condition with its arguments
+type filterClause struct {
+	sql  string
+	args []interface{}
+}
+
+// Limits on the filter expression tree
+const (
+	maxFilterDepth      = 5
+	maxFilterConditions = 50
+)
+
+// compileViewFilters parses the filters (expression tree or legacy flat map), resolves every condition
+// against the built-in columns and the project fields and compiles the tree to one SQL condition.
+// Unknown keys, unsupported operators and malformed values are rejected. Returns nil when nothing is filtered.
+func compileViewFilters(filters map[string]interface{}, fields []domain.ProjectField) (*filterClause, error) {
+	root, err := parseFilterTree(filters)
+	if err != nil {
+		return nil, err
+	}
+	return compileFilterTree(root, fields)
+}
+
+// compileSavedViewFilters compiles the filters of a saved view when the view is applied
+// Conditions on custom fields deleted after the view was saved are skipped instead of making the view
+// unusable, and their keys are returned; everything else is rejected as strictly as on save.
+func compileSavedViewFilters(filters map[string]interface{}, fields []domain.ProjectField) (*filterClause, []string, error) {
+	root, err := parseFilterTree(filters)
+	if err != nil {
+		return nil, nil, err
+	}
+	root, skipped := withoutMissingFields(root, fieldsByID(fields))
+	clause, err := compileFilterTree(root, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	return clause, skipped, nil
+}
+
+// withoutMissingFields returns the filter tree without the conditions on custom fields that are not in the
+// project, and the keys of the removed conditions. Groups left empty are removed too (nil if nothing is left).
+func withoutMissingFields(node *domain.FilterNode, fieldsByID map[string]*domain.ProjectField) (*domain.FilterNode, []string) {
+	if node == nil {
+		return nil, nil
+	}
+	if !node.IsGroup() {
+		if isMissingField(node.Field, fieldsByID) {
+			return nil, []string{node.Field}
+		}
+		return node, nil
+	}
+
+	var removed []string
+	kept := make([]domain.FilterNode, 0, len(node.Conditions))
+	for i := range node.Conditions {
+		child, keys := withoutMissingFields(&node.Conditions[i], fieldsByID)
+		removed = append(removed, keys...)
+		if child != nil {
+			kept = append(kept, *child)
+		}
+	}
+	if len(removed) == 0 {
+		return node, nil
+	}
+	if len(kept) == 0 {
+		return nil, removed
+	}
+	pruned := *node
+	pruned.Conditions = kept
+	return &pruned, removed
+}
+
+// isMissingField reports whether the key is a custom field ID that is not in the project
+// Other unknown keys are left to the compiler, which rejects them.
+func isMissingField(key string, fieldsByID map[string]*domain.ProjectField) bool {
+	if _, ok := builtInFilterTargets[key]; ok {
+		return false
+	}
+	fieldUUID, err := uuid.Parse(key)
+	if err != nil {
+		return false
+	}
+	_, ok := fieldsByID[fieldUUID.String()]
+	return !ok
+}
+
+// compileFilterTree compiles a parsed filter tree; an empty root group (or a nil root) filters nothing
+func compileFilterTree(root *domain.FilterNode, fields []domain.ProjectField) (*filterClause, error) {
+	if root == nil || ((root.Op == domain.FilterGroupAnd || root.Op == domain.FilterGroupOr) && len(root.Conditions) == 0) {
+		return nil, nil
+	}
+
+	compiler := &filterCompiler{fieldsByID: fieldsByID(fields)}
+	clause, err := compiler.compile(root, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &clause, nil
+}
+
+func fieldsByID(fields []domain.ProjectField) map[string]*domain.ProjectField {
+	byID := make(map[string]*domain.ProjectField, len(fields))
+	for i := range fields {
+		byID[fields[i].ID.String()] = &fields[i]
+	}
+	return byID
+}
+
+// parseFilterTree reads the stored filters as an expression tree
+// A map with an "op" key is a tree; any other map is a legacy flat filter, read as an 'and' group in key order
+// so the same filters always produce the same SQL.
+func parseFilterTree(filters map[string]interface{}) (*domain.FilterNode, error) {
+	if _, ok := filters["op"]; ok {
+		raw, err := json.Marshal(filters)
+		if err != nil {
+			return nil, filterGroupError("필터를 해석할 수 없습니다")
+		}
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.DisallowUnknownFields()
+
+		var root domain.FilterNode
+		if err := decoder.Decode(&root); err != nil {
+			return nil, filterGroupError("필터는 {op, conditions} 또는 {field, operator, value} 형식이어야 합니다")
+		}
+		return &root, nil
+	}
+
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	root := &domain.FilterNode{Op: domain.FilterGroupAnd, Conditions: make([]domain.FilterNode, 0, len(keys))}
+	for _, key := range keys {
+		condition, err := parseFilterCondition(key, filters[key])
+		if err != nil {
+			return nil, err
+		}
+		root.Conditions = append(root.Conditions, domain.FilterNode{
+			Field:    key,
+			Operator: condition.Operator,
+			Value:    condition.Value,
+		})
+	}
+	return root, nil
+}
+
+// filterCompiler compiles a filter expression tree against the project fields
+type filterCompiler struct {
+	fieldsByID map[string]*domain.ProjectField
+	conditions int
+}
+
+func (c *filterCompiler) compile(node *domain.FilterNode, depth int) (filterClause, error) {
+	if !node.IsGroup() {
+		return c.compileCondition(node)
+	}
+
+	if depth > maxFilterDepth {
+		return filterClause{}, filterGroupError(fmt.Sprintf("그룹은 %d단계까지만 중첩할 수 있습니다", maxFilterDepth))
+	}
+	if node.Field != "" || node.Operator != "" {
+		return filterClause{}, filterGroupError("그룹에는 field, operator를 지정할 수 없습니다")
+	}
+
+	var joiner string
+	switch node.Op {
+	case domain.FilterGroupAnd:
+		joiner = " AND "
+	case domain.FilterGroupOr:
+		joiner = " OR "
+	case domain.FilterGroupNot:
+		if len(node.Conditions) != 1 {
+			return filterClause{}, filterGroupError("not 그룹에는 조건이 정확히 하나 있어야 합니다")
+		}
+	default:
+		return filterClause{}, filterGroupError(fmt.Sprintf("알 수 없는 그룹 연산자입니다: %s (and, or, not)", node.Op))
+	}
+	if len(node.Conditions) == 0 {
+		return filterClause{}, filterGroupError("빈 그룹은 허용되지 않습니다")
+	}
+
+	parts := make([]string, 0, len(node.Conditions))
+	var args []interface{}
+	for i := range node.Conditions {
+		child, err := c.compile(&node.Conditions[i], depth+1)
+		if err != nil {
+			return filterClause{}, err
+		}
+		parts = append(parts, child.sql)
+		args = append(args, child.args...)
+	}
+
+	if node.Op == domain.FilterGroupNot {
+		return filterClause{sql: "NOT (" + parts[0] + ")", args: args}, nil
+	}
+	if len(parts) == 1 {
+		return filterClause{sql: parts[0], args: args}, nil
+	}
+	return filterClause{sql: "(" + strings.Join(parts, joiner) + ")", args: args}, nil
+}
+
+func (c *filterCompiler) compileCondition(node *domain.FilterNode) (filterClause, error) {
+	c.conditions++
+	if c.conditions > maxFilterConditions {
+		return filterClause{}, filterGroupError(fmt.Sprintf("조건은 최대 %d개까지 지정할 수 있습니다", maxFilterConditions))
+	}
+	if len(node.Conditions) > 0 {
+		return filterClause{}, filterGroupError("조건에 conditions를 지정하려면 op가 필요합니다")
+	}
+	if node.Field == "" {
+		return filterClause{}, filterGroupError("조건에는 field가 필요합니다")
+	}
+	if node.Operator == "" {
+		return filterClause{}, filterError(node.Field, "operator가 필요합니다")
+	}
+
+	target, err := resolveFilterTarget(node.Field, c.fieldsByID)
+	if err != nil {
+		return filterClause{}, err
+	}
+	return buildFilterClause(target, domain.FilterCondition{Operator: node.Operator, Value: node.Value})
+}
+
+func resolveFilterTarget(key string, fieldsByID map[string]*domain.ProjectField) (filterTarget, error) {
+	if target, ok := builtInFilterTargets[key]; ok {
+		target.key = key
+		return target, nil
+	}
+
+	fieldUUID, err := uuid.Parse(key)
+	if err != nil {
+		return filterTarget{}, filterError(key, "알 수 없는 필터 필드입니다")
+	}
+	field, ok := fieldsByID[fieldUUID.String()]
+	if !ok {
+		return filterTarget{}, filterError(key, "프로젝트에 없는 필드입니다")
+	}
+
+	kind, ok := filterKindOf(field.FieldType)
+	if !ok {
+		return filterTarget{}, filterError(key, fmt.Sprintf("%s 타입 필드는 필터링할 수 없습니다", field.FieldType))
+	}
+	return filterTarget{key: key, kind: kind, field: field.ID.String()}, nil
+}
+
+func parseFilterCondition(key string, raw interface{}) (domain.FilterCondition, error) {
+	conditionMap, ok := raw.(map[string]interface{})
+	if !ok {
+		return domain.FilterCondition{}, filterError(key, "필터는 {operator, value} 형식이어야 합니다")
+	}
+	operator, ok := conditionMap["operator"].(string)
+	if !ok || operator == "" {
+		return domain.FilterCondition{}, filterError(key, "operator가 필요합니다")
+	}
+	return domain.FilterCondition{Operator: operator, Value: conditionMap["value"]}, nil
+}
+
+// buildFilterClause compiles one condition for the target
+func buildFilterClause(target filterTarget, condition domain.FilterCondition) (filterClause, error) {
+	op := condition.Operator
+	if !isSupportedOperator(target.kind, op) {
+		if !isKnownOperator(op) {
+			return filterClause{}, filterError(target.key, fmt.Sprintf("알 수 없는 연산자입니다: %s", op))
+		}
+		return filterClause{}, filterError(target.key, fmt.Sprintf("%s 연산자는 이 필드에서 지원되지 않습니다 (지원: %s)",
+			op, strings.Join(filterOperators[target.kind], ", ")))
+	}
+
+	switch target.kind {
+	case filterKindText:
+		return buildTextClause(target, op, condition.Value)
+	case filterKindNumber:
+		return buildNumberClause(target, op, condition.Value)
+	case filterKindTime:
+		return buildTimeClause(target, op, condition.Value)
+	case filterKindRef:
+		return buildRefClause(target, op, condition.Value)
+	case filterKindMultiRef:
+		return buildMultiRefClause(target, op, condition.Value)
+	default:
+		return buildBoolClause(target, op, condition.Value)
+	}
+}
+
+// ==================== Clause builders per kind ====================
+
+func buildTextClause(target filterTarget, op string, value interface{}) (filterClause, error) {
+	x := target.textExpr()
+
+	switch op {
+	case domain.FilterOpIsNull:
+		return filterClause{sql: fmt.Sprintf("(%s IS NULL OR %s = '')", x, x)}, nil
+	case domain.FilterOpIsNotNull:
+		return filterClause{sql: fmt.Sprintf("(%s IS NOT NULL AND %s <> '')", x, x)}, nil
+	case domain.FilterOpIn, domain.FilterOpNotIn:
+		values, err := stringListValue(target.key, value)
+		if err != nil {
+			return filterClause{}, err
+		}
+		return inClause(x, op, values), nil
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return filterClause{}, filterError(target.key, "값은 문자열이어야 합니다")
+	}
+	switch op {
+	case domain.FilterOpEq:
+		return filterClause{sql: x + " = ?", args: []interface{}{text}}, nil
+	case domain.FilterOpNe:
+		return filterClause{sql: fmt.Sprintf("(%s IS NULL OR %s <> ?)", x, x), args: []interface{}{text}}, nil
+	default: // contains (case-insensitive)
+		return filterClause{sql: x + " ILIKE ?", args: []interface{}{"%" + escapeLike(text) + "%"}}, nil
+	}
+}
+
+func buildNumberClause(target filterTarget, op string, value interface{}) (filterClause, error) {
+	x := target.numberExpr()
+
+	switch op {
+	case domain.FilterOpIsNull:
+		return filterClause{sql: x + " IS NULL"}, nil
+	case domain.FilterOpIsNotNull:
+		return filterClause{sql: x + " IS NOT NULL"}, nil
+	case domain.FilterOpIn, domain.FilterOpNotIn:
+		items, err := listValue(target.key, value)
+		if err != nil {
+			return filterClause{}, err
+		}
+		numbers := make([]float64, 0, len(items))
+		for _, item := range items {
+			n, err := numberValue(target.key, item)
+			if err != nil {
+				return filterClause{}, err
+			}
+			numbers = append(numbers, n)
+		}
+		return inClause(x, op, numbers), nil
+	case domain.FilterOpBetween:
+		from, to, err := pairValue(target.key, value)
+		if err != nil {
+			return filterClause{}, err
+		}
+		min, err := numberValue(target.key, from)
+		if err != nil {
+			return filterClause{}, err
+		}
+		max, err := numberValue(target.key, to)
+		if err != nil {
+			return filterClause{}, err
+		}
+		return filterClause{sql: x + " BETWEEN ? AND ?", args: []interface{}{min, max}}, nil
+	}
+
+	n, err := numberValue(target.key, value)
+	if err != nil {
+		return filterClause{}, err
+	}
+	if op == domain.FilterOpNe {
+		return filterClause{sql: fmt.Sprintf("(%s IS NULL OR %s <> ?)", x, x), args: []interface{}{n}}, nil
+	}
+	return filterClause{sql: fmt.Sprintf("%s %s ?", x, comparisonSQL[op]), args: []interface{}{n}}, nil
+}
+
+// buildTimeClause compares timestamps; a date-only value ("2026-10-16") stands for that whole day (UTC)
+func buildTimeClause(target filterTarget, op string, value interface{}) (filterClause, error) {
+	x := target.timeExpr()
+
+	switch op {
+	case domain.FilterOpIsNull:
+		return filterClause{sql: x + " IS NULL"}, nil
+	case domain.FilterOpIsNotNull:
+		return filterClause{sql: x + " IS NOT NULL"}, nil
+	case domain.FilterOpBetween:
+		from, to, err := pairValue(target.key, value)
+		if err != nil {
+			return filterClause{}, err
+		}
+		lower, err := timeValue(target.key, from)
+		if err != nil {
+			return filterClause{}, err
+		}
+		upper, err := timeValue(target.key, to)
+		if err != nil {
+			return filterClause{}, err
+		}
+		lowerSQL, lowerArg := lower.atOrAfterStart(x)
+		upperSQL, upperArg := upper.atOrBeforeEnd(x)
+		return filterClause{sql: "(" + lowerSQL + " AND " + upperSQL + ")", args: []interface{}{lowerArg, upperArg}}, nil
+	}
+
+	t, err := timeValue(target.key, value)
+	if err != nil {
+		return filterClause{}, err
+	}
+
+	switch op {
+	case domain.FilterOpEq, domain.FilterOpNe:
+		var eq filterClause
+		if t.dateOnly {
+			eq = filterClause{sql: fmt.Sprintf("(%s >= ? AND %s < ?)", x, x), args: []interface{}{t.start, t.end}}
+		} else {
+			eq = filterClause{sql: x + " = ?", args: []interface{}{t.start}}
+		}
+		if op == domain.FilterOpNe {
+			eq.sql = fmt.Sprintf("(%s IS NULL OR NOT %s)", x, eq.sql)
+		}
+		return eq, nil
+	case domain.FilterOpGt:
+		if t.dateOnly {
+			return filterClause{sql: x + " >= ?", args: []interface{}{t.end}}, nil
+		}
+		return filterClause{sql: x + " > ?", args: []interface{}{t.start}}, nil
+	case domain.FilterOpGte:
+		sql, arg := t.atOrAfterStart(x)
+		return filterClause{sql: sql, args: []interface{}{arg}}, nil
+	case domain.FilterOpLt:
+		return filterClause{sql: x + " < ?", args: []interface{}{t.start}}, nil
+	default: // lte
+		sql, arg := t.atOrBeforeEnd(x)
+		return filterClause{sql: sql, args: []interface{}{arg}}, nil
+	}
+}
+
+func buildRefClause(target filterTarget, op string, value interface{}) (filterClause, error) {
+	x := target.textExpr()
+
+	switch op {
+	case domain.FilterOpIsNull:
+		return filterClause{sql: x + " IS NULL"}, nil
+	case domain.FilterOpIsNotNull:
+		return filterClause{sql: x + " IS NOT NULL"}, nil
+	case domain.FilterOpIn, domain.FilterOpNotIn:
+		ids, err := idListValue(target.key, value)
+		if err != nil {
+			return filterClause{}, err
+		}
+		return inClause(x, op, ids), nil
+	}
+
+	id, err := idValue(target.key, value)
+	if err != nil {
+		return filterClause{}, err
+	}
+	if op == domain.FilterOpNe {
+		return filterClause{sql: fmt.Sprintf("(%s IS NULL OR %s <> ?)", x, x), args: []interface{}{id}}, nil
+	}
+	return filterClause{sql: x + " = ?", args: []interface{}{id}}, nil
+}
+
+// buildMultiRefClause treats the field as a set of IDs
+// contains: has all given values, in: has any, not_in: has none, eq: exactly the given set
+func buildMultiRefClause(target filterTarget, op string, value interface{}) (filterClause, error) {
+	arr := target.arrayExpr()
+
+	switch op {
+	case domain.FilterOpIsNull:
+		return filterClause{sql: fmt.Sprintf("jsonb_array_length(%s) = 0", arr)}, nil
+	case domain.FilterOpIsNotNull:
+		return filterClause{sql: fmt.Sprintf("jsonb_array_length(%s) > 0", arr)}, nil
+	}
+
+	ids, err := idListValue(target.key, value)
+	if err != nil {
+		return filterClause{}, err
+	}
+
+	switch op {
+	case domain.FilterOpIn, domain.FilterOpNotIn:
+		exists := fmt.Sprintf("EXISTS (SELECT 1 FROM jsonb_array_elements_text(%s) AS elem(value) WHERE elem.value IN ?)", arr)
+		if op == domain.FilterOpNotIn {
+			exists = "NOT " + exists
+		}
+		return filterClause{sql: exists, args: []interface{}{ids}}, nil
+	}
+
+	set, _ := json.Marshal(ids)
+	switch op {
+	case domain.FilterOpContains:
+		return filterClause{sql: arr + " @> ?::jsonb", args: []interface{}{string(set)}}, nil
+	case domain.FilterOpEq:
+		return filterClause{sql: fmt.Sprintf("(%s @> ?::jsonb AND %s <@ ?::jsonb)", arr, arr), args: []interface{}{string(set), string(set)}}, nil
+	default: // ne
+		return filterClause{sql: fmt.Sprintf("NOT (%s @> ?::jsonb AND %s <@ ?::jsonb)", arr, arr), args: []interface{}{string(set), string(set)}}, nil
+	}
+}
+
+// buildBoolClause treats an unset checkbox as false for eq/ne
+func buildBoolClause(target filterTarget, op string, value interface{}) (filterClause, error) {
+	x := target.boolExpr()
+
+	switch op {
+	case domain.FilterOpIsNull:
+		return filterClause{sql: x + " IS NULL"}, nil
+	case domain.FilterOpIsNotNull:
+		return filterClause{sql: x + " IS NOT NULL"}, nil
+	}
+
+	b, ok := value.(bool)
+	if !ok {
+		return filterClause{}, filterError(target.key, "값은 true 또는 false여야 합니다")
+	}
+	if op == domain.FilterOpNe {
+		b = !b
+	}
+	return filterClause{sql: fmt.Sprintf("COALESCE(%s, false) = ?", x), args: []interface{}{b}}, nil
+}
+
+// ==================== SQL expressions ====================
+
+// jsonExpr is the JSONB value of the custom field; the key is a validated UUID, so it is safe to inline
+func (t filterTarget) jsonExpr() string {
+	return fmt.Sprintf("custom_fields_cache->'%s'", t.field)
+}
+
+func (t filterTarget) textExpr() string {
+	if t.column != "" {
+		return t.column
+	}
+	return fmt.Sprintf("custom_fields_cache->>'%s'", t.field)
+}
+
+func (t filterTarget) numberExpr() string {
+	return fmt.Sprintf("(CASE WHEN jsonb_typeof(%s) = 'number' THEN (%s)::numeric END)", t.jsonExpr(), t.textExpr())
+}
+
+func (t filterTarget) timeExpr() string {
+	if t.column != "" {
+		return t.column
+	}
+	return fmt.Sprintf("(CASE WHEN jsonb_typeof(%s) = 'string' THEN (%s)::timestamptz END)", t.jsonExpr(), t.textExpr())
+}
+
+func (t filterTarget) boolExpr() string {
+	return fmt.Sprintf("(CASE WHEN jsonb_typeof(%s) = 'boolean' THEN (%s)::boolean END)", t.jsonExpr(), t.textExpr())
+}
+
+// arrayExpr normalizes a multi-value field to a JSON array (a single value is cached as a scalar)
+func (t filterTarget) arrayExpr() string {
+	j := t.jsonExpr()
+	return fmt.Sprintf("(CASE jsonb_typeof(%s) WHEN 'array' THEN %s WHEN 'string' THEN jsonb_build_array(%s) ELSE '[]'::jsonb END)", j, j, j)
+}
+
+var comparisonSQL = map[string]string{
+	domain.FilterOpEq:  "=",
+	domain.FilterOpGt:  ">",
+	domain.FilterOpGte: ">=",
+	domain.FilterOpLt:  "<",
+	domain.FilterOpLte: "<=",
+}
+
+// inClause builds "x IN (...)"; not_in also matches rows without a value
+func inClause[T any](x, op string, values []T) filterClause {
+	if op == domain.FilterOpNotIn {
+		return filterClause{sql: fmt.Sprintf("(%s IS NULL OR %s NOT IN ?)", x, x), args: []interface{}{values}}
+	}
+	return filterClause{sql: x + " IN ?", args: []interface{}{values}}
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// ==================== Value parsing ====================
+
+// filterTime is a parsed time value; a date-only value covers [start, end)
+type filterTime struct {
+	start    time.Time
+	end      time.Time
+	dateOnly bool
+}
+
+func (t filterTime) atOrAfterStart(x string) (string, interface{}) {
+	return x + " >= ?", t.start
+}
+
+func (t filterTime) atOrBeforeEnd(x string) (string, interface{}) {
+	if t.dateOnly {
+		return x + " < ?", t.end
+	}
+	return x + " <= ?", t.start
+}
+
+func timeValue(key string, value interface{}) (filterTime, error) {
+	s, ok := value.(string)
+	if !ok {
+		return filterTime{}, filterError(key, "날짜 값은 YYYY-MM-DD 또는 RFC3339 문자열이어야 합니다")
+	}
+	if day, err := time.Parse("2006-01-02", s); err == nil {
+		return filterTime{start: day, end: day.AddDate(0, 0, 1), dateOnly: true}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return filterTime{start: t, end: t}, nil
+	}
+	return filterTime{}, filterError(key, fmt.Sprintf("날짜 형식이 올바르지 않습니다: %s", s))
+}
+
+func numberValue(key string, value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case json.Number:
+		if n, err := v.Float64(); err == nil {
+			return n, nil
+		}
+	case string:
+		if n, err := strconv.ParseFloat(v, 64); err == nil {
+			return n, nil
+		}
+	}
+	return 0, filterError(key, fmt.Sprintf("숫자 값이 필요합니다: %v", value))
+}
+
+func idValue(key string, value interface{}) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", filterError(key, "값은 ID 문자열이어야 합니다")
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return "", filterError(key, fmt.Sprintf("유효하지 않은 ID입니다: %s", s))
+	}
+	return id.String(), nil
+}
+
+// idListValue accepts a single ID or a non-empty list of IDs
+func idListValue(key string, value interface{}) ([]string, error) {
+	items, err := listValue(key, value)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		id, err := idValue(key, item)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func stringListValue(key string, value interface{}) ([]string, error) {
+	items, err := listValue(key, value)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			return nil, filterError(key, "목록의 값은 문자열이어야 합니다")
+		}
+		values = append(values, s)
+	}
+	return values, nil
+}
+
+// listValue accepts a single value or a non-empty list
+func listValue(key string, value interface{}) ([]interface{}, error) {
+	switch v := value.(type) {
+	case []interface{}:
+		if len(v) == 0 {
+			return nil, filterError(key, "값 목록이 비어 있습니다")
+		}
+		return v, nil
+	case nil:
+		return nil, filterError(key, "값이 필요합니다")
+	default:
+		return []interface{}{v}, nil
+	}
+}
+
+func pairValue(key string, value interface{}) (interface{}, interface{}, error) {
+	pair, ok := value.([]interface{})
+	if !ok || len(pair) != 2 {
+		return nil, nil, filterError(key, "between 값은 [from, to] 형식이어야 합니다")
+	}
+	return pair[0], pair[1], nil
+}
+
+func isSupportedOperator(kind filterKind, op string) bool {
+	for _, supported := range filterOperators[kind] {
+		if supported == op {
+			return true
+		}
+	}
+	return false
+}
+
+func isKnownOperator(op string) bool {
+	for _, ops := range filterOperators {
+		for _, known := range ops {
+			if known == op {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func filterGroupError(message string) error {
+	return apperrors.New(apperrors.ErrCodeValidation, "필터: "+message, 400)
+}
+
+func filterError(key, message string) error {
+	return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("필터 '%s': %s", key, message), 400)
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testProjectFields() []domain.ProjectField {
+	newField := func(fieldType domain.FieldType) domain.ProjectField {
+		return domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: fieldType}
+	}
+	return []domain.ProjectField{
+		newField(domain.FieldTypeNumber),
+		newField(domain.FieldTypeDate),
+		newField(domain.FieldTypeMultiSelect),
+		newField(domain.FieldTypeCheckbox),
+		newField(domain.FieldTypeSingleUser),
+	}
+}
+
+func cond(op string, value interface{}) map[string]interface{} {
+	return map[string]interface{}{"operator": op, "value": value}
+}
+
+func TestCompileViewFilters_PerKind(t *testing.T) {
+	fields := testProjectFields()
+	number, date, multi, checkbox, user := fields[0].ID.String(), fields[1].ID.String(), fields[2].ID.String(), fields[3].ID.String(), fields[4].ID.String()
+	optionA, optionB := uuid.New().String(), uuid.New().String()
+
+	tests := []struct {
+		name   string
+		key    string
+		filter map[string]interface{}
+		sql    string
+		args   []interface{}
+	}{
+		{
+			name:   "number gte",
+			key:    number,
+			filter: cond("gte", 3.0),
+			sql:    "(CASE WHEN jsonb_typeof(custom_fields_cache->'" + number + "') = 'number' THEN (custom_fields_cache->>'" + number + "')::numeric END) >= ?",
+			args:   []interface{}{3.0},
+		},
+		{
+			name:   "number between",
+			key:    number,
+			filter: cond("between", []interface{}{1.0, "5"}),
+			sql:    "(CASE WHEN jsonb_typeof(custom_fields_cache->'" + number + "') = 'number' THEN (custom_fields_cache->>'" + number + "')::numeric END) BETWEEN ? AND ?",
+			args:   []interface{}{1.0, 5.0},
+		},
+		{
+			name:   "date-only eq covers the whole day",
+			key:    date,
+			filter: cond("eq", "2026-10-16"),
+			sql:    "((CASE WHEN jsonb_typeof(custom_fields_cache->'" + date + "') = 'string' THEN (custom_fields_cache->>'" + date + "')::timestamptz END) >= ? AND (CASE WHEN jsonb_typeof(custom_fields_cache->'" + date + "') = 'string' THEN (custom_fields_cache->>'" + date + "')::timestamptz END) < ?)",
+			args:   []interface{}{time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:   "built-in due date range",
+			key:    "due_date",
+			filter: cond("between", []interface{}{"2026-10-01", "2026-10-31"}),
+			sql:    "(due_date >= ? AND due_date < ?)",
+			args:   []interface{}{time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:   "multi-select has any",
+			key:    multi,
+			filter: cond("in", []interface{}{optionA, optionB}),
+			sql:    "EXISTS (SELECT 1 FROM jsonb_array_elements_text((CASE jsonb_typeof(custom_fields_cache->'" + multi + "') WHEN 'array' THEN custom_fields_cache->'" + multi + "' WHEN 'string' THEN jsonb_build_array(custom_fields_cache->'" + multi + "') ELSE '[]'::jsonb END)) AS elem(value) WHERE elem.value IN ?)",
+			args:   []interface{}{[]string{optionA, optionB}},
+		},
+		{
+			name:   "multi-select has all",
+			key:    multi,
+			filter: cond("contains", optionA),
+			sql:    "(CASE jsonb_typeof(custom_fields_cache->'" + multi + "') WHEN 'array' THEN custom_fields_cache->'" + multi + "' WHEN 'string' THEN jsonb_build_array(custom_fields_cache->'" + multi + "') ELSE '[]'::jsonb END) @> ?::jsonb",
+			args:   []interface{}{`["` + optionA + `"]`},
+		},
+		{
+			name:   "checkbox ne true matches unchecked",
+			key:    checkbox,
+			filter: cond("ne", true),
+			sql:    "COALESCE((CASE WHEN jsonb_typeof(custom_fields_cache->'" + checkbox + "') = 'boolean' THEN (custom_fields_cache->>'" + checkbox + "')::boolean END), false) = ?",
+			args:   []interface{}{false},
+		},
+		{
+			name:   "single user is empty",
+			key:    user,
+			filter: cond("is_null", nil),
+			sql:    "custom_fields_cache->>'" + user + "' IS NULL",
+		},
+		{
+			name:   "assignee alias",
+			key:    "assignee",
+			filter: cond("not_in", []interface{}{optionA}),
+			sql:    "(assignee_id IS NULL OR assignee_id NOT IN ?)",
+			args:   []interface{}{[]string{optionA}},
+		},
+		{
+			name:   "title contains escapes wildcards",
+			key:    "title",
+			filter: cond("contains", "100%_done"),
+			sql:    "title ILIKE ?",
+			args:   []interface{}{`%100\%\_done%`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clause, err := compileViewFilters(map[string]interface{}{tt.key: tt.filter}, fields)
+			require.NoError(t, err)
+			require.NotNil(t, clause)
+			assert.Equal(t, tt.sql, clause.sql)
+			assert.Equal(t, tt.args, clause.args)
+		})
+	}
+}
+
+func TestCompileViewFilters_Rejects(t *testing.T) {
+	fields := testProjectFields()
+	number, checkbox := fields[0].ID.String(), fields[3].ID.String()
+
+	tests := []struct {
+		name    string
+		filters map[string]interface{}
+	}{
+		{"unknown operator", map[string]interface{}{"title": cond("startswith", "a")}},
+		{"operator not supported by kind", map[string]interface{}{checkbox: cond("gt", true)}},
+		{"contains on number", map[string]interface{}{number: cond("contains", "1")}},
+		{"non-numeric value", map[string]interface{}{number: cond("gt", "abc")}},
+		{"malformed between", map[string]interface{}{number: cond("between", []interface{}{1.0})}},
+		{"bad date", map[string]interface{}{"created_at": cond("gte", "yesterday")}},
+		{"bad user id", map[string]interface{}{"author": cond("eq", "bob")}},
+		{"unknown column", map[string]interface{}{"priority": cond("eq", "high")}},
+		{"field of another project", map[string]interface{}{uuid.New().String(): cond("eq", "x")}},
+		{"missing operator", map[string]interface{}{"title": map[string]interface{}{"value": "a"}}},
+		{"not an object", map[string]interface{}{"title": "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := compileViewFilters(tt.filters, fields)
+			assertStatus(t, err, 400)
+		})
+	}
+}
+
+func TestCompileViewFilters_LegacyFlatFiltersAreAnded(t *testing.T) {
+	filters := map[string]interface{}{
+		"title":      cond("eq", "a"),
+		"created_at": cond("is_not_null", nil),
+		"due_date":   cond("is_null", nil),
+	}
+
+	clause, err := compileViewFilters(filters, nil)
+	require.NoError(t, err)
+	require.NotNil(t, clause)
+	assert.Equal(t, "(created_at IS NOT NULL AND due_date IS NULL AND title = ?)", clause.sql)
+	assert.Equal(t, []interface{}{"a"}, clause.args)
+}
+
+func TestCompileViewFilters_Empty(t *testing.T) {
+	for _, filters := range []map[string]interface{}{nil, {}, {"op": "and", "conditions": []interface{}{}}} {
+		clause, err := compileViewFilters(filters, nil)
+		require.NoError(t, err)
+		assert.Nil(t, clause)
+	}
+}
+
+func TestCompileViewFilters_NestedGroups(t *testing.T) {
+	fields := testProjectFields()
+	number := fields[0].ID.String()
+	numberExpr := "(CASE WHEN jsonb_typeof(custom_fields_cache->'" + number + "') = 'number' THEN (custom_fields_cache->>'" + number + "')::numeric END)"
+	assignee := uuid.New().String()
+
+	// (title contains "bug" OR number > 3) AND NOT (assignee = X) AND title ne "wip"
+	filters := map[string]interface{}{
+		"op": "and",
+		"conditions": []interface{}{
+			map[string]interface{}{
+				"op": "or",
+				"conditions": []interface{}{
+					map[string]interface{}{"field": "title", "operator": "contains", "value": "bug"},
+					map[string]interface{}{"field": number, "operator": "gt", "value": 3.0},
+				},
+			},
+			map[string]interface{}{
+				"op":         "not",
+				"conditions": []interface{}{map[string]interface{}{"field": "assignee", "operator": "eq", "value": assignee}},
+			},
+			// The same field may appear more than once
+			map[string]interface{}{"field": "title", "operator": "ne", "value": "wip"},
+		},
+	}
+
+	clause, err := compileViewFilters(filters, fields)
+	require.NoError(t, err)
+	require.NotNil(t, clause)
+	assert.Equal(t, "((title ILIKE ? OR "+numberExpr+" > ?) AND NOT (assignee_id = ?) AND (title IS NULL OR title <> ?))", clause.sql)
+	assert.Equal(t, []interface{}{"%bug%", 3.0, assignee, "wip"}, clause.args)
+}
+
+func TestCompileSavedViewFilters_SkipsDeletedFields(t *testing.T) {
+	fields := testProjectFields()
+	number := fields[0].ID.String()
+	numberExpr := "(CASE WHEN jsonb_typeof(custom_fields_cache->'" + number + "') = 'number' THEN (custom_fields_cache->>'" + number + "')::numeric END)"
+	deleted := uuid.New().String()
+
+	// title eq "a" AND (deleted eq "x" OR NOT (deleted is_null)) AND number is_null
+	filters := map[string]interface{}{
+		"op": "and",
+		"conditions": []interface{}{
+			map[string]interface{}{"field": "title", "operator": "eq", "value": "a"},
+			map[string]interface{}{
+				"op": "or",
+				"conditions": []interface{}{
+					map[string]interface{}{"field": deleted, "operator": "eq", "value": "x"},
+					map[string]interface{}{
+						"op":         "not",
+						"conditions": []interface{}{map[string]interface{}{"field": deleted, "operator": "is_null"}},
+					},
+				},
+			},
+			map[string]interface{}{"field": number, "operator": "is_null"},
+		},
+	}
+
+	// Saving still rejects the deleted field
+	_, err := compileViewFilters(filters, fields)
+	assertStatus(t, err, 400)
+
+	// Applying drops its conditions and the groups left empty
+	clause, skipped, err := compileSavedViewFilters(filters, fields)
+	require.NoError(t, err)
+	require.NotNil(t, clause)
+	assert.Equal(t, "(title = ? AND "+numberExpr+" IS NULL)", clause.sql)
+	assert.Equal(t, []string{deleted, deleted}, skipped)
+
+	// A view filtering only on deleted fields filters nothing
+	clause, skipped, err = compileSavedViewFilters(map[string]interface{}{deleted: cond("eq", "x")}, fields)
+	require.NoError(t, err)
+	assert.Nil(t, clause)
+	assert.Equal(t, []string{deleted}, skipped)
+
+	// Other invalid filters are still rejected
+	_, _, err = compileSavedViewFilters(map[string]interface{}{"priority": cond("eq", "high")}, fields)
+	assertStatus(t, err, 400)
+}
+
+func TestCompileViewFilters_RejectsMalformedGroups(t *testing.T) {
+	leaf := map[string]interface{}{"field": "title", "operator": "eq", "value": "a"}
+	deep := map[string]interface{}{"op": "and", "conditions": []interface{}{leaf}}
+	for i := 0; i < maxFilterDepth; i++ {
+		deep = map[string]interface{}{"op": "and", "conditions": []interface{}{deep}}
+	}
+	many := make([]interface{}, maxFilterConditions+1)
+	for i := range many {
+		many[i] = leaf
+	}
+
+	tests := []struct {
+		name    string
+		filters map[string]interface{}
+	}{
+		{"unknown group operator", map[string]interface{}{"op": "xor", "conditions": []interface{}{leaf}}},
+		{"not with two conditions", map[string]interface{}{"op": "not", "conditions": []interface{}{leaf, leaf}}},
+		{"nested empty group", map[string]interface{}{"op": "or", "conditions": []interface{}{map[string]interface{}{"op": "and"}}}},
+		{"condition without field", map[string]interface{}{"op": "and", "conditions": []interface{}{map[string]interface{}{"operator": "eq", "value": "a"}}}},
+		{"unknown key", map[string]interface{}{"op": "and", "conditions": []interface{}{leaf}, "extra": true}},
+		{"invalid condition inside group", map[string]interface{}{"op": "or", "conditions": []interface{}{leaf, map[string]interface{}{"field": "title", "operator": "gt", "value": "a"}}}},
+		{"too deep", deep},
+		{"too many conditions", map[string]interface{}{"op": "or", "conditions": many}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := compileViewFilters(tt.filters, nil)
+			assertStatus(t, err, 400)
+		})
+	}
+}
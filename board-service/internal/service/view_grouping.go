@@ -0,0 +1,527 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ==================== View Grouping ====================
+// Boards are grouped in SQL over the whole filtered set: counts come from one GROUP BY query and
+// each group's first boards from a ROW_NUMBER() window, so groups do not depend on the page.
+// Within a group boards follow the user's manual position, then the view's sort keys; a group's next
+// boards are fetched with the cursor of its last page. Swimlanes group the boards a second time into
+// rows, so every (row, column) cell is counted and paged like a group.
+// Boards without a value (or with a deleted option) fall into the "(none)" group, which is always listed last.
+
+const noneGroupKey = "(none)"
+
+// builtInGroupKeys maps the groupable built-in keys to board columns
+var builtInGroupKeys = map[string]string{
+	"assignee_id": "assignee_id",
+	"assignee":    "assignee_id",
+	"created_by":  "created_by",
+	"author":      "created_by",
+	"due_date":    "due_date",
+	"start_date":  "start_date",
+	"created_at":  "created_at",
+	"updated_at":  "updated_at",
+}
+
+// viewGrouping is a validated grouping
+type viewGrouping struct {
+	alias   string // Alias of the expanded values of a multi-value field
+	key     string
+	target  filterTarget
+	field   *domain.ProjectField // nil for built-in keys
+	bucket  string               // Date bucket (time kinds only)
+	options []domain.FieldOption // Options of select fields, in display order
+}
+
+// groupedBoardRow is a board with the group (and swimlane) it was ranked in and its rank there
+type groupedBoardRow struct {
+	domain.Board
+	GroupKey  *string
+	LaneKey   *string
+	GroupRank int
+}
+
+// resolveGrouping validates the grouping against the built-in keys and the project fields
+func (s *viewService) resolveGrouping(grouping *domain.ViewGrouping, fields []domain.ProjectField) (*viewGrouping, error) {
+	spec := &viewGrouping{alias: "grp", key: grouping.Key}
+	if column, ok := builtInGroupKeys[grouping.Key]; ok {
+		spec.target = builtInFilterTargets[column]
+	} else {
+		if _, err := uuid.Parse(grouping.Key); err != nil {
+			return nil, groupingError(fmt.Sprintf("그룹핑할 수 없는 필드입니다: %s", grouping.Key))
+		}
+		target, err := resolveFilterTarget(grouping.Key, fieldsByID(fields))
+		if err != nil {
+			return nil, groupingError(fmt.Sprintf("그룹핑 필드를 찾을 수 없습니다: %s", grouping.Key))
+		}
+		spec.target = target
+		spec.field = fieldsByID(fields)[grouping.Key]
+	}
+	spec.target.key = grouping.Key
+
+	if spec.target.kind == filterKindTime {
+		spec.bucket = grouping.Bucket
+		if spec.bucket == "" {
+			spec.bucket = domain.GroupBucketDay
+		}
+		if spec.bucket != domain.GroupBucketDay && spec.bucket != domain.GroupBucketWeek && spec.bucket != domain.GroupBucketMonth {
+			return nil, groupingError(fmt.Sprintf("날짜 그룹 단위는 day, week 또는 month여야 합니다: %s", grouping.Bucket))
+		}
+	} else if grouping.Bucket != "" {
+		return nil, groupingError("그룹 단위는 날짜 필드에만 지정할 수 있습니다")
+	}
+
+	if spec.isSelect() {
+		options, err := s.repo.FindOptionsByField(spec.field.ID)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 조회 실패", 500)
+		}
+		// Archived options get no column; their boards stay in the "(none)" group of the field
+		options = activeOptions(options)
+		sort.SliceStable(options, func(i, j int) bool { return options[i].DisplayOrder < options[j].DisplayOrder })
+		spec.options = options
+	}
+	return spec, nil
+}
+
+// resolveSwimlanes validates the secondary grouping of a view grouped by columns
+func (s *viewService) resolveSwimlanes(columns *viewGrouping, swimlanes *domain.ViewGrouping, fields []domain.ProjectField) (*viewGrouping, error) {
+	lanes, err := s.resolveGrouping(swimlanes, fields)
+	if err != nil {
+		return nil, err
+	}
+	if (lanes.target.column != "" && lanes.target.column == columns.target.column) || (lanes.field != nil && lanes.key == columns.key) {
+		return nil, groupingError("스윔레인은 그룹핑과 다른 필드여야 합니다")
+	}
+	lanes.alias = "lane"
+	return lanes, nil
+}
+
+func (g *viewGrouping) isSelect() bool {
+	return g.field != nil && (g.field.FieldType == domain.FieldTypeSingleSelect || g.field.FieldType == domain.FieldTypeMultiSelect)
+}
+
+// keyExpr is the SQL expression of a board's group key (as text, NULL for the "(none)" group)
+func (g *viewGrouping) keyExpr() string {
+	switch g.target.kind {
+	case filterKindMultiRef:
+		return g.alias + ".value"
+	case filterKindTime:
+		return fmt.Sprintf("to_char(date_trunc('%s', %s), 'YYYY-MM-DD')", g.bucket, g.target.timeExpr())
+	case filterKindNumber:
+		return fmt.Sprintf("(%s)::text", g.target.numberExpr())
+	case filterKindBool:
+		return fmt.Sprintf("(%s)::text", g.target.boolExpr())
+	}
+
+	if g.target.column != "" {
+		return g.target.column + "::text"
+	}
+	text := g.target.textExpr()
+	if g.isSelect() {
+		return fmt.Sprintf("CASE WHEN %s THEN %s END", g.knownOption(text), text)
+	}
+	return fmt.Sprintf("NULLIF(%s, '')", text)
+}
+
+// joinClause expands multi-value fields to one row per value; a board without values keeps one NULL row
+func (g *viewGrouping) joinClause() string {
+	if g.target.kind != filterKindMultiRef {
+		return ""
+	}
+	condition := "value <> ''"
+	if g.isSelect() {
+		condition = g.knownOption("value")
+	}
+	return fmt.Sprintf("LEFT JOIN LATERAL (SELECT value FROM jsonb_array_elements_text(%s) AS e(value) WHERE %s) AS %s ON true",
+		g.target.arrayExpr(), condition, g.alias)
+}
+
+// knownOption is a condition true when expr is a current option of the field
+// Option IDs come from the database, so they are safe to inline.
+func (g *viewGrouping) knownOption(expr string) string {
+	if len(g.options) == 0 {
+		return "false"
+	}
+	ids := make([]string, len(g.options))
+	for i, option := range g.options {
+		ids[i] = "'" + option.ID.String() + "'"
+	}
+	return fmt.Sprintf("%s IN (%s)", expr, strings.Join(ids, ", "))
+}
+
+// orderedKeys lists the group keys in display order: options and checkbox values are always listed,
+// other keys only when boards have them. The "(none)" group is not included.
+func (g *viewGrouping) orderedKeys(present []string) []string {
+	if g.isSelect() {
+		keys := make([]string, len(g.options))
+		for i, option := range g.options {
+			keys[i] = option.ID.String()
+		}
+		return keys
+	}
+	if g.target.kind == filterKindBool {
+		return []string{"true", "false"}
+	}
+
+	keys := append([]string(nil), present...)
+	if g.target.kind == filterKindNumber {
+		sort.Slice(keys, func(i, j int) bool {
+			a, _ := strconv.ParseFloat(keys[i], 64)
+			b, _ := strconv.ParseFloat(keys[j], 64)
+			return a < b
+		})
+	} else {
+		sort.Strings(keys)
+	}
+	return keys
+}
+
+// groupValue describes a group for clients
+func (g *viewGrouping) groupValue(key string) interface{} {
+	if key == noneGroupKey {
+		return nil
+	}
+	if g.isSelect() {
+		for _, option := range g.options {
+			if option.ID.String() == key {
+				return map[string]interface{}{
+					"option_id": key,
+					"label":     option.Label,
+					"color":     option.Color,
+				}
+			}
+		}
+	}
+
+	switch g.target.kind {
+	case filterKindRef, filterKindMultiRef:
+		return map[string]interface{}{"user_id": key}
+	case filterKindBool:
+		return map[string]interface{}{"value": key == "true"}
+	case filterKindTime:
+		return map[string]interface{}{"value": key, "bucket": g.bucket}
+	case filterKindNumber:
+		value, _ := strconv.ParseFloat(key, 64)
+		return map[string]interface{}{"value": value}
+	}
+	return map[string]interface{}{"value": key}
+}
+
+// groupedBoards is the view's filtered boards with multi-value fields expanded to one row per value
+func groupedBoards(q *viewQuery, specs ...*viewGrouping) *gorm.DB {
+	query := q.filteredBoards()
+	for _, spec := range specs {
+		if join := spec.joinClause(); join != "" {
+			query = query.Joins(join)
+		}
+	}
+	return query
+}
+
+// inGroup restricts the query to the group with the key
+func (g *viewGrouping) inGroup(query *gorm.DB, key string) *gorm.DB {
+	if key == noneGroupKey {
+		return query.Where(g.keyExpr() + " IS NULL")
+	}
+	return query.Where(g.keyExpr()+" = ?", key)
+}
+
+// groupKeyOf maps a scanned group key to the group's key
+func groupKeyOf(key *string) string {
+	if key == nil {
+		return noneGroupKey
+	}
+	return *key
+}
+
+func (g *viewGrouping) fieldResponse() *dto.FieldResponse {
+	if g.field == nil {
+		return nil
+	}
+	return &dto.FieldResponse{
+		FieldID:   g.field.ID.String(),
+		ProjectID: g.field.ProjectID.String(),
+		Name:      g.field.Name,
+		FieldType: string(g.field.FieldType),
+	}
+}
+
+// validKey reports whether key can name a group of the grouping
+func (g *viewGrouping) validKey(key string) bool {
+	if key == noneGroupKey {
+		return true
+	}
+	if g.isSelect() {
+		for _, option := range g.options {
+			if option.ID.String() == key {
+				return true
+			}
+		}
+		return false
+	}
+
+	var err error
+	switch g.target.kind {
+	case filterKindRef, filterKindMultiRef:
+		_, err = uuid.Parse(key) // User IDs
+	case filterKindBool:
+		return key == "true" || key == "false"
+	case filterKindTime:
+		_, err = time.Parse("2006-01-02", key)
+	case filterKindNumber:
+		_, err = strconv.ParseFloat(key, 64)
+	}
+	return err == nil && key != ""
+}
+
+// groupOrder orders boards within a group: the user's manual position first, then the view's sort keys
+func (q *viewQuery) groupOrder() string {
+	manual := &manualSort{viewID: q.viewID, userID: q.userID}
+	return manual.expr() + " ASC NULLS LAST, " + q.order
+}
+
+// groupCursor continues a group after a board; the offset is used once that board has left the group
+type groupCursor struct {
+	After  uuid.UUID `json:"a"`
+	Offset int       `json:"o"`
+}
+
+func (c groupCursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeGroupCursor(cursor string) (*groupCursor, error) {
+	var c groupCursor
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err == nil {
+		err = json.Unmarshal(data, &c)
+	}
+	if err != nil || c.Offset < 0 {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "잘못된 커서", 400)
+	}
+	return &c, nil
+}
+
+// countGroups counts the boards of every group over the whole filtered set
+// Returns the counts by key and the keys present, except "(none)".
+func (s *viewService) countGroups(q *viewQuery, spec *viewGrouping) (map[string]int, []string, error) {
+	var counts []struct {
+		GroupKey *string
+		Count    int
+	}
+	if err := groupedBoards(q, spec).Select(spec.keyExpr() + " AS group_key, COUNT(*) AS count").Group("group_key").Scan(&counts).Error; err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "그룹 집계 실패", 500)
+	}
+
+	countByKey := make(map[string]int, len(counts))
+	present := make([]string, 0, len(counts))
+	for _, c := range counts {
+		countByKey[groupKeyOf(c.GroupKey)] = c.Count
+		if c.GroupKey != nil {
+			present = append(present, *c.GroupKey)
+		}
+	}
+	return countByKey, present, nil
+}
+
+// groupBoards groups the filtered boards, returning every group's count and its first limit boards
+func (s *viewService) groupBoards(q *viewQuery, spec *viewGrouping, limit int, total int64) (*dto.GroupedBoardsResponse, error) {
+	countByKey, present, err := s.countGroups(q, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the first boards of every group
+	keyExpr := spec.keyExpr()
+	ranked := groupedBoards(q, spec).Select(fmt.Sprintf("boards.*, %s AS group_key, ROW_NUMBER() OVER (PARTITION BY %s ORDER BY %s) AS group_rank", keyExpr, keyExpr, q.groupOrder()))
+	var rows []groupedBoardRow
+	if err := s.db.Table("(?) AS ranked", ranked).Where("group_rank <= ?", limit).Order("group_rank").Scan(&rows).Error; err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	rowsByKey := make(map[string][]groupedBoardRow)
+	for _, row := range rows {
+		key := groupKeyOf(row.GroupKey)
+		rowsByKey[key] = append(rowsByKey[key], row)
+	}
+	progressMap, positionMap := s.loadGroupedBoardDetails(q, rows)
+
+	keys := append(spec.orderedKeys(present), noneGroupKey)
+	groups := make([]dto.BoardGroup, 0, len(keys))
+	for _, key := range keys {
+		groups = append(groups, s.toBoardGroup(spec, key, rowsByKey[key], countByKey[key], countByKey[key] > len(rowsByKey[key]), progressMap, positionMap))
+	}
+
+	return &dto.GroupedBoardsResponse{
+		GroupByField: spec.fieldResponse(),
+		GroupBy:      spec.key,
+		Bucket:       spec.bucket,
+		Groups:       groups,
+		Total:        total,
+	}, nil
+}
+
+// swimlaneBoards groups the filtered boards into swimlanes (rows) by lanes and groups (columns) by columns,
+// returning the count of every row, column and cell and the first limit boards of every cell
+func (s *viewService) swimlaneBoards(q *viewQuery, columns, lanes *viewGrouping, limit int, total int64) (*dto.SwimlaneBoardsResponse, error) {
+	columnCounts, columnsPresent, err := s.countGroups(q, columns)
+	if err != nil {
+		return nil, err
+	}
+	laneCounts, lanesPresent, err := s.countGroups(q, lanes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Count every cell
+	columnExpr, laneExpr := columns.keyExpr(), lanes.keyExpr()
+	var cellCounts []struct {
+		GroupKey *string
+		LaneKey  *string
+		Count    int
+	}
+	if err := groupedBoards(q, columns, lanes).Select(columnExpr + " AS group_key, " + laneExpr + " AS lane_key, COUNT(*) AS count").
+		Group("group_key, lane_key").Scan(&cellCounts).Error; err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "그룹 집계 실패", 500)
+	}
+	cellCount := make(map[[2]string]int, len(cellCounts))
+	for _, c := range cellCounts {
+		cellCount[[2]string{groupKeyOf(c.LaneKey), groupKeyOf(c.GroupKey)}] = c.Count
+	}
+
+	// Fetch the first boards of every cell
+	ranked := groupedBoards(q, columns, lanes).Select(fmt.Sprintf("boards.*, %s AS group_key, %s AS lane_key, ROW_NUMBER() OVER (PARTITION BY %s, %s ORDER BY %s) AS group_rank",
+		columnExpr, laneExpr, columnExpr, laneExpr, q.groupOrder()))
+	var rows []groupedBoardRow
+	if err := s.db.Table("(?) AS ranked", ranked).Where("group_rank <= ?", limit).Order("group_rank").Scan(&rows).Error; err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	rowsByCell := make(map[[2]string][]groupedBoardRow)
+	for _, row := range rows {
+		cell := [2]string{groupKeyOf(row.LaneKey), groupKeyOf(row.GroupKey)}
+		rowsByCell[cell] = append(rowsByCell[cell], row)
+	}
+	progressMap, positionMap := s.loadGroupedBoardDetails(q, rows)
+
+	columnKeys := append(columns.orderedKeys(columnsPresent), noneGroupKey)
+	headers := make([]dto.GroupHeader, 0, len(columnKeys))
+	for _, key := range columnKeys {
+		headers = append(headers, dto.GroupHeader{Key: key, GroupValue: columns.groupValue(key), Count: columnCounts[key]})
+	}
+
+	laneKeys := append(lanes.orderedKeys(lanesPresent), noneGroupKey)
+	swimlanes := make([]dto.Swimlane, 0, len(laneKeys))
+	for _, laneKey := range laneKeys {
+		cells := make([]dto.BoardGroup, 0, len(columnKeys))
+		for _, columnKey := range columnKeys {
+			cell := [2]string{laneKey, columnKey}
+			cells = append(cells, s.toBoardGroup(columns, columnKey, rowsByCell[cell], cellCount[cell], cellCount[cell] > len(rowsByCell[cell]), progressMap, positionMap))
+		}
+		swimlanes = append(swimlanes, dto.Swimlane{
+			GroupHeader: dto.GroupHeader{Key: laneKey, GroupValue: lanes.groupValue(laneKey), Count: laneCounts[laneKey]},
+			Cells:       cells,
+		})
+	}
+
+	return &dto.SwimlaneBoardsResponse{
+		GroupByField:    columns.fieldResponse(),
+		GroupBy:         columns.key,
+		Bucket:          columns.bucket,
+		SwimlaneByField: lanes.fieldResponse(),
+		SwimlaneBy:      lanes.key,
+		SwimlaneBucket:  lanes.bucket,
+		Columns:         headers,
+		Swimlanes:       swimlanes,
+		Total:           total,
+	}, nil
+}
+
+// groupPage returns the next limit boards of one group (or one cell when lane is set) after the cursor
+// The cursor is nil for the first page.
+func (s *viewService) groupPage(q *viewQuery, spec *viewGrouping, key string, lane *viewGrouping, laneKey string, cursor *groupCursor, limit int) (*dto.BoardGroup, error) {
+	inGroup := func() *gorm.DB {
+		if lane == nil {
+			return spec.inGroup(groupedBoards(q, spec), key)
+		}
+		return lane.inGroup(spec.inGroup(groupedBoards(q, spec, lane), key), laneKey)
+	}
+
+	var count int64
+	if err := inGroup().Count(&count).Error; err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 카운트 실패", 500)
+	}
+
+	ranked := func() *gorm.DB {
+		return inGroup().Select(fmt.Sprintf("boards.*, ROW_NUMBER() OVER (ORDER BY %s) AS group_rank", q.groupOrder()))
+	}
+	query := s.db.Table("(?) AS ranked", ranked())
+	if cursor != nil {
+		query = query.Where("group_rank > COALESCE((SELECT r.group_rank FROM (?) AS r WHERE r.id = ?), ?)", ranked(), cursor.After, cursor.Offset)
+	}
+
+	// One extra row tells whether there are more
+	var rows []groupedBoardRow
+	if err := query.Order("group_rank").Limit(limit + 1).Scan(&rows).Error; err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	progressMap, positionMap := s.loadGroupedBoardDetails(q, rows)
+	group := s.toBoardGroup(spec, key, rows, int(count), hasMore, progressMap, positionMap)
+	return &group, nil
+}
+
+// loadGroupedBoardDetails fetches checklist progress and the user's positions of the grouped boards
+func (s *viewService) loadGroupedBoardDetails(q *viewQuery, rows []groupedBoardRow) (map[uuid.UUID]domain.ChecklistProgress, map[uuid.UUID]string) {
+	boardIDs := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		boardIDs[i] = row.ID
+	}
+	return s.loadBoardDetails(q.viewID, q.userID, boardIDs)
+}
+
+// toBoardGroup builds a group from its ranked rows; the cursor continues after the last row
+func (s *viewService) toBoardGroup(spec *viewGrouping, key string, rows []groupedBoardRow, count int, hasMore bool, progressMap map[uuid.UUID]domain.ChecklistProgress, positionMap map[uuid.UUID]string) dto.BoardGroup {
+	boards := make([]domain.Board, len(rows))
+	for i, row := range rows {
+		boards[i] = row.Board
+	}
+
+	group := dto.BoardGroup{
+		Key:        key,
+		GroupValue: spec.groupValue(key),
+		Boards:     s.toBoardResponses(boards, positionMap, progressMap),
+		Count:      count,
+		HasMore:    hasMore,
+	}
+	if hasMore && len(rows) > 0 {
+		last := rows[len(rows)-1]
+		group.NextCursor = groupCursor{After: last.ID, Offset: last.GroupRank}.encode()
+	}
+	return group
+}
+
+func groupingError(message string) error {
+	return apperrors.New(apperrors.ErrCodeValidation, "그룹핑: "+message, 400)
+}
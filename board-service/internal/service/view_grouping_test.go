@@ -0,0 +1,206 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/testutil"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestResolveGrouping_KeyExpressions(t *testing.T) {
+	fields := testProjectFields()
+	number, date, labels, done, owner := fields[0].ID, fields[1].ID, fields[2].ID, fields[3].ID, fields[4].ID
+
+	bug := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, Label: "bug", DisplayOrder: 1}
+	ui := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, Label: "ui", DisplayOrder: 0}
+	fieldRepo := new(testutil.MockFieldRepository)
+	fieldRepo.On("FindOptionsByField", labels).Return([]domain.FieldOption{bug, ui}, nil)
+	s := &viewService{repo: fieldRepo, logger: zap.NewNop()}
+
+	tests := []struct {
+		grouping domain.ViewGrouping
+		keyExpr  string
+		join     string
+	}{
+		{domain.ViewGrouping{Key: "assignee"}, "assignee_id::text", ""},
+		{domain.ViewGrouping{Key: "due_date", Bucket: "week"}, "to_char(date_trunc('week', due_date), 'YYYY-MM-DD')", ""},
+		{domain.ViewGrouping{Key: "created_at"}, "to_char(date_trunc('day', created_at), 'YYYY-MM-DD')", ""},
+		{
+			domain.ViewGrouping{Key: date.String(), Bucket: "month"},
+			fmt.Sprintf("to_char(date_trunc('month', (CASE WHEN jsonb_typeof(custom_fields_cache->'%s') = 'string' THEN (custom_fields_cache->>'%s')::timestamptz END)), 'YYYY-MM-DD')", date, date),
+			"",
+		},
+		{
+			domain.ViewGrouping{Key: number.String()},
+			fmt.Sprintf("((CASE WHEN jsonb_typeof(custom_fields_cache->'%s') = 'number' THEN (custom_fields_cache->>'%s')::numeric END))::text", number, number),
+			"",
+		},
+		{
+			domain.ViewGrouping{Key: done.String()},
+			fmt.Sprintf("((CASE WHEN jsonb_typeof(custom_fields_cache->'%s') = 'boolean' THEN (custom_fields_cache->>'%s')::boolean END))::text", done, done),
+			"",
+		},
+		{domain.ViewGrouping{Key: owner.String()}, fmt.Sprintf("NULLIF(custom_fields_cache->>'%s', '')", owner), ""},
+		{
+			// Values that are not current options fall into "(none)"
+			domain.ViewGrouping{Key: labels.String()},
+			"grp.value",
+			fmt.Sprintf("LEFT JOIN LATERAL (SELECT value FROM jsonb_array_elements_text(%s) AS e(value) WHERE value IN ('%s', '%s')) AS grp ON true",
+				filterTarget{field: labels.String()}.arrayExpr(), ui.ID, bug.ID),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.grouping.Key, func(t *testing.T) {
+			spec, err := s.resolveGrouping(&tt.grouping, fields)
+			require.NoError(t, err)
+			assert.Equal(t, tt.keyExpr, spec.keyExpr())
+			assert.Equal(t, tt.join, spec.joinClause())
+		})
+	}
+}
+
+func TestResolveGrouping_Rejects(t *testing.T) {
+	fields := testProjectFields()
+	s := &viewService{logger: zap.NewNop()}
+
+	tests := []struct {
+		name     string
+		grouping domain.ViewGrouping
+	}{
+		{"raw SQL", domain.ViewGrouping{Key: "assignee_id; DROP TABLE boards"}},
+		{"column outside the whitelist", domain.ViewGrouping{Key: "title"}},
+		{"field of another project", domain.ViewGrouping{Key: uuid.New().String()}},
+		{"unknown bucket", domain.ViewGrouping{Key: "due_date", Bucket: "year"}},
+		{"bucket on a non-date key", domain.ViewGrouping{Key: fields[4].ID.String(), Bucket: "day"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.resolveGrouping(&tt.grouping, fields)
+			assertStatus(t, err, 400)
+		})
+	}
+}
+
+func TestViewGrouping_OrderedKeysAndValues(t *testing.T) {
+	fields := testProjectFields()
+	s := &viewService{logger: zap.NewNop()}
+
+	number, err := s.resolveGrouping(&domain.ViewGrouping{Key: fields[0].ID.String()}, fields)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2", "10", "10.5"}, number.orderedKeys([]string{"10.5", "2", "10"}))
+	assert.Equal(t, map[string]interface{}{"value": 10.5}, number.groupValue("10.5"))
+	assert.Nil(t, number.groupValue(noneGroupKey))
+
+	// Checkbox groups are listed even when empty
+	done, err := s.resolveGrouping(&domain.ViewGrouping{Key: fields[3].ID.String()}, fields)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"true", "false"}, done.orderedKeys(nil))
+	assert.Equal(t, map[string]interface{}{"value": false}, done.groupValue("false"))
+
+	due, err := s.resolveGrouping(&domain.ViewGrouping{Key: "due_date", Bucket: "month"}, fields)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2026-09-01", "2026-10-01"}, due.orderedKeys([]string{"2026-10-01", "2026-09-01"}))
+	assert.Equal(t, map[string]interface{}{"value": "2026-10-01", "bucket": "month"}, due.groupValue("2026-10-01"))
+}
+
+func TestSavedView_Grouping(t *testing.T) {
+	fieldID := uuid.New()
+	legacy := &domain.SavedView{GroupByFieldID: &fieldID}
+	assert.Equal(t, &domain.ViewGrouping{Key: fieldID.String()}, legacy.Grouping())
+
+	view := &domain.SavedView{}
+	view.SetGrouping(&domain.ViewGrouping{Key: "due_date", Bucket: "week"})
+	assert.Nil(t, view.GroupByFieldID)
+	assert.Equal(t, &domain.ViewGrouping{Key: "due_date", Bucket: "week"}, view.Grouping())
+
+	view.SetGrouping(&domain.ViewGrouping{Key: fieldID.String()})
+	assert.Equal(t, fieldID, *view.GroupByFieldID)
+
+	view.SetGrouping(nil)
+	assert.Nil(t, view.Grouping())
+}
+
+func TestViewGrouping_ValidKey(t *testing.T) {
+	fields := testProjectFields()
+	labels := fields[2].ID
+	bug := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, Label: "bug"}
+	fieldRepo := new(testutil.MockFieldRepository)
+	fieldRepo.On("FindOptionsByField", labels).Return([]domain.FieldOption{bug}, nil)
+	s := &viewService{repo: fieldRepo, logger: zap.NewNop()}
+
+	tests := []struct {
+		key   string
+		group string
+		valid bool
+	}{
+		{labels.String(), bug.ID.String(), true},
+		{labels.String(), uuid.New().String(), false}, // Deleted option
+		{labels.String(), noneGroupKey, true},
+		{"assignee", uuid.New().String(), true},
+		{"assignee", "bob", false},
+		{"due_date", "2026-10-12", true},
+		{"due_date", "next week", false},
+		{fields[0].ID.String(), "10.5", true},
+		{fields[3].ID.String(), "maybe", false},
+	}
+
+	for _, tt := range tests {
+		spec, err := s.resolveGrouping(&domain.ViewGrouping{Key: tt.key}, fields)
+		require.NoError(t, err)
+		assert.Equal(t, tt.valid, spec.validKey(tt.group), "%s = %s", tt.key, tt.group)
+	}
+}
+
+func TestGroupCursor_RoundTrip(t *testing.T) {
+	cursor := groupCursor{After: uuid.New(), Offset: 40}
+	decoded, err := decodeGroupCursor(cursor.encode())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, *decoded)
+
+	_, err = decodeGroupCursor("not a cursor")
+	assertStatus(t, err, 400)
+}
+
+func TestViewService_GetGroupBoards_UngroupedView(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+	projectRepo := new(testutil.MockProjectRepository)
+	s := &viewService{repo: fieldRepo, projectRepo: projectRepo, logger: zap.NewNop()}
+
+	userID, viewID, projectID := uuid.New(), uuid.New(), uuid.New()
+	fieldRepo.On("FindViewByID", viewID).Return(&domain.SavedView{BaseModel: domain.BaseModel{ID: viewID}, ProjectID: projectID, CreatedBy: userID}, nil)
+	projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{}, nil)
+
+	_, err := s.GetGroupBoards(userID.String(), viewID.String(), noneGroupKey, "", "", 20)
+	assertStatus(t, err, 400)
+}
+
+func TestResolveSwimlanes(t *testing.T) {
+	fields := testProjectFields()
+	fieldRepo := new(testutil.MockFieldRepository)
+	fieldRepo.On("FindOptionsByField", fields[2].ID).Return([]domain.FieldOption{}, nil)
+	s := &viewService{repo: fieldRepo, logger: zap.NewNop()}
+
+	columns, err := s.resolveGrouping(&domain.ViewGrouping{Key: fields[4].ID.String()}, fields)
+	require.NoError(t, err)
+
+	// Multi-value swimlanes are expanded under their own alias
+	lanes, err := s.resolveSwimlanes(columns, &domain.ViewGrouping{Key: fields[2].ID.String()}, fields)
+	require.NoError(t, err)
+	assert.Equal(t, "lane.value", lanes.keyExpr())
+	assert.Contains(t, lanes.joinClause(), "AS lane ON true")
+
+	_, err = s.resolveSwimlanes(columns, &domain.ViewGrouping{Key: fields[4].ID.String()}, fields)
+	assertStatus(t, err, 400)
+
+	assignee, err := s.resolveGrouping(&domain.ViewGrouping{Key: "assignee"}, fields)
+	require.NoError(t, err)
+	_, err = s.resolveSwimlanes(assignee, &domain.ViewGrouping{Key: "assignee_id"}, fields)
+	assertStatus(t, err, 400)
+}
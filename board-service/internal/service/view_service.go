@@ -2,6 +2,7 @@ package service
 
 import (
 	"board-service/internal/apperrors"
+	"board-service/internal/boardquery"
 	"board-service/internal/cache"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
@@ -9,8 +10,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
-	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -27,36 +27,57 @@ type ViewService interface {
 
 	// Apply view (filter + sort + group)
 	ApplyView(userID, viewID string, page, limit int) (interface{}, error)
-	ApplyViewWithFilters(userID, projectID, viewID string, filters map[string]interface{}, sortBy, sortDir string, groupByFieldID *string, page, limit int) (interface{}, error)
+	ApplyViewWithFilters(userID, projectID, viewID string, filters map[string]interface{}, sorts []domain.SortKey, grouping, swimlanes *domain.ViewGrouping, page, limit int) (interface{}, error)
+	GetGroupBoards(userID, viewID, groupKey, swimlaneKey, cursor string, limit int) (*dto.BoardGroup, error)
+
+	// Calendar view
+	GetCalendar(userID, viewID string, req *dto.GetCalendarRequest) (*dto.CalendarResponse, error)
+	MoveCalendarBoard(userID, viewID, boardID string, req *dto.MoveCalendarBoardRequest) (*dto.MoveCalendarBoardResponse, error)
+
+	// Timeline view
+	GetTimeline(userID, viewID string, req *dto.GetTimelineRequest) (*dto.TimelineResponse, error)
+
+	// Table view settings of the current user
+	UpdateMyTableSettings(userID, viewID string, req *dto.TableSettings) (*dto.TableLayout, error)
+	ResetMyTableSettings(userID, viewID string) (*dto.TableLayout, error)
+
+	// Query boards with the text query language
+	QueryBoards(userID, projectID, q string, page, limit int) (interface{}, error)
 
 	// Board order management
 	UpdateBoardOrder(userID string, req *dto.UpdateBoardOrderRequest) error
 }
 
 type viewService struct {
-	repo        repository.FieldRepository
-	boardRepo   repository.BoardRepository
-	projectRepo repository.ProjectRepository
-	cache       cache.FieldCache
-	logger      *zap.Logger
-	db          *gorm.DB
+	repo          repository.FieldRepository
+	boardRepo     repository.BoardRepository
+	projectRepo   repository.ProjectRepository
+	checklistRepo repository.ChecklistRepository
+	boardService  BoardService // Calendar moves edit boards like any other edit
+	cache         cache.FieldCache
+	logger        *zap.Logger
+	db            *gorm.DB
 }
 
 func NewViewService(
 	repo repository.FieldRepository,
 	boardRepo repository.BoardRepository,
 	projectRepo repository.ProjectRepository,
+	checklistRepo repository.ChecklistRepository,
+	boardService BoardService,
 	cache cache.FieldCache,
 	logger *zap.Logger,
 	db *gorm.DB,
 ) ViewService {
 	return &viewService{
-		repo:        repo,
-		boardRepo:   boardRepo,
-		projectRepo: projectRepo,
-		cache:       cache,
-		logger:      logger,
-		db:          db,
+		repo:          repo,
+		boardRepo:     boardRepo,
+		projectRepo:   projectRepo,
+		checklistRepo: checklistRepo,
+		boardService:  boardService,
+		cache:         cache,
+		logger:        logger,
+		db:            db,
 	}
 }
 
@@ -82,29 +103,56 @@ func (s *viewService) CreateView(userID string, req *dto.CreateViewRequest) (*dt
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
 	}
 
-	// Serialize filters
+	// Sort keys (the legacy sortBy/sortDirection pair is a single key)
+	sorts := toSortKeys(req.Sorts)
+	if len(sorts) == 0 && req.SortBy != "" {
+		sorts = []domain.SortKey{{Field: req.SortBy, Direction: req.SortDirection}}
+	}
+
+	// Validate filters and sort keys, then serialize filters
+	sorts, err = s.validateViewSettings(projectUUID, req.Filters, sorts)
+	if err != nil {
+		return nil, err
+	}
 	filtersJSON, err := json.Marshal(req.Filters)
 	if err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "필터가 유효하지 않습니다", 400)
 	}
 
-	// Validate group by field if specified
-	var groupByFieldID *uuid.UUID
-	if req.GroupByFieldID != "" {
-		fieldUUID, err := uuid.Parse(req.GroupByFieldID)
-		if err != nil {
-			return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 그룹핑 필드 ID", 400)
+	// Validate grouping and swimlanes if specified (the legacy groupByFieldId is a custom field key)
+	grouping := requestedGrouping(req)
+	var swimlanes *domain.ViewGrouping
+	if req.SwimlaneBy != "" {
+		swimlanes = &domain.ViewGrouping{Key: req.SwimlaneBy, Bucket: req.SwimlaneBucket}
+	}
+	if grouping != nil || swimlanes != nil {
+		if err := s.validateViewGrouping(projectUUID, grouping, swimlanes); err != nil {
+			return nil, err
 		}
+	}
 
-		// Verify field exists and is single-select or multi-select
-		field, err := s.repo.FindFieldByID(fieldUUID)
-		if err != nil {
-			return nil, apperrors.New(apperrors.ErrCodeBadRequest, "그룹핑 필드를 찾을 수 없습니다", 400)
+	// Validate calendar fields if specified
+	if req.CalendarField != "" || req.CalendarStart != "" {
+		dateKey := req.CalendarField
+		if dateKey == "" {
+			dateKey = domain.CalendarDueDate
+		}
+		if err := s.validateViewCalendar(projectUUID, dateKey, req.CalendarStart); err != nil {
+			return nil, err
 		}
-		if field.FieldType != domain.FieldTypeSingleSelect && field.FieldType != domain.FieldTypeMultiSelect {
-			return nil, apperrors.New(apperrors.ErrCodeBadRequest, "Single-select 또는 Multi-select 필드만 그룹핑에 사용할 수 있습니다", 400)
+	}
+
+	// Validate table settings if specified
+	var table domain.TableSettings
+	if req.TableSettings != nil {
+		table = toTableSettings(req.TableSettings)
+		if err := s.validateViewTable(projectUUID, table); err != nil {
+			return nil, err
 		}
-		groupByFieldID = &fieldUUID
+	}
+	viewType := req.ViewType
+	if viewType == "" {
+		viewType = domain.ViewTypeKanban
 	}
 
 	// Determine IsShared value (default: true if not specified)
@@ -115,19 +163,24 @@ func (s *viewService) CreateView(userID string, req *dto.CreateViewRequest) (*dt
 
 	// Create view
 	view := &domain.SavedView{
-		ProjectID:      projectUUID,
-		CreatedBy:      userUUID,
-		Name:           req.Name,
-		Description:    req.Description,
-		IsDefault:      req.IsDefault,
-		IsShared:       isShared, // Default: true (team-shared view)
-		Filters:        string(filtersJSON),
-		SortDirection:  req.SortDirection,
-		GroupByFieldID: groupByFieldID,
-	}
-
-	if req.SortBy != "" {
-		view.SortBy = &req.SortBy
+		ProjectID:     projectUUID,
+		CreatedBy:     userUUID,
+		Name:          req.Name,
+		Description:   req.Description,
+		IsDefault:     req.IsDefault,
+		IsShared:      isShared, // Default: true (team-shared view)
+		Filters:       string(filtersJSON),
+		CalendarField: req.CalendarField,
+		CalendarStart: req.CalendarStart,
+		ViewType:      viewType,
+	}
+	if err := view.SetTable(table); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "테이블 설정이 유효하지 않습니다", 400)
+	}
+	view.SetGrouping(grouping)
+	view.SetSwimlanes(swimlanes)
+	if err := view.SetSortKeys(sorts); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "정렬 기준이 유효하지 않습니다", 400)
 	}
 
 	if err := s.repo.CreateView(view); err != nil {
@@ -255,27 +308,64 @@ func (s *viewService) UpdateView(userID, viewID string, req *dto.UpdateViewReque
 		view.IsShared = *req.IsShared
 	}
 	if req.Filters != nil {
+		if _, err := s.validateViewSettings(view.ProjectID, req.Filters, nil); err != nil {
+			return nil, err
+		}
 		filtersJSON, err := json.Marshal(req.Filters)
 		if err != nil {
 			return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "필터가 유효하지 않습니다", 400)
 		}
 		view.Filters = string(filtersJSON)
 	}
-	if req.SortBy != nil {
-		view.SortBy = req.SortBy
+	if sorts, changed := updatedSortKeys(view, req); changed {
+		sorts, err := s.validateViewSettings(view.ProjectID, nil, sorts)
+		if err != nil {
+			return nil, err
+		}
+		if err := view.SetSortKeys(sorts); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "정렬 기준이 유효하지 않습니다", 400)
+		}
 	}
-	if req.SortDirection != "" {
-		view.SortDirection = req.SortDirection
+	groupBy := req.GroupBy
+	if groupBy == nil {
+		groupBy = req.GroupByFieldID
 	}
-	if req.GroupByFieldID != nil {
-		if *req.GroupByFieldID == "" {
-			view.GroupByFieldID = nil
-		} else {
-			fieldUUID, err := uuid.Parse(*req.GroupByFieldID)
-			if err != nil {
-				return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 그룹핑 필드 ID", 400)
+	grouping, groupingChanged := updatedGrouping(view.Grouping(), groupBy, req.GroupByBucket)
+	swimlanes, swimlanesChanged := updatedGrouping(view.Swimlanes(), req.SwimlaneBy, req.SwimlaneBucket)
+	if groupingChanged && grouping == nil && !swimlanesChanged {
+		// Swimlanes only exist within groups
+		swimlanes, swimlanesChanged = nil, view.Swimlanes() != nil
+	}
+	if groupingChanged || swimlanesChanged {
+		if grouping != nil || swimlanes != nil {
+			if err := s.validateViewGrouping(view.ProjectID, grouping, swimlanes); err != nil {
+				return nil, err
 			}
-			view.GroupByFieldID = &fieldUUID
+		}
+		view.SetGrouping(grouping)
+		view.SetSwimlanes(swimlanes)
+	}
+	if req.CalendarField != nil || req.CalendarStart != nil {
+		if req.CalendarField != nil {
+			view.CalendarField = *req.CalendarField
+		}
+		if req.CalendarStart != nil {
+			view.CalendarStart = *req.CalendarStart
+		}
+		if err := s.validateViewCalendar(view.ProjectID, view.CalendarDateKey(), view.CalendarStart); err != nil {
+			return nil, err
+		}
+	}
+	if req.ViewType != nil {
+		view.ViewType = *req.ViewType
+	}
+	if req.TableSettings != nil {
+		table := toTableSettings(req.TableSettings)
+		if err := s.validateViewTable(view.ProjectID, table); err != nil {
+			return nil, err
+		}
+		if err := view.SetTable(table); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "테이블 설정이 유효하지 않습니다", 400)
 		}
 	}
 
@@ -337,6 +427,86 @@ func (s *viewService) ApplyView(userID, viewID string, page, limit int) (interfa
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 뷰 ID", 400)
 	}
 
+	view, err := s.findAccessibleView(userUUID, viewUUID)
+	if err != nil {
+		return nil, err
+	}
+	filters, sorts := s.viewSettings(view)
+
+	result, err := s.ApplyViewWithFilters(userID, view.ProjectID.String(), viewUUID.String(), filters, sorts, view.Grouping(), view.Swimlanes(), page, limit)
+	if err != nil {
+		return nil, err
+	}
+	layout, err := s.tableLayout(view, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	return withTableLayout(result, view.ViewType, layout), nil
+}
+
+// GetGroupBoards returns the next boards of one group of a grouped view, or of one cell when swimlaneKey is set
+// Keys are the groups' keys ("(none)" for boards without a value); an empty cursor starts at the first board.
+func (s *viewService) GetGroupBoards(userID, viewID, groupKey, swimlaneKey, cursor string, limit int) (*dto.BoardGroup, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	viewUUID, err := uuid.Parse(viewID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 뷰 ID", 400)
+	}
+
+	var after *groupCursor
+	if cursor != "" {
+		if after, err = decodeGroupCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+	if limit < 1 {
+		limit = 20
+	}
+
+	view, err := s.findAccessibleView(userUUID, viewUUID)
+	if err != nil {
+		return nil, err
+	}
+	grouping := view.Grouping()
+	if grouping == nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "그룹핑되지 않은 뷰입니다", 400)
+	}
+
+	filters, sorts := s.viewSettings(view)
+	q, err := s.prepareViewQuery(userUUID, view.ProjectID, viewUUID, filters, sorts)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := s.resolveGrouping(grouping, q.fields)
+	if err != nil {
+		return nil, err
+	}
+	if !spec.validKey(groupKey) {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "그룹을 찾을 수 없습니다", 404)
+	}
+
+	var lanes *viewGrouping
+	if swimlaneKey != "" {
+		if view.Swimlanes() == nil {
+			return nil, apperrors.New(apperrors.ErrCodeBadRequest, "스윔레인이 없는 뷰입니다", 400)
+		}
+		if lanes, err = s.resolveSwimlanes(spec, view.Swimlanes(), q.fields); err != nil {
+			return nil, err
+		}
+		if !lanes.validKey(swimlaneKey) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "스윔레인을 찾을 수 없습니다", 404)
+		}
+	}
+
+	return s.groupPage(q, spec, groupKey, lanes, swimlaneKey, after, limit)
+}
+
+// findAccessibleView fetches a view the user may apply: shared or their own, in a project they are a member of
+func (s *viewService) findAccessibleView(userUUID, viewUUID uuid.UUID) (*domain.SavedView, error) {
 	// Fetch view
 	view, err := s.repo.FindViewByID(viewUUID)
 	if err != nil {
@@ -360,6 +530,11 @@ func (s *viewService) ApplyView(userID, viewID string, page, limit int) (interfa
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
 	}
 
+	return view, nil
+}
+
+// viewSettings returns the saved filters and sort keys of a view; unreadable settings are logged and ignored
+func (s *viewService) viewSettings(view *domain.SavedView) (map[string]interface{}, []domain.SortKey) {
 	// Parse filters
 	var filters map[string]interface{}
 	if view.Filters != "" && view.Filters != "{}" {
@@ -369,21 +544,15 @@ func (s *viewService) ApplyView(userID, viewID string, page, limit int) (interfa
 		}
 	}
 
-	var sortBy string
-	if view.SortBy != nil {
-		sortBy = *view.SortBy
-	}
-
-	var groupByFieldIDStr *string
-	if view.GroupByFieldID != nil {
-		str := view.GroupByFieldID.String()
-		groupByFieldIDStr = &str
+	sorts, err := view.SortKeys()
+	if err != nil {
+		s.logger.Warn("Failed to parse view sorts", zap.Error(err))
+		sorts = nil
 	}
-
-	return s.ApplyViewWithFilters(userID, view.ProjectID.String(), viewUUID.String(), filters, sortBy, view.SortDirection, groupByFieldIDStr, page, limit)
+	return filters, sorts
 }
 
-func (s *viewService) ApplyViewWithFilters(userID, projectID, viewID string, filters map[string]interface{}, sortBy, sortDir string, groupByFieldID *string, page, limit int) (interface{}, error) {
+func (s *viewService) ApplyViewWithFilters(userID, projectID, viewID string, filters map[string]interface{}, sorts []domain.SortKey, grouping, swimlanes *domain.ViewGrouping, page, limit int) (interface{}, error) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
@@ -399,57 +568,14 @@ func (s *viewService) ApplyViewWithFilters(userID, projectID, viewID string, fil
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 뷰 ID", 400)
 	}
 
-	// Check project membership
-	_, err = s.projectRepo.FindMemberByUserAndProject(userUUID, projectUUID)
+	q, err := s.prepareViewQuery(userUUID, projectUUID, viewUUID, filters, sorts)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
-		}
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
-	}
-
-	// Build query with filters
-	query := s.db.Model(&domain.Board{}).Where("project_id = ? AND is_deleted = ?", projectUUID, false)
-
-	// Apply filters
-	for fieldIDStr, filterConfig := range filters {
-		// Parse filter condition
-		filterMap, ok := filterConfig.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		operator, _ := filterMap["operator"].(string)
-		value := filterMap["value"]
-
-		// Special handling for built-in fields
-		if fieldIDStr == "title" {
-			query = s.applyBuiltInFilter(query, "title", operator, value)
-			continue
-		}
-
-		// Custom field filtering via custom_fields_cache
-		fieldUUID, err := uuid.Parse(fieldIDStr)
-		if err != nil {
-			continue
-		}
-
-		query = s.applyCustomFieldFilter(query, fieldUUID, operator, value)
-	}
-
-	// Apply sorting
-	if sortBy != "" {
-		if sortDir == "" {
-			sortDir = "asc"
-		}
-		query = query.Order(fmt.Sprintf("%s %s", sortBy, strings.ToUpper(sortDir)))
-	} else {
-		query = query.Order("created_at DESC")
+		return nil, err
 	}
 
 	// Count total
 	var total int64
-	if err := query.Count(&total).Error; err != nil {
+	if err := q.filteredBoards().Count(&total).Error; err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 카운트 실패", 500)
 	}
 
@@ -462,26 +588,119 @@ func (s *viewService) ApplyViewWithFilters(userID, projectID, viewID string, fil
 	}
 	offset := (page - 1) * limit
 
+	// If grouping requested, group the whole filtered set (limit applies per group, or per cell with swimlanes)
+	if swimlanes != nil && grouping == nil {
+		return nil, groupingError("스윔레인은 그룹핑과 함께 사용해야 합니다")
+	}
+	if grouping != nil {
+		spec, err := s.resolveGrouping(grouping, q.fields)
+		if err != nil {
+			return nil, err
+		}
+		if swimlanes == nil {
+			return s.groupBoards(q, spec, limit, total)
+		}
+		lanes, err := s.resolveSwimlanes(spec, swimlanes, q.fields)
+		if err != nil {
+			return nil, err
+		}
+		return s.swimlaneBoards(q, spec, lanes, limit, total)
+	}
+
 	// Fetch boards
 	var boards []domain.Board
-	if err := query.Offset(offset).Limit(limit).Find(&boards).Error; err != nil {
+	if err := q.filteredBoards().Order(q.order).Offset(offset).Limit(limit).Find(&boards).Error; err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
 	}
 
-	// If grouping requested, apply grouping
-	if groupByFieldID != nil && *groupByFieldID != "" {
-		return s.applyGrouping(boards, *groupByFieldID, total)
-	}
-
-	// Fetch board positions for this view and user
 	boardIDs := make([]uuid.UUID, len(boards))
 	for i, board := range boards {
 		boardIDs[i] = board.ID
 	}
 
+	// Fetch checklist progress and board positions for this view and user
+	progressMap, positionMap := s.loadBoardDetails(viewUUID, userUUID, boardIDs)
+
+	// Return paginated results
+	boardResponses := s.toBoardResponses(boards, positionMap, progressMap)
+
+	return map[string]interface{}{
+		"boards": boardResponses,
+		"total":  total,
+		"page":   page,
+		"limit":  limit,
+	}, nil
+}
+
+// viewQuery is the filtered and ordered board query of a view
+type viewQuery struct {
+	viewID         uuid.UUID
+	userID         uuid.UUID
+	fields         []domain.ProjectField
+	filteredBoards func() *gorm.DB // New query over the filtered boards (unordered)
+	order          string
+}
+
+// prepareViewQuery checks project membership and compiles the view's filters and sort keys
+func (s *viewService) prepareViewQuery(userUUID, projectUUID, viewUUID uuid.UUID, filters map[string]interface{}, sorts []domain.SortKey) (*viewQuery, error) {
+	// Check project membership
+	_, err := s.projectRepo.FindMemberByUserAndProject(userUUID, projectUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
+	}
+
+	fields, err := s.repo.FindFieldsByProject(projectUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+
+	// Apply filters (invalid filters are rejected, not ignored; only conditions on deleted fields are skipped)
+	clause, skipped, err := compileSavedViewFilters(filters, fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(skipped) > 0 {
+		s.logger.Info("Skipped view filters on deleted fields",
+			zap.String("view_id", viewUUID.String()),
+			zap.Strings("fields", skipped))
+	}
+
+	// Apply sorting (whitelisted keys only; keys on deleted fields are dropped like their filters)
+	sorts, dropped := withoutMissingSortFields(sorts, fields)
+	if len(dropped) > 0 {
+		s.logger.Info("Dropped view sort keys on deleted fields",
+			zap.String("view_id", viewUUID.String()),
+			zap.Strings("fields", dropped))
+	}
+	order, err := s.buildBoardOrder(sorts, fields, &manualSort{viewID: viewUUID, userID: userUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	q := &viewQuery{viewID: viewUUID, userID: userUUID, fields: fields, order: order}
+	q.filteredBoards = func() *gorm.DB {
+		query := s.db.Model(&domain.Board{}).Where("project_id = ? AND is_deleted = ?", projectUUID, false)
+		if clause != nil {
+			query = query.Where(clause.sql, clause.args...)
+		}
+		return query
+	}
+	return q, nil
+}
+
+// loadBoardDetails fetches the checklist progress of the boards and the user's positions of them in the view
+func (s *viewService) loadBoardDetails(viewID, userID uuid.UUID, boardIDs []uuid.UUID) (map[uuid.UUID]domain.ChecklistProgress, map[uuid.UUID]string) {
+	return loadChecklistProgress(s.checklistRepo, s.logger, boardIDs), s.loadBoardPositions(viewID, userID, boardIDs)
+}
+
+// loadBoardPositions returns the user's manual positions of the boards in the view
+func (s *viewService) loadBoardPositions(viewID, userID uuid.UUID, boardIDs []uuid.UUID) map[uuid.UUID]string {
 	var userBoardOrders []domain.UserBoardOrder
 	if len(boardIDs) > 0 {
-		s.db.Where("view_id = ? AND user_id = ? AND board_id IN ?", viewUUID, userUUID, boardIDs).
+		s.db.Where("view_id = ? AND user_id = ? AND board_id IN ?", viewID, userID, boardIDs).
 			Find(&userBoardOrders)
 	}
 
@@ -490,45 +709,111 @@ func (s *viewService) ApplyViewWithFilters(userID, projectID, viewID string, fil
 	for _, order := range userBoardOrders {
 		positionMap[order.BoardID] = order.Position
 	}
+	return positionMap
+}
 
-	// Return paginated results
-	boardResponses := make([]dto.BoardResponse, 0, len(boards))
-	for _, board := range boards {
-		// Parse custom_fields_cache
-		var customFields map[string]interface{}
-		if board.CustomFieldsCache != "" && board.CustomFieldsCache != "{}" {
-			if err := json.Unmarshal([]byte(board.CustomFieldsCache), &customFields); err != nil {
-				s.logger.Warn("Failed to parse custom_fields_cache", zap.Error(err), zap.String("board_id", board.ID.String()))
-				customFields = make(map[string]interface{})
-			}
-		} else {
-			customFields = make(map[string]interface{})
+// ==================== Board Query ====================
+
+// QueryBoards runs a text query (see package boardquery) through the same filter compiler as saved views
+func (s *viewService) QueryBoards(userID, projectID, q string, page, limit int) (interface{}, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 프로젝트 ID", 400)
+	}
+
+	// Check project membership
+	_, err = s.projectRepo.FindMemberByUserAndProject(userUUID, projectUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
 		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
+	}
 
-		// Get position from map
-		position := positionMap[board.ID]
+	fields, err := s.repo.FindFieldsByProject(projectUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
 
-		// Simplified board response (can be enhanced with full details)
-		boardResponses = append(boardResponses, dto.BoardResponse{
-			ID:           board.ID.String(),
-			ProjectID:    board.ProjectID.String(),
-			Title:        board.Title,
-			Content:      board.Description,
-			CustomFields: customFields,
-			Position:     position, // Include position from user_board_order
-			CreatedAt:    board.CreatedAt,
-			UpdatedAt:    board.UpdatedAt,
-		})
+	parsed, err := boardquery.Parse(q)
+	if err != nil {
+		return nil, toQueryError(err)
+	}
+	compiled, err := boardquery.Compile(parsed, boardquery.Schema{
+		Fields:  fields,
+		Options: s.repo.FindOptionsByField,
+	}, boardquery.Env{UserID: userUUID, Now: time.Now()})
+	if err != nil {
+		return nil, toQueryError(err)
+	}
+
+	clause, err := compileFilterTree(compiled.Filter, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	sorts := make([]domain.SortKey, 0, len(compiled.OrderBy))
+	for _, key := range compiled.OrderBy {
+		direction := domain.SortDirectionAsc
+		if key.Desc {
+			direction = domain.SortDirectionDesc
+		}
+		sorts = append(sorts, domain.SortKey{Field: key.Field, Direction: direction})
+	}
+	order, err := s.buildBoardOrder(sorts, fields, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.db.Model(&domain.Board{}).Where("project_id = ? AND is_deleted = ?", projectUUID, false)
+	if clause != nil {
+		query = query.Where(clause.sql, clause.args...)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 카운트 실패", 500)
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+
+	var boards []domain.Board
+	if err := query.Order(order).Offset((page - 1) * limit).Limit(limit).Find(&boards).Error; err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	boardIDs := make([]uuid.UUID, len(boards))
+	for i, board := range boards {
+		boardIDs[i] = board.ID
 	}
 
 	return map[string]interface{}{
-		"boards": boardResponses,
+		"boards": s.toBoardResponses(boards, nil, loadChecklistProgress(s.checklistRepo, s.logger, boardIDs)),
 		"total":  total,
 		"page":   page,
 		"limit":  limit,
 	}, nil
 }
 
+// toQueryError converts query syntax and name errors to 400, keeping the position in the message
+func toQueryError(err error) error {
+	var queryErr *boardquery.Error
+	if errors.As(err, &queryErr) {
+		return apperrors.Wrap(err, apperrors.ErrCodeQuerySyntax, "쿼리 오류 ("+queryErr.Error()+")", 400)
+	}
+	return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "쿼리 처리 실패", 500)
+}
+
 // ==================== Board Order Management ====================
 
 func (s *viewService) UpdateBoardOrder(userID string, req *dto.UpdateBoardOrderRequest) error {
@@ -586,6 +871,40 @@ func (s *viewService) UpdateBoardOrder(userID string, req *dto.UpdateBoardOrderR
 
 // ==================== Helper Methods ====================
 
+// toBoardResponses builds the simplified board responses of view results
+func (s *viewService) toBoardResponses(boards []domain.Board, positionMap map[uuid.UUID]string, progressMap map[uuid.UUID]domain.ChecklistProgress) []dto.BoardResponse {
+	boardResponses := make([]dto.BoardResponse, 0, len(boards))
+	for _, board := range boards {
+		// Parse custom_fields_cache
+		var customFields map[string]interface{}
+		if board.CustomFieldsCache != "" && board.CustomFieldsCache != "{}" {
+			if err := json.Unmarshal([]byte(board.CustomFieldsCache), &customFields); err != nil {
+				s.logger.Warn("Failed to parse custom_fields_cache", zap.Error(err), zap.String("board_id", board.ID.String()))
+				customFields = make(map[string]interface{})
+			}
+		} else {
+			customFields = make(map[string]interface{})
+		}
+
+		// Get position from map
+		position := positionMap[board.ID]
+
+		// Simplified board response (can be enhanced with full details)
+		boardResponses = append(boardResponses, dto.BoardResponse{
+			ID:           board.ID.String(),
+			ProjectID:    board.ProjectID.String(),
+			Title:        board.Title,
+			Content:      board.Description,
+			CustomFields: customFields,
+			Position:     position, // Include position from user_board_order
+			Checklist:    toChecklistProgress(progressMap[board.ID]),
+			CreatedAt:    board.CreatedAt,
+			UpdatedAt:    board.UpdatedAt,
+		})
+	}
+	return boardResponses
+}
+
 func (s *viewService) buildViewResponse(view *domain.SavedView) *dto.ViewResponse {
 	var filters map[string]interface{}
 	if view.Filters != "" && view.Filters != "{}" {
@@ -606,6 +925,24 @@ func (s *viewService) buildViewResponse(view *domain.SavedView) *dto.ViewRespons
 	if view.GroupByFieldID != nil {
 		groupByFieldID = view.GroupByFieldID.String()
 	}
+	var groupBy, groupByBucket string
+	if grouping := view.Grouping(); grouping != nil {
+		groupBy, groupByBucket = grouping.Key, grouping.Bucket
+	}
+
+	sorts, err := view.SortKeys()
+	if err != nil {
+		s.logger.Warn("Failed to parse view sorts", zap.Error(err))
+	}
+	sortResponses := make([]dto.ViewSortKey, 0, len(sorts))
+	for _, key := range sorts {
+		sortResponses = append(sortResponses, dto.ViewSortKey{Field: key.Field, Direction: key.Direction})
+	}
+
+	table, err := view.Table()
+	if err != nil {
+		s.logger.Warn("Failed to parse view table settings", zap.Error(err))
+	}
 
 	return &dto.ViewResponse{
 		ViewID:         view.ID.String(),
@@ -618,135 +955,127 @@ func (s *viewService) buildViewResponse(view *domain.SavedView) *dto.ViewRespons
 		Filters:        filters,
 		SortBy:         sortBy,
 		SortDirection:  view.SortDirection,
+		Sorts:          sortResponses,
 		GroupByFieldID: groupByFieldID,
+		GroupBy:        groupBy,
+		GroupByBucket:  groupByBucket,
+		SwimlaneBy:     view.SwimlaneBy,
+		SwimlaneBucket: view.SwimlaneBucket,
+		CalendarField:  view.CalendarDateKey(),
+		CalendarStart:  view.CalendarStart,
+		ViewType:       view.ViewType,
+		TableSettings:  toTableSettingsResponse(table),
 		CreatedAt:      view.CreatedAt,
 		UpdatedAt:      view.UpdatedAt,
 	}
 }
 
-func (s *viewService) applyBuiltInFilter(query *gorm.DB, field, operator string, value interface{}) *gorm.DB {
-	switch operator {
-	case "contains":
-		if strVal, ok := value.(string); ok {
-			return query.Where(field+" LIKE ?", "%"+strVal+"%")
-		}
-	case "eq":
-		return query.Where(field+" = ?", value)
-	case "ne":
-		return query.Where(field+" != ?", value)
+// validateViewSettings checks filters and sort keys against the project fields before a view is saved
+// and returns the normalized sort keys
+func (s *viewService) validateViewSettings(projectID uuid.UUID, filters map[string]interface{}, sorts []domain.SortKey) ([]domain.SortKey, error) {
+	if len(filters) == 0 && len(sorts) == 0 {
+		return sorts, nil
 	}
-	return query
-}
 
-func (s *viewService) applyCustomFieldFilter(query *gorm.DB, fieldID uuid.UUID, operator string, value interface{}) *gorm.DB {
-	// Use JSONB operators on custom_fields_cache
-	fieldKey := fieldID.String()
-
-	switch operator {
-	case "contains":
-		if strVal, ok := value.(string); ok {
-			return query.Where("custom_fields_cache->? LIKE ?", fieldKey, "%"+strVal+"%")
-		}
-	case "in":
-		if arr, ok := value.([]interface{}); ok {
-			return query.Where("custom_fields_cache->? ?| ARRAY[?]", fieldKey, arr)
-		}
-	case "eq":
-		return query.Where("custom_fields_cache->>? = ?", fieldKey, value)
+	fields, err := s.repo.FindFieldsByProject(projectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
 	}
 
-	return query
+	if _, err := compileViewFilters(filters, fields); err != nil {
+		return nil, err
+	}
+	return normalizeSortKeys(sorts, fields, true)
 }
 
-func (s *viewService) applyGrouping(boards []domain.Board, groupByFieldID string, total int64) (interface{}, error) {
-	fieldUUID, err := uuid.Parse(groupByFieldID)
-	if err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 그룹핑 필드 ID", 400)
+// validateViewGrouping checks the grouping and swimlanes against the project fields before a view is saved
+func (s *viewService) validateViewGrouping(projectID uuid.UUID, grouping, swimlanes *domain.ViewGrouping) error {
+	if grouping == nil {
+		return groupingError("스윔레인은 그룹핑과 함께 사용해야 합니다")
 	}
 
-	// Fetch field
-	field, err := s.repo.FindFieldByID(fieldUUID)
+	fields, err := s.repo.FindFieldsByProject(projectID)
 	if err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "그룹핑 필드 조회 실패", 500)
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
 	}
-
-	// Fetch options for the field
-	options, err := s.repo.FindOptionsByField(fieldUUID)
+	spec, err := s.resolveGrouping(grouping, fields)
 	if err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 조회 실패", 500)
+		return err
 	}
+	if swimlanes != nil {
+		_, err = s.resolveSwimlanes(spec, swimlanes, fields)
+	}
+	return err
+}
 
-	// Group boards by option
-	groups := make(map[string][]dto.BoardResponse)
-	for _, board := range boards {
-		// Parse custom_fields_cache
-		var cache map[string]interface{}
-		if board.CustomFieldsCache != "" && board.CustomFieldsCache != "{}" {
-			if err := json.Unmarshal([]byte(board.CustomFieldsCache), &cache); err == nil {
-				// Get field value
-				if fieldVal, exists := cache[groupByFieldID]; exists {
-					// Handle array values (multi-select)
-					if arr, ok := fieldVal.([]interface{}); ok {
-						for _, optionID := range arr {
-							optionIDStr := fmt.Sprintf("%v", optionID)
-							groups[optionIDStr] = append(groups[optionIDStr], dto.BoardResponse{
-								ID:           board.ID.String(),
-								ProjectID:    board.ProjectID.String(),
-								Title:        board.Title,
-								Content:      board.Description,
-								CustomFields: cache,
-								CreatedAt:    board.CreatedAt,
-								UpdatedAt:    board.UpdatedAt,
-							})
-						}
-					} else {
-						// Single value
-						optionIDStr := fmt.Sprintf("%v", fieldVal)
-						groups[optionIDStr] = append(groups[optionIDStr], dto.BoardResponse{
-							ID:           board.ID.String(),
-							ProjectID:    board.ProjectID.String(),
-							Title:        board.Title,
-							Content:      board.Description,
-							CustomFields: cache,
-							CreatedAt:    board.CreatedAt,
-							UpdatedAt:    board.UpdatedAt,
-						})
-					}
-				}
-			}
+// requestedGrouping returns the grouping of a new view, or nil when it is not grouped
+func requestedGrouping(req *dto.CreateViewRequest) *domain.ViewGrouping {
+	if req.GroupBy != "" {
+		return &domain.ViewGrouping{Key: req.GroupBy, Bucket: req.GroupByBucket}
+	}
+	if req.GroupByFieldID != "" {
+		return &domain.ViewGrouping{Key: req.GroupByFieldID, Bucket: req.GroupByBucket}
+	}
+	return nil
+}
+
+// updatedGrouping returns the grouping (or swimlanes) requested by an update, if it changes it
+// key replaces the key and "" removes the grouping; bucket alone changes the bucket.
+func updatedGrouping(current *domain.ViewGrouping, key, bucket *string) (*domain.ViewGrouping, bool) {
+	if key == nil {
+		if bucket == nil || current == nil {
+			return current, false
 		}
+		return &domain.ViewGrouping{Key: current.Key, Bucket: *bucket}, true
+	}
+	if *key == "" {
+		return nil, true
 	}
 
-	// Build response
-	fieldResponse := dto.FieldResponse{
-		FieldID:   field.ID.String(),
-		ProjectID: field.ProjectID.String(),
-		Name:      field.Name,
-		FieldType: string(field.FieldType),
+	grouping := &domain.ViewGrouping{Key: *key}
+	if bucket != nil {
+		grouping.Bucket = *bucket
+	} else if current != nil && current.Key == *key {
+		grouping.Bucket = current.Bucket
 	}
+	return grouping, true
+}
 
-	groupResponses := make([]dto.BoardGroup, 0)
-	for _, option := range options {
-		optionIDStr := option.ID.String()
-		boardsInGroup := groups[optionIDStr]
-		if boardsInGroup == nil {
-			boardsInGroup = make([]dto.BoardResponse, 0)
-		}
+// updatedSortKeys returns the sort keys requested by an update, if it changes them
+// sorts replaces all keys; the legacy sortBy/sortDirection pair replaces them with a single key.
+func updatedSortKeys(view *domain.SavedView, req *dto.UpdateViewRequest) ([]domain.SortKey, bool) {
+	if req.Sorts != nil {
+		return toSortKeys(req.Sorts), true
+	}
+	if req.SortBy == nil && req.SortDirection == "" {
+		return nil, false
+	}
 
-		groupResponses = append(groupResponses, dto.BoardGroup{
-			GroupValue: map[string]interface{}{
-				"option_id": option.ID.String(),
-				"label":     option.Label,
-				"color":     option.Color,
-			},
-			Boards: boardsInGroup,
-			Count:  len(boardsInGroup),
-		})
+	sortBy := ""
+	if view.SortBy != nil {
+		sortBy = *view.SortBy
+	}
+	if req.SortBy != nil {
+		sortBy = *req.SortBy
+	}
+	if sortBy == "" {
+		return []domain.SortKey{}, true
 	}
 
-	return dto.GroupedBoardsResponse{
-		GroupByField: fieldResponse,
-		Groups:       groupResponses,
-		Total:        total,
-	}, nil
+	direction := view.SortDirection
+	if req.SortDirection != "" {
+		direction = req.SortDirection
+	}
+	return []domain.SortKey{{Field: sortBy, Direction: direction}}, true
+}
+
+func toSortKeys(keys []dto.ViewSortKey) []domain.SortKey {
+	if keys == nil {
+		return nil
+	}
+	sorts := make([]domain.SortKey, 0, len(keys))
+	for _, key := range keys {
+		sorts = append(sorts, domain.SortKey{Field: key.Field, Direction: key.Direction})
+	}
+	return sorts
 }
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ==================== View Sorting ====================
+// Sort keys are resolved against a whitelist of board columns and the project fields and compiled
+// to typed expressions, so client text never reaches the ORDER BY clause.
+
+// builtInSortKeys maps the sortable built-in keys to board columns
+var builtInSortKeys = map[string]string{
+	"title":       "title",
+	"created_at":  "created_at",
+	"updated_at":  "updated_at",
+	"due_date":    "due_date",
+	"start_date":  "start_date",
+	"assignee_id": "assignee_id",
+	"assignee":    "assignee_id",
+	"created_by":  "created_by",
+	"author":      "created_by",
+}
+
+// manualSort identifies whose manual order SortFieldPosition uses
+type manualSort struct {
+	viewID uuid.UUID
+	userID uuid.UUID
+}
+
+// expr is the user's position of a board in the view
+// Fractional positions compare bytewise (see util.ComparePositions); both IDs are parsed UUIDs.
+func (m *manualSort) expr() string {
+	return fmt.Sprintf(`(SELECT ubo.position FROM user_board_order ubo WHERE ubo.board_id = boards.id AND ubo.view_id = '%s' AND ubo.user_id = '%s') COLLATE "C"`,
+		m.viewID, m.userID)
+}
+
+// normalizeSortKeys validates the keys against the project fields and lower-cases directions
+// allowPosition is false where there is no view (and so no manual order) to sort by.
+func normalizeSortKeys(keys []domain.SortKey, fields []domain.ProjectField, allowPosition bool) ([]domain.SortKey, error) {
+	if len(keys) > domain.MaxViewSortKeys {
+		return nil, sortError(fmt.Sprintf("정렬 기준은 최대 %d개까지 지정할 수 있습니다", domain.MaxViewSortKeys))
+	}
+
+	byID := fieldsByID(fields)
+	normalized := make([]domain.SortKey, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		direction := strings.ToLower(key.Direction)
+		if direction == "" {
+			direction = domain.SortDirectionAsc
+		}
+		if direction != domain.SortDirectionAsc && direction != domain.SortDirectionDesc {
+			return nil, sortError(fmt.Sprintf("정렬 방향은 asc 또는 desc여야 합니다: %s", key.Direction))
+		}
+
+		if _, err := resolveSortTarget(key.Field, byID, allowPosition); err != nil {
+			return nil, err
+		}
+		if seen[key.Field] {
+			return nil, sortError(fmt.Sprintf("같은 정렬 기준이 중복되었습니다: %s", key.Field))
+		}
+		seen[key.Field] = true
+
+		normalized = append(normalized, domain.SortKey{Field: key.Field, Direction: direction})
+	}
+	return normalized, nil
+}
+
+// withoutMissingSortFields drops the sort keys on custom fields that are not in the project
+// (deleted after the view was saved) and returns their keys; other keys are left to normalizeSortKeys.
+func withoutMissingSortFields(keys []domain.SortKey, fields []domain.ProjectField) ([]domain.SortKey, []string) {
+	byID := fieldsByID(fields)
+	kept := make([]domain.SortKey, 0, len(keys))
+	var dropped []string
+	for _, key := range keys {
+		if isMissingField(key.Field, byID) {
+			dropped = append(dropped, key.Field)
+			continue
+		}
+		kept = append(kept, key)
+	}
+	return kept, dropped
+}
+
+// resolveSortTarget resolves a sort key; SortFieldPosition resolves to an empty target
+func resolveSortTarget(key string, byID map[string]*domain.ProjectField, allowPosition bool) (filterTarget, error) {
+	if key == domain.SortFieldPosition {
+		if !allowPosition {
+			return filterTarget{}, sortError("position 정렬은 뷰에서만 사용할 수 있습니다")
+		}
+		return filterTarget{key: key}, nil
+	}
+
+	if column, ok := builtInSortKeys[key]; ok {
+		target := builtInFilterTargets[column]
+		target.key = key
+		return target, nil
+	}
+	if _, err := uuid.Parse(key); err != nil {
+		return filterTarget{}, sortError(fmt.Sprintf("정렬할 수 없는 필드입니다: %s", key))
+	}
+
+	target, err := resolveFilterTarget(key, byID)
+	if err != nil {
+		return filterTarget{}, sortError(fmt.Sprintf("정렬 필드를 찾을 수 없습니다: %s", key))
+	}
+	if target.kind == filterKindMultiRef {
+		return filterTarget{}, sortError(fmt.Sprintf("다중 값 필드로는 정렬할 수 없습니다: %s", key))
+	}
+	return target, nil
+}
+
+// buildBoardOrder compiles sort keys to an ORDER BY clause
+// Empty values sort last in both directions, and created_at/id break ties so pages and group ranks are stable.
+// manual is nil where SortFieldPosition is not available.
+func (s *viewService) buildBoardOrder(keys []domain.SortKey, fields []domain.ProjectField, manual *manualSort) (string, error) {
+	keys, err := normalizeSortKeys(keys, fields, manual != nil)
+	if err != nil {
+		return "", err
+	}
+	if len(keys) == 0 {
+		return "created_at DESC, boards.id", nil
+	}
+
+	byID := fieldsByID(fields)
+	parts := make([]string, 0, len(keys)+2)
+	for _, key := range keys {
+		var expr string
+		if key.Field == domain.SortFieldPosition {
+			expr = manual.expr()
+		} else {
+			target, _ := resolveSortTarget(key.Field, byID, false)
+			if expr, err = s.sortExpression(target, byID); err != nil {
+				return "", err
+			}
+		}
+		parts = append(parts, fmt.Sprintf("%s %s NULLS LAST", expr, strings.ToUpper(key.Direction)))
+	}
+
+	return strings.Join(append(parts, "created_at DESC", "boards.id"), ", "), nil
+}
+
+// sortExpression is the value a target sorts by: numeric for numbers, chronological for dates
+// and option display order for single-select fields
+func (s *viewService) sortExpression(target filterTarget, byID map[string]*domain.ProjectField) (string, error) {
+	switch target.kind {
+	case filterKindNumber:
+		return target.numberExpr(), nil
+	case filterKindTime:
+		return target.timeExpr(), nil
+	case filterKindBool:
+		return target.boolExpr(), nil
+	}
+
+	if field := byID[target.field]; field != nil && field.FieldType == domain.FieldTypeSingleSelect {
+		options, err := s.repo.FindOptionsByField(field.ID)
+		if err != nil {
+			return "", apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 조회 실패", 500)
+		}
+		if len(options) > 0 {
+			// Option IDs come from the database, so they are safe to inline
+			var sb strings.Builder
+			sb.WriteString("CASE " + target.textExpr())
+			for _, option := range options {
+				fmt.Fprintf(&sb, " WHEN '%s' THEN %d", option.ID, option.DisplayOrder)
+			}
+			sb.WriteString(" END")
+			return sb.String(), nil
+		}
+	}
+	return target.textExpr(), nil
+}
+
+func sortError(message string) error {
+	return apperrors.New(apperrors.ErrCodeValidation, "정렬: "+message, 400)
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/testutil"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestBuildBoardOrder_TypedExpressions(t *testing.T) {
+	fields := testProjectFields()
+	number, date := fields[0].ID.String(), fields[1].ID.String()
+	stage := domain.ProjectField{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: domain.FieldTypeSingleSelect}
+	fields = append(fields, stage)
+
+	todo := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, DisplayOrder: 0}
+	done := domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, DisplayOrder: 1}
+	fieldRepo := new(testutil.MockFieldRepository)
+	fieldRepo.On("FindOptionsByField", stage.ID).Return([]domain.FieldOption{todo, done}, nil)
+
+	s := &viewService{repo: fieldRepo, logger: zap.NewNop()}
+	manual := &manualSort{viewID: uuid.New(), userID: uuid.New()}
+
+	order, err := s.buildBoardOrder([]domain.SortKey{
+		{Field: stage.ID.String(), Direction: "DESC"},
+		{Field: number},
+		{Field: date, Direction: "asc"},
+		{Field: "assignee"},
+		{Field: domain.SortFieldPosition},
+	}, fields, manual)
+	require.NoError(t, err)
+
+	expected := fmt.Sprintf("CASE custom_fields_cache->>'%s' WHEN '%s' THEN 0 WHEN '%s' THEN 1 END DESC NULLS LAST, ", stage.ID, todo.ID, done.ID) +
+		fmt.Sprintf("(CASE WHEN jsonb_typeof(custom_fields_cache->'%s') = 'number' THEN (custom_fields_cache->>'%s')::numeric END) ASC NULLS LAST, ", number, number) +
+		fmt.Sprintf("(CASE WHEN jsonb_typeof(custom_fields_cache->'%s') = 'string' THEN (custom_fields_cache->>'%s')::timestamptz END) ASC NULLS LAST, ", date, date) +
+		"assignee_id ASC NULLS LAST, " +
+		fmt.Sprintf(`(SELECT ubo.position FROM user_board_order ubo WHERE ubo.board_id = boards.id AND ubo.view_id = '%s' AND ubo.user_id = '%s') COLLATE "C" ASC NULLS LAST, `, manual.viewID, manual.userID) +
+		"created_at DESC, boards.id"
+	assert.Equal(t, expected, order)
+}
+
+func TestBuildBoardOrder_Default(t *testing.T) {
+	s := &viewService{logger: zap.NewNop()}
+
+	order, err := s.buildBoardOrder(nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "created_at DESC, boards.id", order)
+}
+
+func TestBuildBoardOrder_Rejects(t *testing.T) {
+	fields := testProjectFields()
+	multi := fields[2].ID.String()
+	s := &viewService{logger: zap.NewNop()}
+	manual := &manualSort{viewID: uuid.New(), userID: uuid.New()}
+
+	tests := []struct {
+		name   string
+		keys   []domain.SortKey
+		manual *manualSort
+	}{
+		{"raw SQL", []domain.SortKey{{Field: "created_at; DROP TABLE boards"}}, manual},
+		{"column outside the whitelist", []domain.SortKey{{Field: "custom_fields_cache"}}, manual},
+		{"bad direction", []domain.SortKey{{Field: "title", Direction: "sideways"}}, manual},
+		{"field of another project", []domain.SortKey{{Field: uuid.New().String()}}, manual},
+		{"multi-value field", []domain.SortKey{{Field: multi}}, manual},
+		{"duplicate key", []domain.SortKey{{Field: "title"}, {Field: "title", Direction: "desc"}}, manual},
+		{"position without a view", []domain.SortKey{{Field: domain.SortFieldPosition}}, nil},
+		{"too many keys", []domain.SortKey{{Field: "title"}, {Field: "created_at"}, {Field: "updated_at"}, {Field: "due_date"}, {Field: "assignee"}, {Field: "author"}}, manual},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.buildBoardOrder(tt.keys, fields, tt.manual)
+			assertStatus(t, err, 400)
+		})
+	}
+}
+
+func TestWithoutMissingSortFields(t *testing.T) {
+	fields := testProjectFields()
+	number, deleted := fields[0].ID.String(), uuid.New().String()
+
+	keys, dropped := withoutMissingSortFields([]domain.SortKey{
+		{Field: deleted, Direction: "desc"},
+		{Field: number},
+		{Field: domain.SortFieldPosition},
+		{Field: "title"},
+	}, fields)
+	assert.Equal(t, []domain.SortKey{{Field: number}, {Field: domain.SortFieldPosition}, {Field: "title"}}, keys)
+	assert.Equal(t, []string{deleted}, dropped)
+
+	// Keys that are not field IDs are kept, so they are still rejected
+	keys, dropped = withoutMissingSortFields([]domain.SortKey{{Field: "custom_fields_cache"}}, fields)
+	assert.Len(t, keys, 1)
+	assert.Empty(t, dropped)
+}
+
+func TestSavedView_SortKeys(t *testing.T) {
+	sortBy := "due_date"
+	legacy := &domain.SavedView{SortBy: &sortBy, SortDirection: "desc", Sorts: "[]"}
+	keys, err := legacy.SortKeys()
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SortKey{{Field: "due_date", Direction: "desc"}}, keys)
+
+	view := &domain.SavedView{}
+	require.NoError(t, view.SetSortKeys([]domain.SortKey{{Field: "title", Direction: "desc"}, {Field: "created_at", Direction: "asc"}}))
+	assert.Equal(t, "title", *view.SortBy)
+	assert.Equal(t, "desc", view.SortDirection)
+	keys, err = view.SortKeys()
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	require.NoError(t, view.SetSortKeys(nil))
+	assert.Nil(t, view.SortBy)
+	assert.Equal(t, "[]", view.Sorts)
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Table View ====================
+// A view's table settings list its visible columns in order with their widths, how many leading
+// columns stay frozen and the row density. Each member may save their own settings for a shared
+// view; what they set replaces the view's settings for them only.
+
+// tableColumn is a built-in column of table views
+type tableColumn struct {
+	key       string
+	name      string
+	fieldType domain.FieldType // Type of the values, in custom field terms
+	visible   bool             // Shown when the view does not list its columns
+}
+
+// builtInTableColumns are the board columns of table views in their default order
+var builtInTableColumns = []tableColumn{
+	{key: "title", name: "제목", fieldType: domain.FieldTypeText, visible: true},
+	{key: "assignee", name: "담당자", fieldType: domain.FieldTypeSingleUser, visible: true},
+	{key: "start_date", name: "시작일", fieldType: domain.FieldTypeDateTime, visible: true},
+	{key: "due_date", name: "마감일", fieldType: domain.FieldTypeDateTime, visible: true},
+	{key: "author", name: "작성자", fieldType: domain.FieldTypeSingleUser},
+	{key: "created_at", name: "생성일", fieldType: domain.FieldTypeDateTime},
+	{key: "updated_at", name: "수정일", fieldType: domain.FieldTypeDateTime},
+}
+
+// tableColumnDefinitions returns every column a table view of the project can show, in default order
+func tableColumnDefinitions(fields []domain.ProjectField) []dto.ColumnDefinition {
+	columns := make([]dto.ColumnDefinition, 0, len(builtInTableColumns)+len(fields))
+	for _, column := range builtInTableColumns {
+		columns = append(columns, dto.ColumnDefinition{
+			Key:     column.key,
+			Name:    column.name,
+			Type:    string(column.fieldType),
+			Visible: column.visible,
+		})
+	}
+	for _, field := range fields {
+		columns = append(columns, dto.ColumnDefinition{
+			Key:           field.ID.String(),
+			Name:          field.Name,
+			Type:          string(field.FieldType),
+			IsCustomField: true,
+			Visible:       true,
+		})
+	}
+	return columns
+}
+
+// buildTableLayout resolves table settings into column definitions: the listed columns in order,
+// then the others hidden. Columns of deleted fields are skipped.
+func buildTableLayout(settings domain.TableSettings, fields []domain.ProjectField, personalized bool) *dto.TableLayout {
+	all := tableColumnDefinitions(fields)
+	layout := &dto.TableLayout{Density: settings.Density, Personalized: personalized}
+	if layout.Density == "" {
+		layout.Density = domain.TableDensityDefault
+	}
+
+	columns := all
+	if len(settings.Columns) > 0 {
+		index := make(map[string]int, len(all))
+		for i, column := range all {
+			index[column.Key] = i
+		}
+
+		columns = make([]dto.ColumnDefinition, 0, len(all))
+		listed := make(map[string]bool, len(settings.Columns))
+		for _, setting := range settings.Columns {
+			i, ok := index[setting.Key]
+			if !ok || listed[setting.Key] {
+				continue
+			}
+			listed[setting.Key] = true
+			column := all[i]
+			column.Visible, column.Width = true, setting.Width
+			columns = append(columns, column)
+		}
+		for _, column := range all {
+			if !listed[column.Key] {
+				column.Visible = false
+				columns = append(columns, column)
+			}
+		}
+	} else {
+		// Unlisted columns keep their default visibility, visible ones first
+		visible := make([]dto.ColumnDefinition, 0, len(all))
+		hidden := make([]dto.ColumnDefinition, 0)
+		for _, column := range all {
+			if column.Visible {
+				visible = append(visible, column)
+			} else {
+				hidden = append(hidden, column)
+			}
+		}
+		columns = append(visible, hidden...)
+	}
+
+	if settings.FrozenColumns != nil {
+		for i := range columns {
+			if i >= *settings.FrozenColumns || !columns[i].Visible {
+				break
+			}
+			columns[i].Frozen = true
+			layout.FrozenColumns++
+		}
+	}
+	layout.Columns = columns
+	return layout
+}
+
+// validateTableSettings checks the columns of table settings against the project fields
+// columns are the visible columns the settings apply to (an override may keep the view's).
+func validateTableSettings(settings domain.TableSettings, columns []domain.TableColumn, fields []domain.ProjectField) error {
+	known := make(map[string]bool, len(builtInTableColumns)+len(fields))
+	for _, column := range builtInTableColumns {
+		known[column.key] = true
+	}
+	for _, field := range fields {
+		known[field.ID.String()] = true
+	}
+
+	seen := make(map[string]bool, len(settings.Columns))
+	for _, column := range settings.Columns {
+		if !known[column.Key] {
+			return tableError(fmt.Sprintf("표시할 수 없는 컬럼입니다: %s", column.Key))
+		}
+		if seen[column.Key] {
+			return tableError(fmt.Sprintf("중복된 컬럼입니다: %s", column.Key))
+		}
+		seen[column.Key] = true
+	}
+
+	if settings.FrozenColumns != nil && len(columns) > 0 && *settings.FrozenColumns > len(columns) {
+		return tableError("고정 컬럼 수가 표시 컬럼 수보다 많습니다")
+	}
+	return nil
+}
+
+// toTableSettings converts requested table settings
+func toTableSettings(req *dto.TableSettings) domain.TableSettings {
+	settings := domain.TableSettings{FrozenColumns: req.FrozenColumns, Density: req.Density}
+	for _, column := range req.Columns {
+		settings.Columns = append(settings.Columns, domain.TableColumn{Key: column.Key, Width: column.Width})
+	}
+	return settings
+}
+
+// toTableSettingsResponse converts stored table settings
+func toTableSettingsResponse(settings domain.TableSettings) dto.TableSettings {
+	response := dto.TableSettings{
+		Columns:       make([]dto.TableColumnSetting, 0, len(settings.Columns)),
+		FrozenColumns: settings.FrozenColumns,
+		Density:       settings.Density,
+	}
+	for _, column := range settings.Columns {
+		response.Columns = append(response.Columns, dto.TableColumnSetting{Key: column.Key, Width: column.Width})
+	}
+	return response
+}
+
+// validateViewTable checks a view's table settings against the project fields before the view is saved
+func (s *viewService) validateViewTable(projectID uuid.UUID, settings domain.TableSettings) error {
+	if len(settings.Columns) == 0 {
+		return nil
+	}
+	fields, err := s.repo.FindFieldsByProject(projectID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	return validateTableSettings(settings, settings.Columns, fields)
+}
+
+// viewTableSettings returns the view's table settings with the user's override applied
+// Unreadable settings are logged and ignored.
+func (s *viewService) viewTableSettings(view *domain.SavedView, userUUID uuid.UUID) (domain.TableSettings, bool) {
+	settings, err := view.Table()
+	if err != nil {
+		s.logger.Warn("Failed to parse view table settings", zap.Error(err))
+	}
+
+	own, err := s.repo.FindViewUserSettings(view.ID, userUUID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Warn("Failed to fetch user table settings", zap.Error(err))
+		}
+		return settings, false
+	}
+	override, err := own.Table()
+	if err != nil {
+		s.logger.Warn("Failed to parse user table settings", zap.Error(err))
+		return settings, false
+	}
+	return settings.Merge(override), true
+}
+
+// tableLayout returns the user's effective table layout of the view
+func (s *viewService) tableLayout(view *domain.SavedView, userUUID uuid.UUID) (*dto.TableLayout, error) {
+	fields, err := s.repo.FindFieldsByProject(view.ProjectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	settings, personalized := s.viewTableSettings(view, userUUID)
+	return buildTableLayout(settings, fields, personalized), nil
+}
+
+// withTableLayout attaches the view type and the user's table layout to ApplyView results
+func withTableLayout(result interface{}, viewType string, layout *dto.TableLayout) interface{} {
+	switch r := result.(type) {
+	case map[string]interface{}:
+		r["viewType"] = viewType
+		r["layout"] = layout
+	case *dto.GroupedBoardsResponse:
+		r.ViewType, r.Layout = viewType, layout
+	case *dto.SwimlaneBoardsResponse:
+		r.ViewType, r.Layout = viewType, layout
+	}
+	return result
+}
+
+// UpdateMyTableSettings saves the user's own table settings of a view and returns the resulting layout
+func (s *viewService) UpdateMyTableSettings(userID, viewID string, req *dto.TableSettings) (*dto.TableLayout, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	viewUUID, err := uuid.Parse(viewID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 뷰 ID", 400)
+	}
+
+	view, err := s.findAccessibleView(userUUID, viewUUID)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := s.repo.FindFieldsByProject(view.ProjectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+
+	override := toTableSettings(req)
+	viewSettings, err := view.Table()
+	if err != nil {
+		s.logger.Warn("Failed to parse view table settings", zap.Error(err))
+	}
+	if err := validateTableSettings(override, viewSettings.Merge(override).Columns, fields); err != nil {
+		return nil, err
+	}
+
+	own := &domain.SavedViewUserSettings{ViewID: viewUUID, UserID: userUUID}
+	if err := own.SetTable(override); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "테이블 설정이 유효하지 않습니다", 400)
+	}
+	if err := s.repo.SaveViewUserSettings(own); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "테이블 설정 저장 실패", 500)
+	}
+
+	return buildTableLayout(viewSettings.Merge(override), fields, true), nil
+}
+
+// ResetMyTableSettings removes the user's own table settings of a view and returns the view's layout
+func (s *viewService) ResetMyTableSettings(userID, viewID string) (*dto.TableLayout, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	viewUUID, err := uuid.Parse(viewID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 뷰 ID", 400)
+	}
+
+	view, err := s.findAccessibleView(userUUID, viewUUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteViewUserSettings(viewUUID, userUUID); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "테이블 설정 삭제 실패", 500)
+	}
+
+	return s.tableLayout(view, userUUID)
+}
+
+func tableError(message string) error {
+	return apperrors.New(apperrors.ErrCodeValidation, "테이블: "+message, 400)
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/testutil"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func TestTableSettings_Merge(t *testing.T) {
+	one, two := 1, 2
+	view := domain.TableSettings{
+		Columns:       []domain.TableColumn{{Key: "title", Width: 300}, {Key: "assignee"}},
+		FrozenColumns: &one,
+		Density:       domain.TableDensityCompact,
+	}
+
+	// An override replaces only what it sets
+	merged := view.Merge(domain.TableSettings{FrozenColumns: &two})
+	assert.Equal(t, view.Columns, merged.Columns)
+	assert.Equal(t, 2, *merged.FrozenColumns)
+	assert.Equal(t, domain.TableDensityCompact, merged.Density)
+
+	merged = view.Merge(domain.TableSettings{Columns: []domain.TableColumn{{Key: "due_date"}}, Density: domain.TableDensityComfortable})
+	assert.Equal(t, []domain.TableColumn{{Key: "due_date"}}, merged.Columns)
+	assert.Equal(t, 1, *merged.FrozenColumns)
+	assert.Equal(t, domain.TableDensityComfortable, merged.Density)
+}
+
+func TestBuildTableLayout(t *testing.T) {
+	fields := testProjectFields()
+	number := fields[0].ID.String()
+
+	// Without columns: built-in defaults and every custom field, hidden columns last
+	layout := buildTableLayout(domain.TableSettings{}, fields, false)
+	require.Len(t, layout.Columns, len(builtInTableColumns)+len(fields))
+	assert.Equal(t, "title", layout.Columns[0].Key)
+	assert.Equal(t, number, layout.Columns[4].Key)
+	assert.True(t, layout.Columns[4].IsCustomField)
+	assert.Equal(t, "updated_at", layout.Columns[len(layout.Columns)-1].Key)
+	assert.False(t, layout.Columns[len(layout.Columns)-1].Visible)
+	assert.Equal(t, domain.TableDensityDefault, layout.Density)
+	assert.Zero(t, layout.FrozenColumns)
+
+	// Listed columns in order; deleted fields and duplicates are skipped; frozen stops at the visible ones
+	frozen := 5
+	layout = buildTableLayout(domain.TableSettings{
+		Columns:       []domain.TableColumn{{Key: number, Width: 120}, {Key: uuid.New().String()}, {Key: "title"}, {Key: number}},
+		FrozenColumns: &frozen,
+	}, fields, true)
+	require.Len(t, layout.Columns, len(builtInTableColumns)+len(fields))
+	assert.Equal(t, dto.ColumnDefinition{Key: number, Type: "number", IsCustomField: true, Visible: true, Width: 120, Frozen: true}, layout.Columns[0])
+	assert.Equal(t, "title", layout.Columns[1].Key)
+	assert.False(t, layout.Columns[2].Visible)
+	assert.False(t, layout.Columns[2].Frozen)
+	assert.Equal(t, 2, layout.FrozenColumns)
+	assert.True(t, layout.Personalized)
+}
+
+func TestValidateTableSettings(t *testing.T) {
+	fields := testProjectFields()
+	three := 3
+
+	columns := []domain.TableColumn{{Key: "title"}, {Key: fields[0].ID.String()}}
+	assert.NoError(t, validateTableSettings(domain.TableSettings{Columns: columns}, columns, fields))
+
+	tests := []struct {
+		name     string
+		settings domain.TableSettings
+	}{
+		{"unknown column", domain.TableSettings{Columns: []domain.TableColumn{{Key: "status"}}}},
+		{"field of another project", domain.TableSettings{Columns: []domain.TableColumn{{Key: uuid.New().String()}}}},
+		{"duplicate column", domain.TableSettings{Columns: []domain.TableColumn{{Key: "title"}, {Key: "title"}}}},
+		{"more frozen than visible", domain.TableSettings{FrozenColumns: &three}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visible := tt.settings.Columns
+			if visible == nil {
+				visible = columns
+			}
+			assertStatus(t, validateTableSettings(tt.settings, visible, fields), 400)
+		})
+	}
+}
+
+func TestViewService_UpdateMyTableSettings(t *testing.T) {
+	fields := testProjectFields()
+	fieldRepo := new(testutil.MockFieldRepository)
+	projectRepo := new(testutil.MockProjectRepository)
+	s := &viewService{repo: fieldRepo, projectRepo: projectRepo, logger: zap.NewNop()}
+
+	userID, viewID, projectID := uuid.New(), uuid.New(), uuid.New()
+	fieldRepo.On("FindViewByID", viewID).Return(&domain.SavedView{
+		BaseModel: domain.BaseModel{ID: viewID}, ProjectID: projectID, CreatedBy: uuid.New(), IsShared: true,
+		TableSettings: `{"columns":[{"key":"title","width":300},{"key":"assignee"}],"density":"compact"}`,
+	}, nil)
+	fieldRepo.On("FindFieldsByProject", projectID).Return(fields, nil)
+	projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{}, nil)
+	fieldRepo.On("SaveViewUserSettings", mock.MatchedBy(func(settings *domain.SavedViewUserSettings) bool {
+		return settings.ViewID == viewID && settings.UserID == userID && settings.TableSettings == `{"frozen_columns":2}`
+	})).Return(nil)
+
+	// A member of a shared view overrides its frozen columns only
+	frozen := 2
+	layout, err := s.UpdateMyTableSettings(userID.String(), viewID.String(), &dto.TableSettings{FrozenColumns: &frozen})
+	require.NoError(t, err)
+	assert.True(t, layout.Personalized)
+	assert.Equal(t, 2, layout.FrozenColumns)
+	assert.Equal(t, domain.TableDensityCompact, layout.Density)
+	assert.Equal(t, 300, layout.Columns[0].Width)
+	fieldRepo.AssertExpectations(t)
+
+	// Frozen columns are checked against the view's visible columns
+	frozen = 3
+	_, err = s.UpdateMyTableSettings(userID.String(), viewID.String(), &dto.TableSettings{FrozenColumns: &frozen})
+	assertStatus(t, err, 400)
+
+	// Without own settings the view's layout applies
+	fieldRepo.On("FindViewUserSettings", viewID, userID).Return(nil, gorm.ErrRecordNotFound)
+	fieldRepo.On("DeleteViewUserSettings", viewID, userID).Return(nil)
+	layout, err = s.ResetMyTableSettings(userID.String(), viewID.String())
+	require.NoError(t, err)
+	assert.False(t, layout.Personalized)
+	assert.Zero(t, layout.FrozenColumns)
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ==================== Timeline View ====================
+// A timeline draws the view's boards as bars from their start date to their due date. A board with
+// only a due date is a milestone; a board with only a start date is a bar of no length. Bars keep the
+// view's filters and order, and a grouped view splits them into rows by its grouping.
+
+const (
+	maxTimelineDays = 366  // Days of one timeline request
+	maxTimelineBars = 1000 // Bars of one timeline request; more are reported as truncated
+)
+
+// GetTimeline returns the bars of the view's boards that overlap the days from req.From to req.To
+func (s *viewService) GetTimeline(userID, viewID string, req *dto.GetTimelineRequest) (*dto.TimelineResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	viewUUID, err := uuid.Parse(viewID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 뷰 ID", 400)
+	}
+
+	from, to, err := parseDateRange(req.From, req.To, maxTimelineDays)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := loadTimeZone(req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := s.findAccessibleView(userUUID, viewUUID)
+	if err != nil {
+		return nil, err
+	}
+	filters, sorts := s.viewSettings(view)
+	q, err := s.prepareViewQuery(userUUID, view.ProjectID, viewUUID, filters, sorts)
+	if err != nil {
+		return nil, err
+	}
+	var spec *viewGrouping
+	if grouping := view.Grouping(); grouping != nil {
+		if spec, err = s.resolveGrouping(grouping, q.fields); err != nil {
+			return nil, err
+		}
+	}
+
+	var unscheduled int64
+	if err := q.filteredBoards().Where("start_date IS NULL AND due_date IS NULL").Count(&unscheduled).Error; err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 카운트 실패", 500)
+	}
+
+	// Boards whose period overlaps the window (LEAST and GREATEST skip a missing date)
+	lower := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	upper := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+	query := q.filteredBoards().Select("boards.*, NULL AS group_key")
+	if spec != nil {
+		query = groupedBoards(q, spec).Select("boards.*, " + spec.keyExpr() + " AS group_key")
+	}
+	var rows []groupedBoardRow
+	if err := query.Where("LEAST(start_date, due_date) < ? AND GREATEST(start_date, due_date) >= ?", upper, lower).
+		Order(q.order).Limit(maxTimelineBars + 1).
+		Scan(&rows).Error; err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	truncated := len(rows) > maxTimelineBars
+	if truncated {
+		rows = rows[:maxTimelineBars]
+	}
+
+	response := &dto.TimelineResponse{
+		From:        req.From,
+		To:          req.To,
+		TimeZone:    loc.String(),
+		Groups:      []dto.GroupHeader{},
+		Bars:        toTimelineBars(rows, spec != nil),
+		Unscheduled: unscheduled,
+		Truncated:   truncated,
+	}
+	if spec != nil {
+		response.GroupByField = spec.fieldResponse()
+		response.GroupBy = spec.key
+		response.Bucket = spec.bucket
+		response.Groups = timelineGroups(spec, response.Bars)
+	}
+	return response, nil
+}
+
+// toTimelineBars builds the bars of scheduled boards; grouped bars carry their group's key
+func toTimelineBars(rows []groupedBoardRow, grouped bool) []dto.TimelineBar {
+	bars := make([]dto.TimelineBar, 0, len(rows))
+	for _, row := range rows {
+		start, end := row.StartDate, row.DueDate
+		if start == nil {
+			start = end
+		}
+		if end == nil {
+			end = start
+		}
+		if start == nil {
+			continue
+		}
+
+		bar := dto.TimelineBar{
+			BoardID:     row.ID.String(),
+			Title:       row.Title,
+			Start:       *start,
+			End:         *end,
+			IsMilestone: row.StartDate == nil,
+		}
+		if row.AssigneeID != nil {
+			assigneeID := row.AssigneeID.String()
+			bar.AssigneeID = &assigneeID
+		}
+		if grouped {
+			bar.GroupKey = groupKeyOf(row.GroupKey)
+		}
+		bars = append(bars, bar)
+	}
+	return bars
+}
+
+// timelineGroups lists the rows of a grouped timeline in display order with their number of bars
+// Options and checkbox values are always listed; "(none)" only when boards without a value have bars.
+func timelineGroups(spec *viewGrouping, bars []dto.TimelineBar) []dto.GroupHeader {
+	counts := make(map[string]int)
+	present := make([]string, 0)
+	for _, bar := range bars {
+		if counts[bar.GroupKey] == 0 && bar.GroupKey != noneGroupKey {
+			present = append(present, bar.GroupKey)
+		}
+		counts[bar.GroupKey]++
+	}
+
+	keys := spec.orderedKeys(present)
+	if counts[noneGroupKey] > 0 {
+		keys = append(keys, noneGroupKey)
+	}
+	groups := make([]dto.GroupHeader, 0, len(keys))
+	for _, key := range keys {
+		groups = append(groups, dto.GroupHeader{Key: key, GroupValue: spec.groupValue(key), Count: counts[key]})
+	}
+	return groups
+}
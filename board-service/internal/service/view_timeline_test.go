@@ -0,0 +1,76 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestBoard_Reschedule(t *testing.T) {
+	start := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 10, 5, 18, 0, 0, 0, time.UTC)
+	moved := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+
+	// Start and due date shift together
+	board := &domain.Board{StartDate: &start, DueDate: &due}
+	board.Reschedule(moved)
+	assert.Equal(t, moved, *board.StartDate)
+	assert.Equal(t, time.Date(2026, 10, 16, 18, 0, 0, 0, time.UTC), *board.DueDate)
+	assert.NoError(t, board.ValidateSchedule())
+
+	// A milestone moves its due date
+	milestone := &domain.Board{DueDate: &due}
+	milestone.Reschedule(moved)
+	assert.Nil(t, milestone.StartDate)
+	assert.Equal(t, moved, *milestone.DueDate)
+
+	late := &domain.Board{StartDate: &due, DueDate: &start}
+	assert.Error(t, late.ValidateSchedule())
+}
+
+func TestToTimelineBars(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	assignee := uuid.New()
+	stage := "todo"
+
+	rows := []groupedBoardRow{
+		{Board: domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, Title: "period", StartDate: &start, DueDate: &due, AssigneeID: &assignee}, GroupKey: &stage},
+		{Board: domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, Title: "milestone", DueDate: &due}},
+		{Board: domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, Title: "open", StartDate: &start}},
+	}
+
+	bars := toTimelineBars(rows, true)
+	require.Len(t, bars, 3)
+
+	assigneeID := assignee.String()
+	assert.Equal(t, dto.TimelineBar{BoardID: rows[0].ID.String(), Title: "period", Start: start, End: due, AssigneeID: &assigneeID, GroupKey: "todo"}, bars[0])
+	assert.Equal(t, due, bars[1].Start)
+	assert.True(t, bars[1].IsMilestone)
+	assert.Equal(t, noneGroupKey, bars[1].GroupKey)
+	assert.Equal(t, start, bars[2].End)
+	assert.False(t, bars[2].IsMilestone)
+
+	assert.Empty(t, toTimelineBars(rows[:1], false)[0].GroupKey)
+}
+
+func TestTimelineGroups(t *testing.T) {
+	fields := testProjectFields()
+	s := &viewService{logger: zap.NewNop()}
+
+	done, err := s.resolveGrouping(&domain.ViewGrouping{Key: fields[3].ID.String()}, fields)
+	require.NoError(t, err)
+
+	groups := timelineGroups(done, []dto.TimelineBar{{GroupKey: "false"}, {GroupKey: "false"}, {GroupKey: noneGroupKey}})
+	assert.Equal(t, []dto.GroupHeader{
+		{Key: "true", GroupValue: map[string]interface{}{"value": true}, Count: 0},
+		{Key: "false", GroupValue: map[string]interface{}{"value": false}, Count: 2},
+		{Key: noneGroupKey, Count: 1},
+	}, groups)
+}
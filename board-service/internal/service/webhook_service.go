@@ -0,0 +1,360 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/pagination"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/realtime"
+	"board-service/internal/repository"
+	"board-service/internal/webhook"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type WebhookService interface {
+	// Webhook management (project ADMIN or OWNER)
+	CreateWebhook(userID, projectID string, req *dto.CreateWebhookRequest) (*dto.WebhookResponse, error)
+	GetWebhooks(userID, projectID string) ([]dto.WebhookResponse, error)
+	UpdateWebhook(userID, webhookID string, req *dto.UpdateWebhookRequest) (*dto.WebhookResponse, error)
+	DeleteWebhook(userID, webhookID string) error
+
+	// Delivery log
+	GetDeliveries(userID, webhookID string, req *dto.GetWebhookDeliveriesRequest) (*dto.PaginatedWebhookDeliveriesResponse, error)
+	Redeliver(userID, webhookID, deliveryID string) (*dto.WebhookDeliveryResponse, error)
+}
+
+type webhookService struct {
+	repo       repository.WebhookRepository
+	authorizer auth.ProjectAuthorizer
+	urlPolicy  webhook.URLPolicy // Allowed webhook destinations
+	logger     *zap.Logger
+}
+
+func NewWebhookService(
+	repo repository.WebhookRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	urlPolicy webhook.URLPolicy,
+	logger *zap.Logger,
+) WebhookService {
+	return &webhookService{
+		repo:       repo,
+		authorizer: auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		urlPolicy:  urlPolicy,
+		logger:     logger,
+	}
+}
+
+// ==================== Webhook Management ====================
+
+func (s *webhookService) CreateWebhook(userID, projectID string, req *dto.CreateWebhookRequest) (*dto.WebhookResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorizer.RequireAdmin(userUUID, projectUUID); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateWebhookURL(req.URL); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookEvents(req.Events); err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		if secret, err = generateWebhookSecret(); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 시크릿 생성 실패", 500)
+		}
+	}
+
+	webhook := &domain.Webhook{
+		ProjectID: projectUUID,
+		URL:       req.URL,
+		Secret:    secret,
+		IsActive:  true,
+		CreatedBy: userUUID,
+	}
+	if err := webhook.SetEventFilter(req.Events); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 생성 실패", 500)
+	}
+
+	if err := s.repo.Create(webhook); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 생성 실패", 500)
+	}
+
+	// The secret is shown only once, right after creation
+	response := toWebhookResponse(webhook)
+	response.Secret = webhook.Secret
+	return response, nil
+}
+
+func (s *webhookService) GetWebhooks(userID, projectID string) ([]dto.WebhookResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorizer.RequireAdmin(userUUID, projectUUID); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.repo.FindByProject(projectUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 조회 실패", 500)
+	}
+
+	responses := make([]dto.WebhookResponse, 0, len(webhooks))
+	for i := range webhooks {
+		responses = append(responses, *toWebhookResponse(&webhooks[i]))
+	}
+	return responses, nil
+}
+
+func (s *webhookService) UpdateWebhook(userID, webhookID string, req *dto.UpdateWebhookRequest) (*dto.WebhookResponse, error) {
+	webhook, err := s.findWebhookAsAdmin(userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := s.validateWebhookURL(*req.URL); err != nil {
+			return nil, err
+		}
+		webhook.URL = *req.URL
+	}
+	if req.Secret != nil {
+		webhook.Secret = *req.Secret
+	}
+	if req.Events != nil {
+		if err := validateWebhookEvents(*req.Events); err != nil {
+			return nil, err
+		}
+		if err := webhook.SetEventFilter(*req.Events); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 수정 실패", 500)
+		}
+	}
+	if req.IsActive != nil {
+		webhook.IsActive = *req.IsActive
+	}
+
+	if err := s.repo.Update(webhook); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 수정 실패", 500)
+	}
+
+	return toWebhookResponse(webhook), nil
+}
+
+func (s *webhookService) DeleteWebhook(userID, webhookID string) error {
+	webhook, err := s.findWebhookAsAdmin(userID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(webhook.ID); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 삭제 실패", 500)
+	}
+	return nil
+}
+
+// ==================== Delivery Log ====================
+
+func (s *webhookService) GetDeliveries(userID, webhookID string, req *dto.GetWebhookDeliveriesRequest) (*dto.PaginatedWebhookDeliveriesResponse, error) {
+	webhook, err := s.findWebhookAsAdmin(userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
+	deliveries, total, err := s.repo.FindDeliveriesByWebhook(webhook.ID, page, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 전송 기록 조회 실패", 500)
+	}
+
+	responses := make([]dto.WebhookDeliveryResponse, 0, len(deliveries))
+	for i := range deliveries {
+		responses = append(responses, *toWebhookDeliveryResponse(&deliveries[i]))
+	}
+
+	return &dto.PaginatedWebhookDeliveriesResponse{
+		Deliveries: responses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+	}, nil
+}
+
+// Redeliver queues a new delivery of the same payload; the original log entry is kept as is
+func (s *webhookService) Redeliver(userID, webhookID, deliveryID string) (*dto.WebhookDeliveryResponse, error) {
+	webhook, err := s.findWebhookAsAdmin(userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryUUID, err := parser.ParseUUID(deliveryID, "전송")
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.repo.FindDeliveryByID(deliveryUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "Webhook 전송 기록을 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 전송 기록 조회 실패", 500)
+	}
+	if delivery.WebhookID != webhook.ID {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "Webhook 전송 기록을 찾을 수 없습니다", 404)
+	}
+	if !webhook.IsActive {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "비활성화된 Webhook은 재전송할 수 없습니다", 400)
+	}
+
+	redeliveries := []domain.WebhookDelivery{*delivery.Redeliver()}
+	if err := s.repo.CreateDeliveries(redeliveries); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 재전송 실패", 500)
+	}
+
+	return toWebhookDeliveryResponse(&redeliveries[0]), nil
+}
+
+// ==================== Helpers ====================
+
+// findWebhookAsAdmin loads the webhook and checks that the user is ADMIN or OWNER of its project
+func (s *webhookService) findWebhookAsAdmin(userID, webhookID string) (*domain.Webhook, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookUUID, err := parser.ParseUUID(webhookID, "Webhook")
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.repo.FindByID(webhookUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "Webhook을 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 조회 실패", 500)
+	}
+
+	if _, err := s.authorizer.RequireAdmin(userUUID, webhook.ProjectID); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// validateWebhookURL accepts http(s) URLs of hosts allowed by the URL policy
+func (s *webhookService) validateWebhookURL(rawURL string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.urlPolicy.ValidateURL(ctx, rawURL); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeValidation, "허용되지 않는 Webhook URL입니다 (공개된 http/https 주소만 가능)", 400)
+	}
+	return nil
+}
+
+// validateWebhookEvents accepts "*", known event types and "<resource>.*" prefixes
+func validateWebhookEvents(events []string) error {
+	for _, event := range events {
+		if event == "*" {
+			continue
+		}
+
+		valid := false
+		prefix, wildcard := strings.CutSuffix(event, "*")
+		for _, eventType := range realtime.EventTypes {
+			if (!wildcard && string(eventType) == event) || (wildcard && strings.HasSuffix(prefix, ".") && strings.HasPrefix(string(eventType), prefix)) {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("알 수 없는 이벤트 유형입니다: %s", event), 400)
+		}
+	}
+	return nil
+}
+
+// generateWebhookSecret returns a random signing secret
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+func toWebhookResponse(webhook *domain.Webhook) *dto.WebhookResponse {
+	events := webhook.EventFilter()
+	if events == nil {
+		events = []string{}
+	}
+
+	return &dto.WebhookResponse{
+		WebhookID: webhook.ID.String(),
+		ProjectID: webhook.ProjectID.String(),
+		URL:       webhook.URL,
+		Events:    events,
+		IsActive:  webhook.IsActive,
+		CreatedBy: webhook.CreatedBy.String(),
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(delivery *domain.WebhookDelivery) *dto.WebhookDeliveryResponse {
+	response := &dto.WebhookDeliveryResponse{
+		DeliveryID:     delivery.ID.String(),
+		WebhookID:      delivery.WebhookID.String(),
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		LastError:      delivery.LastError,
+		DurationMs:     delivery.DurationMs,
+		DeliveredAt:    delivery.DeliveredAt,
+		Payload:        delivery.Payload,
+		CreatedAt:      delivery.CreatedAt,
+	}
+
+	if delivery.Status == domain.WebhookDeliveryPending {
+		nextAttemptAt := delivery.NextAttemptAt
+		response.NextAttemptAt = &nextAttemptAt
+	}
+	if delivery.RedeliveryOf != nil {
+		original := delivery.RedeliveryOf.String()
+		response.RedeliveryOf = &original
+	}
+
+	return response
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrObjectNotFound is returned by Get when no object exists under the key
+var ErrObjectNotFound = errors.New("storage: object not found")
+
+// Storage stores attachment contents as opaque objects addressed by key
+// Keys are generated by the application ("projects/<id>/boards/<id>/<id>"), never by users.
+type Storage interface {
+	// Put writes an object of the given size, replacing any object under the same key
+	Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error
+
+	// Get opens an object for reading; the caller must close it
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes an object (deleting a missing object is not an error)
+	Delete(ctx context.Context, key string) error
+}
+
+// validateKey rejects keys that could escape the storage root or bucket
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("storage: invalid key %q", key)
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("storage: invalid key %q", key)
+		}
+	}
+	return nil
+}
@@ -104,6 +104,16 @@ func migrateTestModels(db *gorm.DB) error {
 		&domain.SavedView{},
 		&domain.UserBoardOrder{},
 		&domain.Comment{},
+		&domain.BoardActivity{},
+		&domain.Mention{},
+		&domain.Notification{},
+		&domain.NotificationPreference{},
+		&domain.DomainEvent{},
+		&domain.Webhook{},
+		&domain.WebhookDelivery{},
+		&domain.BoardLink{},
+		&domain.ChecklistItem{},
+		&domain.Attachment{},
 	)
 }
 
@@ -124,6 +134,16 @@ func (t *TestDB) Teardown() {
 func (t *TestDB) Clean() {
 	// Order matters due to foreign keys
 	tables := []interface{}{
+		&domain.Attachment{},
+		&domain.ChecklistItem{},
+		&domain.BoardLink{},
+		&domain.WebhookDelivery{},
+		&domain.Webhook{},
+		&domain.DomainEvent{},
+		&domain.NotificationPreference{},
+		&domain.Notification{},
+		&domain.Mention{},
+		&domain.BoardActivity{},
 		&domain.Comment{},
 		&domain.UserBoardOrder{},
 		&domain.SavedView{},
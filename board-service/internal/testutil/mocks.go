@@ -28,6 +28,11 @@ func (m *MockBoardRepository) FindByID(boardID uuid.UUID) (*domain.Board, error)
 	return args.Get(0).(*domain.Board), args.Error(1)
 }
 
+func (m *MockBoardRepository) FindByIDs(ids []uuid.UUID) ([]domain.Board, error) {
+	args := m.Called(ids)
+	return args.Get(0).([]domain.Board), args.Error(1)
+}
+
 func (m *MockBoardRepository) FindByProject(projectID uuid.UUID, filters repository.BoardFilters, page, limit int) ([]domain.Board, int64, error) {
 	args := m.Called(projectID, filters, page, limit)
 	return args.Get(0).([]domain.Board), args.Get(1).(int64), args.Error(2)
@@ -38,6 +43,11 @@ func (m *MockBoardRepository) Update(board *domain.Board) error {
 	return args.Error(0)
 }
 
+func (m *MockBoardRepository) UpdateFieldsCache(id uuid.UUID, cache string) error {
+	args := m.Called(id, cache)
+	return args.Error(0)
+}
+
 func (m *MockBoardRepository) Delete(boardID uuid.UUID) error {
 	args := m.Called(boardID)
 	return args.Error(0)
@@ -198,6 +208,22 @@ func (m *MockFieldRepository) FindFieldByID(id uuid.UUID) (*domain.ProjectField,
 	return args.Get(0).(*domain.ProjectField), args.Error(1)
 }
 
+func (m *MockFieldRepository) FindFieldByIDForUpdate(id uuid.UUID) (*domain.ProjectField, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ProjectField), args.Error(1)
+}
+
+func (m *MockFieldRepository) FindFieldByIDForShare(id uuid.UUID) (*domain.ProjectField, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ProjectField), args.Error(1)
+}
+
 func (m *MockFieldRepository) FindFieldsByProject(projectID uuid.UUID) ([]domain.ProjectField, error) {
 	args := m.Called(projectID)
 	return args.Get(0).([]domain.ProjectField), args.Error(1)
@@ -313,6 +339,26 @@ func (m *MockFieldRepository) BatchDeleteFieldValues(boardID, fieldID uuid.UUID)
 	return args.Error(0)
 }
 
+func (m *MockFieldRepository) FindFieldValuesByField(fieldID uuid.UUID) ([]domain.BoardFieldValue, error) {
+	args := m.Called(fieldID)
+	return args.Get(0).([]domain.BoardFieldValue), args.Error(1)
+}
+
+func (m *MockFieldRepository) DeleteFieldValuesByField(fieldID uuid.UUID) error {
+	args := m.Called(fieldID)
+	return args.Error(0)
+}
+
+func (m *MockFieldRepository) RemapOptionValues(fieldID, fromOptionID, toOptionID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(fieldID, fromOptionID, toOptionID)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockFieldRepository) CountOptionValues(fieldID, optionID uuid.UUID) (int64, error) {
+	args := m.Called(fieldID, optionID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockFieldRepository) UpdateBoardFieldCache(boardID uuid.UUID) (string, error) {
 	args := m.Called(boardID)
 	return args.String(0), args.Error(1)
@@ -355,6 +401,24 @@ func (m *MockFieldRepository) DeleteView(id uuid.UUID) error {
 	return args.Error(0)
 }
 
+func (m *MockFieldRepository) FindViewUserSettings(viewID, userID uuid.UUID) (*domain.SavedViewUserSettings, error) {
+	args := m.Called(viewID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SavedViewUserSettings), args.Error(1)
+}
+
+func (m *MockFieldRepository) SaveViewUserSettings(settings *domain.SavedViewUserSettings) error {
+	args := m.Called(settings)
+	return args.Error(0)
+}
+
+func (m *MockFieldRepository) DeleteViewUserSettings(viewID, userID uuid.UUID) error {
+	args := m.Called(viewID, userID)
+	return args.Error(0)
+}
+
 // Board Order methods
 func (m *MockFieldRepository) SetBoardOrder(order *domain.UserBoardOrder) error {
 	args := m.Called(order)
@@ -410,6 +474,205 @@ func (m *MockCommentRepository) Delete(id uuid.UUID) error {
 	return args.Error(0)
 }
 
+// ==================== Mock MentionRepository ====================
+
+type MockMentionRepository struct {
+	mock.Mock
+}
+
+func (m *MockMentionRepository) FindByID(id uuid.UUID) (*domain.Mention, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Mention), args.Error(1)
+}
+
+func (m *MockMentionRepository) Update(mention *domain.Mention) error {
+	args := m.Called(mention)
+	return args.Error(0)
+}
+
+func (m *MockMentionRepository) CreateBatch(mentions []domain.Mention) error {
+	args := m.Called(mentions)
+	return args.Error(0)
+}
+
+func (m *MockMentionRepository) FindUserIDsBySource(sourceID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(sourceID)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockMentionRepository) FindByUser(userID uuid.UUID, unreadOnly bool, page, limit int) ([]domain.Mention, int64, error) {
+	args := m.Called(userID, unreadOnly, page, limit)
+	return args.Get(0).([]domain.Mention), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockMentionRepository) CountUnread(userID uuid.UUID) (int64, error) {
+	args := m.Called(userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockMentionRepository) MarkAllAsRead(userID uuid.UUID) (int64, error) {
+	args := m.Called(userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// ==================== Mock NotificationRepository ====================
+
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationRepository) FindByID(id uuid.UUID) (*domain.Notification, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) Update(notification *domain.Notification) error {
+	args := m.Called(notification)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) CreateBatch(notifications []domain.Notification) error {
+	args := m.Called(notifications)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) FindByUser(userID uuid.UUID, unreadOnly bool, page, limit int) ([]domain.Notification, int64, error) {
+	args := m.Called(userID, unreadOnly, page, limit)
+	return args.Get(0).([]domain.Notification), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockNotificationRepository) CountUnread(userID uuid.UUID) (int64, error) {
+	args := m.Called(userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationRepository) MarkAllAsRead(userID uuid.UUID) (int64, error) {
+	args := m.Called(userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationRepository) FindPreference(userID, projectID uuid.UUID) (*domain.NotificationPreference, error) {
+	args := m.Called(userID, projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.NotificationPreference), args.Error(1)
+}
+
+func (m *MockNotificationRepository) FindPreferencesByUsers(userIDs []uuid.UUID, projectID uuid.UUID) ([]domain.NotificationPreference, error) {
+	args := m.Called(userIDs, projectID)
+	return args.Get(0).([]domain.NotificationPreference), args.Error(1)
+}
+
+func (m *MockNotificationRepository) SavePreference(preference *domain.NotificationPreference) error {
+	args := m.Called(preference)
+	return args.Error(0)
+}
+
+// ==================== Mock BoardLinkRepository ====================
+
+type MockBoardLinkRepository struct {
+	mock.Mock
+}
+
+func (m *MockBoardLinkRepository) Create(link *domain.BoardLink) error {
+	args := m.Called(link)
+	return args.Error(0)
+}
+
+func (m *MockBoardLinkRepository) FindByID(id uuid.UUID) (*domain.BoardLink, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BoardLink), args.Error(1)
+}
+
+func (m *MockBoardLinkRepository) Delete(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockBoardLinkRepository) FindByBoard(boardID uuid.UUID) ([]domain.BoardLink, error) {
+	args := m.Called(boardID)
+	return args.Get(0).([]domain.BoardLink), args.Error(1)
+}
+
+func (m *MockBoardLinkRepository) FindByBoards(boardIDs []uuid.UUID) ([]domain.BoardLink, error) {
+	args := m.Called(boardIDs)
+	return args.Get(0).([]domain.BoardLink), args.Error(1)
+}
+
+func (m *MockBoardLinkRepository) FindBetween(boardID, otherBoardID uuid.UUID) ([]domain.BoardLink, error) {
+	args := m.Called(boardID, otherBoardID)
+	return args.Get(0).([]domain.BoardLink), args.Error(1)
+}
+
+func (m *MockBoardLinkRepository) FindBlockingByProject(projectID uuid.UUID) ([]domain.BoardLink, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.BoardLink), args.Error(1)
+}
+
+func (m *MockBoardLinkRepository) DeleteByBoard(boardID uuid.UUID) error {
+	args := m.Called(boardID)
+	return args.Error(0)
+}
+
+func (m *MockBoardLinkRepository) LockProject(projectID uuid.UUID) error {
+	args := m.Called(projectID)
+	return args.Error(0)
+}
+
+// ==================== Mock ChecklistRepository ====================
+
+type MockChecklistRepository struct {
+	mock.Mock
+}
+
+func (m *MockChecklistRepository) Create(item *domain.ChecklistItem) error {
+	args := m.Called(item)
+	return args.Error(0)
+}
+
+func (m *MockChecklistRepository) FindByID(id uuid.UUID) (*domain.ChecklistItem, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ChecklistItem), args.Error(1)
+}
+
+func (m *MockChecklistRepository) Update(item *domain.ChecklistItem) error {
+	args := m.Called(item)
+	return args.Error(0)
+}
+
+func (m *MockChecklistRepository) Delete(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockChecklistRepository) FindByBoard(boardID uuid.UUID) ([]domain.ChecklistItem, error) {
+	args := m.Called(boardID)
+	return args.Get(0).([]domain.ChecklistItem), args.Error(1)
+}
+
+func (m *MockChecklistRepository) FindLastPosition(boardID uuid.UUID) (string, error) {
+	args := m.Called(boardID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockChecklistRepository) CountProgressByBoards(boardIDs []uuid.UUID) (map[uuid.UUID]domain.ChecklistProgress, error) {
+	args := m.Called(boardIDs)
+	return args.Get(0).(map[uuid.UUID]domain.ChecklistProgress), args.Error(1)
+}
+
 // ==================== Helper Functions ====================
 
 // ExpectNotFoundError configures mock to return gorm.ErrRecordNotFound
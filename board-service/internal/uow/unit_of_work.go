@@ -19,11 +19,15 @@ type UnitOfWork interface {
 
 // Repositories는 트랜잭션 내에서 사용할 수 있는 모든 repository를 포함합니다
 type Repositories struct {
-	Board   repository.BoardRepository
-	Project repository.ProjectRepository
-	Comment repository.CommentRepository
-	Field   repository.FieldRepository
-	Role    repository.RoleRepository
+	Board      repository.BoardRepository
+	Project    repository.ProjectRepository
+	Comment    repository.CommentRepository
+	Field      repository.FieldRepository
+	Role       repository.RoleRepository
+	Activity   repository.BoardActivityRepository
+	Event      repository.DomainEventRepository // Transactional outbox
+	Link       repository.BoardLinkRepository
+	Attachment repository.AttachmentRepository // Metadata only; stored files are removed after commit
 }
 
 type unitOfWork struct {
@@ -40,11 +44,15 @@ func (uow *unitOfWork) Do(fn func(repos *Repositories) error) error {
 	return uow.db.Transaction(func(tx *gorm.DB) error {
 		// Create repositories with the transaction database
 		repos := &Repositories{
-			Board:   repository.NewBoardRepository(tx),
-			Project: repository.NewProjectRepository(tx),
-			Comment: repository.NewCommentRepository(tx),
-			Field:   repository.NewFieldRepository(tx),
-			Role:    repository.NewRoleRepository(tx),
+			Board:      repository.NewBoardRepository(tx),
+			Project:    repository.NewProjectRepository(tx),
+			Comment:    repository.NewCommentRepository(tx),
+			Field:      repository.NewFieldRepository(tx),
+			Role:       repository.NewRoleRepository(tx),
+			Activity:   repository.NewBoardActivityRepository(tx),
+			Event:      repository.NewDomainEventRepository(tx),
+			Link:       repository.NewBoardLinkRepository(tx),
+			Attachment: repository.NewAttachmentRepository(tx),
 		}
 
 		// Execute the business logic
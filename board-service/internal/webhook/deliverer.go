@@ -0,0 +1,192 @@
+package webhook
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/repository"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DelivererConfig controls polling, timeouts and the retry schedule of webhook deliveries
+type DelivererConfig struct {
+	BatchSize    int
+	PollInterval time.Duration
+	Timeout      time.Duration // Per-request timeout
+	Lease        time.Duration // How long a claimed delivery is hidden from other replicas
+	MaxAttempts  int
+	BaseBackoff  time.Duration // Delay after the first failure, doubled on every retry
+	MaxBackoff   time.Duration
+}
+
+// DefaultDelivererConfig returns the settings used in production
+// With these values a delivery is retried for about 3.5 hours before it is given up.
+func DefaultDelivererConfig() DelivererConfig {
+	return DelivererConfig{
+		BatchSize:    50,
+		PollInterval: 2 * time.Second,
+		Timeout:      10 * time.Second,
+		Lease:        time.Minute,
+		MaxAttempts:  12,
+		BaseBackoff:  10 * time.Second,
+		MaxBackoff:   time.Hour,
+	}
+}
+
+var errWebhookDisabled = errors.New("webhook is disabled or deleted")
+
+// Deliverer sends pending webhook deliveries in the background
+type Deliverer struct {
+	repo   repository.WebhookRepository
+	client *http.Client
+	logger *zap.Logger
+	cfg    DelivererConfig
+	now    func() time.Time
+}
+
+// NewDeliverer creates a new Deliverer with DefaultDelivererConfig
+// Requests only reach addresses allowed by policy.
+func NewDeliverer(repo repository.WebhookRepository, policy URLPolicy, logger *zap.Logger) *Deliverer {
+	cfg := DefaultDelivererConfig()
+	return &Deliverer{
+		repo:   repo,
+		client: policy.HTTPClient(cfg.Timeout),
+		logger: logger,
+		cfg:    cfg,
+		now:    time.Now,
+	}
+}
+
+// Run polls for due deliveries until ctx is cancelled
+func (d *Deliverer) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	d.logger.Info("Webhook deliverer started")
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("Webhook deliverer stopped")
+			return
+		case <-ticker.C:
+			if _, err := d.ProcessBatch(ctx); err != nil {
+				d.logger.Error("Webhook delivery batch failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// ProcessBatch sends one batch of due deliveries and records each outcome
+// It returns the number of deliveries attempted.
+func (d *Deliverer) ProcessBatch(ctx context.Context) (int, error) {
+	deliveries, err := d.repo.ClaimDueDeliveries(d.now(), d.cfg.Lease, d.cfg.BatchSize)
+	if err != nil || len(deliveries) == 0 {
+		return 0, err
+	}
+
+	webhookIDs := make([]uuid.UUID, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		webhookIDs = append(webhookIDs, delivery.WebhookID)
+	}
+	webhooks, err := d.repo.FindByIDs(webhookIDs)
+	if err != nil {
+		return 0, err
+	}
+	webhookMap := make(map[uuid.UUID]*domain.Webhook, len(webhooks))
+	for i := range webhooks {
+		webhookMap[webhooks[i].ID] = &webhooks[i]
+	}
+
+	for i := range deliveries {
+		delivery := &deliveries[i]
+
+		webhook, ok := webhookMap[delivery.WebhookID]
+		if !ok || !webhook.IsActive || webhook.IsDeleted {
+			// No point retrying: give up immediately
+			delivery.MarkAsFailed(errWebhookDisabled, d.now(), 0, 0)
+		} else {
+			d.deliver(ctx, webhook, delivery)
+		}
+
+		if err := d.repo.UpdateDelivery(delivery); err != nil {
+			d.logger.Error("Failed to save webhook delivery result",
+				zap.String("delivery_id", delivery.ID.String()),
+				zap.Error(err))
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// deliver performs a single attempt and updates the delivery with the outcome
+func (d *Deliverer) deliver(ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery) {
+	start := d.now()
+	status, body, err := d.send(ctx, webhook, delivery)
+	delivery.RecordResponse(status, body, d.now().Sub(start))
+
+	if err == nil && (status < 200 || status >= 300) {
+		err = fmt.Errorf("receiver responded with status %d", status)
+	}
+
+	now := d.now()
+	if err != nil {
+		delivery.MarkAsFailed(err, now, d.backoff(delivery.Attempts), d.cfg.MaxAttempts)
+		d.logger.Warn("Webhook delivery failed",
+			zap.String("webhook_id", webhook.ID.String()),
+			zap.String("delivery_id", delivery.ID.String()),
+			zap.String("event_type", delivery.EventType),
+			zap.Int("attempts", delivery.Attempts),
+			zap.Error(err))
+		return
+	}
+
+	delivery.MarkAsSucceeded(now)
+}
+
+// send posts the signed payload to the webhook URL
+func (d *Deliverer) send(ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery) (int, []byte, error) {
+	body := []byte(delivery.Payload)
+	timestamp := d.now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "board-service-webhooks/1.0")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID.String())
+	req.Header.Set(HeaderWebhook, webhook.ID.String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	return resp.StatusCode, respBody, nil
+}
+
+// backoff returns the delay before the next attempt: BaseBackoff * 2^attempts, capped at MaxBackoff
+func (d *Deliverer) backoff(attempts int) time.Duration {
+	delay := d.cfg.BaseBackoff
+	for i := 0; i < attempts; i++ {
+		delay *= 2
+		if delay >= d.cfg.MaxBackoff {
+			return d.cfg.MaxBackoff
+		}
+	}
+	return delay
+}
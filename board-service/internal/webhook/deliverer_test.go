@@ -0,0 +1,285 @@
+package webhook
+
+import (
+	"board-service/internal/domain"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// memoryWebhookRepository keeps webhooks and deliveries in memory
+type memoryWebhookRepository struct {
+	webhooks   map[uuid.UUID]domain.Webhook
+	deliveries map[uuid.UUID]domain.WebhookDelivery
+	order      []uuid.UUID
+}
+
+func newMemoryWebhookRepository() *memoryWebhookRepository {
+	return &memoryWebhookRepository{
+		webhooks:   make(map[uuid.UUID]domain.Webhook),
+		deliveries: make(map[uuid.UUID]domain.WebhookDelivery),
+	}
+}
+
+func (r *memoryWebhookRepository) Create(webhook *domain.Webhook) error {
+	if webhook.ID == uuid.Nil {
+		webhook.ID = uuid.New()
+	}
+	r.webhooks[webhook.ID] = *webhook
+	return nil
+}
+
+func (r *memoryWebhookRepository) FindByID(id uuid.UUID) (*domain.Webhook, error) {
+	webhook, ok := r.webhooks[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &webhook, nil
+}
+
+func (r *memoryWebhookRepository) Update(webhook *domain.Webhook) error {
+	r.webhooks[webhook.ID] = *webhook
+	return nil
+}
+
+func (r *memoryWebhookRepository) Delete(id uuid.UUID) error {
+	webhook := r.webhooks[id]
+	webhook.IsDeleted = true
+	r.webhooks[id] = webhook
+	return nil
+}
+
+func (r *memoryWebhookRepository) FindByProject(projectID uuid.UUID) ([]domain.Webhook, error) {
+	return nil, nil
+}
+
+func (r *memoryWebhookRepository) FindActiveByProject(projectID uuid.UUID) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	for _, webhook := range r.webhooks {
+		if webhook.ProjectID == projectID && webhook.IsActive && !webhook.IsDeleted {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	return webhooks, nil
+}
+
+func (r *memoryWebhookRepository) FindByIDs(ids []uuid.UUID) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	for _, id := range ids {
+		if webhook, ok := r.webhooks[id]; ok {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	return webhooks, nil
+}
+
+// CreateDeliveries skips deliveries of an event the webhook already has, like idx_webhook_deliveries_event
+func (r *memoryWebhookRepository) CreateDeliveries(deliveries []domain.WebhookDelivery) error {
+	for i := range deliveries {
+		if deliveries[i].RedeliveryOf == nil && r.hasDelivery(deliveries[i].WebhookID, deliveries[i].EventID) {
+			continue
+		}
+		if deliveries[i].ID == uuid.Nil {
+			deliveries[i].ID = uuid.New()
+		}
+		r.deliveries[deliveries[i].ID] = deliveries[i]
+		r.order = append(r.order, deliveries[i].ID)
+	}
+	return nil
+}
+
+func (r *memoryWebhookRepository) hasDelivery(webhookID uuid.UUID, eventID string) bool {
+	for _, delivery := range r.deliveries {
+		if delivery.WebhookID == webhookID && delivery.EventID == eventID && delivery.RedeliveryOf == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *memoryWebhookRepository) FindDeliveryByID(id uuid.UUID) (*domain.WebhookDelivery, error) {
+	delivery, ok := r.deliveries[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &delivery, nil
+}
+
+func (r *memoryWebhookRepository) FindDeliveriesByWebhook(webhookID uuid.UUID, page, limit int) ([]domain.WebhookDelivery, int64, error) {
+	return nil, 0, nil
+}
+
+func (r *memoryWebhookRepository) ClaimDueDeliveries(now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error) {
+	var due []domain.WebhookDelivery
+	for _, id := range r.order {
+		delivery := r.deliveries[id]
+		if delivery.Status == domain.WebhookDeliveryPending && !delivery.NextAttemptAt.After(now) && len(due) < limit {
+			delivery.NextAttemptAt = now.Add(lease)
+			r.deliveries[id] = delivery
+			due = append(due, delivery)
+		}
+	}
+	return due, nil
+}
+
+func (r *memoryWebhookRepository) UpdateDelivery(delivery *domain.WebhookDelivery) error {
+	r.deliveries[delivery.ID] = *delivery
+	return nil
+}
+
+func setupDeliverer(t *testing.T, url string) (*Deliverer, *memoryWebhookRepository, *domain.Webhook) {
+	t.Helper()
+	repo := newMemoryWebhookRepository()
+	deliverer := NewDeliverer(repo, URLPolicy{AllowPrivate: true}, zap.NewNop())
+	deliverer.cfg.MaxAttempts = 3
+
+	webhook := &domain.Webhook{
+		ProjectID: uuid.New(),
+		URL:       url,
+		Secret:    "whsec_test_secret_value",
+		IsActive:  true,
+		CreatedBy: uuid.New(),
+	}
+	require.NoError(t, repo.Create(webhook))
+	return deliverer, repo, webhook
+}
+
+func queueDelivery(t *testing.T, repo *memoryWebhookRepository, webhook *domain.Webhook, now time.Time) *domain.WebhookDelivery {
+	t.Helper()
+	delivery := domain.NewWebhookDelivery(webhook, uuid.NewString(), "board.created", `{"type":"board.created"}`)
+	delivery.NextAttemptAt = now.Add(-time.Second)
+	deliveries := []domain.WebhookDelivery{*delivery}
+	require.NoError(t, repo.CreateDeliveries(deliveries))
+	return &deliveries[0]
+}
+
+func TestDeliverer_ProcessBatch_SendsSignedRequest(t *testing.T) {
+	var received *http.Request
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	deliverer, repo, webhook := setupDeliverer(t, server.URL)
+	delivery := queueDelivery(t, repo, webhook, time.Now())
+
+	processed, err := deliverer.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+
+	require.NotNil(t, received)
+	assert.Equal(t, `{"type":"board.created"}`, string(receivedBody))
+	assert.Equal(t, "board.created", received.Header.Get(HeaderEvent))
+	assert.Equal(t, delivery.ID.String(), received.Header.Get(HeaderDelivery))
+	assert.Equal(t, webhook.ID.String(), received.Header.Get(HeaderWebhook))
+
+	timestamp, err := strconv.ParseInt(received.Header.Get(HeaderTimestamp), 10, 64)
+	require.NoError(t, err)
+	assert.True(t, Verify(webhook.Secret, timestamp, receivedBody, received.Header.Get(HeaderSignature)))
+	assert.False(t, Verify("other-secret", timestamp, receivedBody, received.Header.Get(HeaderSignature)))
+
+	stored := repo.deliveries[delivery.ID]
+	assert.Equal(t, domain.WebhookDeliverySucceeded, stored.Status)
+	assert.Equal(t, 1, stored.Attempts)
+	assert.Equal(t, http.StatusOK, stored.ResponseStatus)
+	assert.Equal(t, "ok", stored.ResponseBody)
+	assert.NotNil(t, stored.DeliveredAt)
+
+	// Succeeded deliveries are not sent again
+	processed, err = deliverer.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, processed)
+}
+
+func TestDeliverer_ProcessBatch_RetriesWithBackoffThenGivesUp(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	deliverer, repo, webhook := setupDeliverer(t, server.URL)
+	now := time.Now()
+	deliverer.now = func() time.Time { return now }
+	delivery := queueDelivery(t, repo, webhook, now)
+
+	// First failure: scheduled after the base backoff
+	_, err := deliverer.ProcessBatch(context.Background())
+	require.NoError(t, err)
+
+	stored := repo.deliveries[delivery.ID]
+	assert.Equal(t, domain.WebhookDeliveryPending, stored.Status)
+	assert.Equal(t, 1, stored.Attempts)
+	assert.Equal(t, http.StatusInternalServerError, stored.ResponseStatus)
+	assert.Equal(t, "receiver responded with status 500", stored.LastError)
+	assert.Equal(t, now.Add(deliverer.cfg.BaseBackoff), stored.NextAttemptAt)
+
+	// Not due yet
+	processed, err := deliverer.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, processed)
+
+	// Keep failing until MaxAttempts is reached
+	for i := 0; i < deliverer.cfg.MaxAttempts-1; i++ {
+		now = now.Add(deliverer.cfg.MaxBackoff)
+		_, err = deliverer.ProcessBatch(context.Background())
+		require.NoError(t, err)
+	}
+
+	stored = repo.deliveries[delivery.ID]
+	assert.Equal(t, domain.WebhookDeliveryFailed, stored.Status)
+	assert.Equal(t, deliverer.cfg.MaxAttempts, stored.Attempts)
+	assert.Equal(t, deliverer.cfg.MaxAttempts, calls)
+
+	// Given-up deliveries are never retried automatically
+	now = now.Add(deliverer.cfg.MaxBackoff)
+	processed, err = deliverer.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, processed)
+}
+
+func TestDeliverer_ProcessBatch_GivesUpForInactiveWebhook(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	deliverer, repo, webhook := setupDeliverer(t, server.URL)
+	delivery := queueDelivery(t, repo, webhook, time.Now())
+
+	webhook.IsActive = false
+	require.NoError(t, repo.Update(webhook))
+
+	_, err := deliverer.ProcessBatch(context.Background())
+	require.NoError(t, err)
+
+	stored := repo.deliveries[delivery.ID]
+	assert.Equal(t, domain.WebhookDeliveryFailed, stored.Status)
+	assert.Equal(t, errWebhookDisabled.Error(), stored.LastError)
+	assert.Zero(t, calls)
+}
+
+func TestDeliverer_Backoff(t *testing.T) {
+	deliverer := NewDeliverer(nil, URLPolicy{AllowPrivate: true}, zap.NewNop())
+
+	assert.Equal(t, 10*time.Second, deliverer.backoff(0))
+	assert.Equal(t, 20*time.Second, deliverer.backoff(1))
+	assert.Equal(t, 80*time.Second, deliverer.backoff(3))
+	assert.Equal(t, time.Hour, deliverer.backoff(20))
+}
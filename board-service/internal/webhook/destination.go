@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedAddress is returned when a webhook would reach a loopback, private or link-local address
+var ErrBlockedAddress = errors.New("webhook destination address is not allowed")
+
+// blockedNetworks are ranges IsLoopback/IsPrivate/IsLinkLocal* do not cover
+var blockedNetworks = mustParseCIDRs(
+	"0.0.0.0/8",     // "This" network
+	"100.64.0.0/10", // Carrier-grade NAT, also used for cloud metadata endpoints
+	"192.0.0.0/24",  // IETF protocol assignments
+	"198.18.0.0/15", // Benchmarking
+)
+
+// URLPolicy restricts where webhooks can be sent
+// Only http(s) URLs of public hosts are accepted, so project admins cannot make the server call
+// internal services or cloud metadata endpoints. Addresses are checked when a webhook is saved and
+// again on every connection, which also covers redirects and DNS records changed after saving.
+type URLPolicy struct {
+	AllowPrivate bool // Allow loopback/private/link-local addresses (local test receivers only)
+}
+
+// ValidateURL checks the scheme and resolves the host of a webhook URL
+func (p URLPolicy) ValidateURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return errors.New("missing host")
+	}
+	if u.User != nil {
+		return errors.New("credentials in the URL are not allowed")
+	}
+	if p.AllowPrivate {
+		return nil
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return p.checkIP(ip)
+	}
+	if host = strings.ToLower(strings.TrimSuffix(host, ".")); host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrBlockedAddress
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("cannot resolve host %q", host)
+	}
+	for _, addr := range addrs {
+		if err := p.checkIP(addr.IP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DialContext returns the dialer's DialContext, refusing connections to blocked addresses
+// The check runs on the resolved address right before connecting, so DNS rebinding cannot bypass it.
+func (p URLPolicy) DialContext(dialer *net.Dialer) func(ctx context.Context, network, address string) (net.Conn, error) {
+	guarded := *dialer
+	guarded.Control = func(network, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		return p.checkIP(net.ParseIP(host))
+	}
+	return guarded.DialContext
+}
+
+// HTTPClient returns a client that only connects to addresses allowed by the policy
+// Environment proxies are not used: the policy would check the proxy instead of the receiver.
+func (p URLPolicy) HTTPClient(timeout time.Duration) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = p.DialContext(&net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second})
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// checkIP rejects loopback, private, link-local, multicast and unspecified addresses unless AllowPrivate
+func (p URLPolicy) checkIP(ip net.IP) error {
+	if p.AllowPrivate {
+		return nil
+	}
+	if ip == nil {
+		return ErrBlockedAddress
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return ErrBlockedAddress
+	}
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return ErrBlockedAddress
+		}
+	}
+	return nil
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/realtime"
+	"board-service/internal/repository"
+	"context"
+	"encoding/json"
+)
+
+// OutboxPublisher creates webhook deliveries from outbox events (implements outbox.Publisher)
+// It runs in the outbox relay, so every committed change gets its deliveries even if the process
+// stops right after the commit or Redis is down. The deliveries are then sent by the Deliverer.
+type OutboxPublisher struct {
+	repo repository.WebhookRepository
+}
+
+// NewOutboxPublisher creates a new OutboxPublisher
+func NewOutboxPublisher(repo repository.WebhookRepository) *OutboxPublisher {
+	return &OutboxPublisher{repo: repo}
+}
+
+// Publish creates a delivery of the event for every active project webhook subscribed to it
+// The relay may publish an event again; deliveries already created for it are left as they are.
+func (p *OutboxPublisher) Publish(ctx context.Context, event *domain.DomainEvent) error {
+	if !isWebhookEvent(event.EventType) {
+		return nil
+	}
+
+	webhooks, err := p.repo.FindActiveByProject(event.ProjectID)
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(toProjectEvent(event))
+	if err != nil {
+		return err
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(webhooks))
+	for i := range webhooks {
+		if webhooks[i].Subscribes(event.EventType) {
+			deliveries = append(deliveries, *domain.NewWebhookDelivery(&webhooks[i], event.ID.String(), event.EventType, string(payload)))
+		}
+	}
+	return p.repo.CreateDeliveries(deliveries)
+}
+
+// isWebhookEvent reports whether webhooks can subscribe to the event type (e.g. not project.created)
+func isWebhookEvent(eventType string) bool {
+	for _, t := range realtime.EventTypes {
+		if string(t) == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// toProjectEvent converts an outbox event to the project event sent as the webhook payload
+// The outbox event ID is kept, so receivers can dedupe deliveries of the same change.
+func toProjectEvent(event *domain.DomainEvent) *realtime.Event {
+	projectEvent := &realtime.Event{
+		ID:         event.ID.String(),
+		Type:       realtime.EventType(event.EventType),
+		ProjectID:  event.ProjectID.String(),
+		ActorID:    event.ActorID.String(),
+		Data:       json.RawMessage(event.Payload),
+		OccurredAt: event.CreatedAt,
+	}
+	if event.AggregateType == domain.AggregateBoard {
+		projectEvent.BoardID = event.AggregateID.String()
+	}
+	return projectEvent
+}
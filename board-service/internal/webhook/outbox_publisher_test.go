@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/realtime"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxPublisher_CreatesDeliveriesForSubscribedWebhooks(t *testing.T) {
+	repo := newMemoryWebhookRepository()
+	publisher := NewOutboxPublisher(repo)
+	projectID, boardID := uuid.New(), uuid.New()
+
+	all := &domain.Webhook{ProjectID: projectID, IsActive: true}
+	comments := &domain.Webhook{ProjectID: projectID, IsActive: true, Events: `["comment.*"]`}
+	inactive := &domain.Webhook{ProjectID: projectID}
+	otherProject := &domain.Webhook{ProjectID: uuid.New(), IsActive: true}
+	for _, webhook := range []*domain.Webhook{all, comments, inactive, otherProject} {
+		require.NoError(t, repo.Create(webhook))
+	}
+
+	event, err := domain.NewDomainEvent(domain.AggregateBoard, boardID, projectID, uuid.New(), string(realtime.EventBoardUpdated), map[string]string{"title": "Renamed"})
+	require.NoError(t, err)
+	event.ID = uuid.New()
+
+	require.NoError(t, publisher.Publish(context.Background(), event))
+	require.Len(t, repo.deliveries, 1)
+	delivery := repo.deliveries[repo.order[0]]
+	assert.Equal(t, all.ID, delivery.WebhookID)
+	assert.Equal(t, event.ID.String(), delivery.EventID)
+
+	// The payload keeps the project event shape, with the outbox event ID for deduping
+	var payload realtime.Event
+	require.NoError(t, json.Unmarshal([]byte(delivery.Payload), &payload))
+	assert.Equal(t, event.ID.String(), payload.ID)
+	assert.Equal(t, realtime.EventBoardUpdated, payload.Type)
+	assert.Equal(t, boardID.String(), payload.BoardID)
+	assert.JSONEq(t, `{"title":"Renamed"}`, string(payload.Data))
+
+	// The relay may publish the same event again
+	require.NoError(t, publisher.Publish(context.Background(), event))
+	assert.Len(t, repo.deliveries, 1)
+
+	// Events webhooks cannot subscribe to are skipped
+	created, err := domain.NewDomainEvent(domain.AggregateProject, projectID, projectID, uuid.New(), domain.EventTypeProjectCreated, nil)
+	require.NoError(t, err)
+	require.NoError(t, publisher.Publish(context.Background(), created))
+	assert.Len(t, repo.deliveries, 1)
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS board_activities CASCADE;
+DELETE FROM schema_versions WHERE version = '20261016100000';
@@ -0,0 +1,28 @@
+-- ============================================
+-- Board Activities (audit trail with per-field diffs)
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS board_activities (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    actor_id UUID NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    changes JSONB DEFAULT '[]',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_activities_board_id ON board_activities(board_id);
+CREATE INDEX IF NOT EXISTS idx_board_activities_project_id ON board_activities(project_id);
+CREATE INDEX IF NOT EXISTS idx_board_activities_actor_id ON board_activities(actor_id);
+CREATE INDEX IF NOT EXISTS idx_board_activities_created_at ON board_activities(created_at);
+
+COMMENT ON TABLE board_activities IS 'Append-only board history (update, move, delete, field value changes)';
+COMMENT ON COLUMN board_activities.board_id IS 'References boards.id (no FK for sharding)';
+COMMENT ON COLUMN board_activities.project_id IS 'References projects.id (no FK for sharding)';
+COMMENT ON COLUMN board_activities.actor_id IS 'References users.id (no FK for microservice isolation)';
+COMMENT ON COLUMN board_activities.action IS 'board.updated, board.moved, board.deleted, field_value.changed';
+COMMENT ON COLUMN board_activities.changes IS 'JSON array of {field, fieldName, oldValue, newValue}; option values keep their label';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016100000', 'Add board_activities table');
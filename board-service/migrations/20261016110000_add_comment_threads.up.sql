@@ -0,0 +1,14 @@
+-- ============================================
+-- Threaded comment replies
+-- ============================================
+
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS parent_id UUID;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS depth INT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments(parent_id);
+
+COMMENT ON COLUMN comments.parent_id IS 'References comments.id of the parent comment; NULL for root comments (no FK for sharding)';
+COMMENT ON COLUMN comments.depth IS 'Nesting level: 0 = root, max 2 (domain.MaxCommentDepth)';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016110000', 'Add parent_id and depth to comments for threaded replies');
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS mentions CASCADE;
+DELETE FROM schema_versions WHERE version = '20261016120000';
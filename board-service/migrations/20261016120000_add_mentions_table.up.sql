@@ -0,0 +1,36 @@
+-- ============================================
+-- Mentions (@nickname / @userId in comments and board descriptions)
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS mentions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL,
+    board_id UUID NOT NULL,
+    source_type VARCHAR(20) NOT NULL,
+    source_id UUID NOT NULL,
+    mentioned_user_id UUID NOT NULL,
+    mentioned_by UUID NOT NULL,
+    excerpt TEXT,
+    is_read BOOLEAN DEFAULT FALSE,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_mention_source_user ON mentions(source_id, mentioned_user_id);
+CREATE INDEX IF NOT EXISTS idx_mentions_project_id ON mentions(project_id);
+CREATE INDEX IF NOT EXISTS idx_mentions_board_id ON mentions(board_id);
+CREATE INDEX IF NOT EXISTS idx_mentions_mentioned_user_id ON mentions(mentioned_user_id);
+CREATE INDEX IF NOT EXISTS idx_mentions_is_read ON mentions(is_read);
+
+COMMENT ON TABLE mentions IS 'Per-user mention inbox; one row per (source, mentioned user)';
+COMMENT ON COLUMN mentions.project_id IS 'References projects.id (no FK for sharding)';
+COMMENT ON COLUMN mentions.board_id IS 'References boards.id (no FK for sharding)';
+COMMENT ON COLUMN mentions.source_type IS 'comment, board';
+COMMENT ON COLUMN mentions.source_id IS 'References comments.id or boards.id depending on source_type (no FK)';
+COMMENT ON COLUMN mentions.mentioned_user_id IS 'References users.id (no FK for microservice isolation)';
+COMMENT ON COLUMN mentions.mentioned_by IS 'References users.id (no FK for microservice isolation)';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016120000', 'Add mentions table');
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS notification_preferences CASCADE;
+DROP TABLE IF EXISTS notifications CASCADE;
+DELETE FROM schema_versions WHERE version = '20261016130000';
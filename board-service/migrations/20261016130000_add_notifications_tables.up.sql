@@ -0,0 +1,61 @@
+-- ============================================
+-- Notifications (in-app, one row per recipient)
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    board_id UUID,
+    type VARCHAR(50) NOT NULL,
+    actor_id UUID NOT NULL,
+    resource_id UUID,
+    title VARCHAR(255),
+    detail TEXT,
+    is_read BOOLEAN DEFAULT FALSE,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications(user_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_project_id ON notifications(project_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_board_id ON notifications(board_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_is_read ON notifications(is_read);
+
+COMMENT ON TABLE notifications IS 'In-app notifications (assignment, due date, comment, mention, join request, role change)';
+COMMENT ON COLUMN notifications.user_id IS 'Recipient. References users.id (no FK for microservice isolation)';
+COMMENT ON COLUMN notifications.project_id IS 'References projects.id (no FK for sharding)';
+COMMENT ON COLUMN notifications.board_id IS 'References boards.id (no FK for sharding), NULL for project-level notifications';
+COMMENT ON COLUMN notifications.type IS 'board.assigned, board.due_date_changed, comment.created, mention, join_request.decided, member.role_changed';
+COMMENT ON COLUMN notifications.actor_id IS 'References users.id (no FK for microservice isolation)';
+COMMENT ON COLUMN notifications.resource_id IS 'References comments.id, project_join_requests.id or project_members.id depending on type (no FK)';
+
+-- ============================================
+-- Notification Preferences (per user, per project)
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    assignments BOOLEAN NOT NULL DEFAULT TRUE,
+    due_dates BOOLEAN NOT NULL DEFAULT TRUE,
+    comments BOOLEAN NOT NULL DEFAULT TRUE,
+    mentions BOOLEAN NOT NULL DEFAULT TRUE,
+    join_requests BOOLEAN NOT NULL DEFAULT TRUE,
+    role_changes BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_pref_user_project ON notification_preferences(user_id, project_id);
+
+COMMENT ON TABLE notification_preferences IS 'Per-user, per-project notification settings; a missing row means everything is enabled';
+COMMENT ON COLUMN notification_preferences.user_id IS 'References users.id (no FK for microservice isolation)';
+COMMENT ON COLUMN notification_preferences.project_id IS 'References projects.id (no FK for sharding)';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016130000', 'Add notifications and notification_preferences tables');
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS domain_events CASCADE;
+DELETE FROM schema_versions WHERE version = '20261016140000';
@@ -0,0 +1,34 @@
+-- ============================================
+-- Domain Events (transactional outbox)
+-- ============================================
+-- Rows are written in the same transaction as the change they describe
+-- and published by the outbox relay (at-least-once delivery)
+
+CREATE TABLE IF NOT EXISTS domain_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id UUID NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    project_id UUID NOT NULL,
+    actor_id UUID NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_domain_events_pending ON domain_events(status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_domain_events_aggregate ON domain_events(aggregate_type, aggregate_id);
+
+COMMENT ON TABLE domain_events IS 'Transactional outbox of domain events published to other services';
+COMMENT ON COLUMN domain_events.aggregate_id IS 'References boards.id or projects.id depending on aggregate_type (no FK)';
+COMMENT ON COLUMN domain_events.project_id IS 'References projects.id (no FK for sharding)';
+COMMENT ON COLUMN domain_events.actor_id IS 'References users.id (no FK for microservice isolation)';
+COMMENT ON COLUMN domain_events.status IS 'pending, published, failed (gave up after max attempts)';
+COMMENT ON COLUMN domain_events.next_attempt_at IS 'Earliest time of the next publish attempt (exponential backoff after failures)';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016140000', 'Add domain_events outbox table');
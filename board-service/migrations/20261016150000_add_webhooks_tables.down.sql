@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS webhook_deliveries CASCADE;
+DROP TABLE IF EXISTS webhooks CASCADE;
+DELETE FROM schema_versions WHERE version = '20261016150000';
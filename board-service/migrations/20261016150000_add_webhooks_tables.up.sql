@@ -0,0 +1,62 @@
+-- ============================================
+-- Project Webhooks
+-- ============================================
+-- Project admins subscribe URLs to project events.
+-- Every event is queued as a row in webhook_deliveries and sent by the
+-- webhook deliverer with an HMAC-SHA256 signature (retried with backoff).
+
+CREATE TABLE IF NOT EXISTS webhooks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    events JSONB DEFAULT '[]',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhooks_project_id ON webhooks(project_id);
+
+COMMENT ON TABLE webhooks IS 'Outgoing webhook subscriptions of a project';
+COMMENT ON COLUMN webhooks.project_id IS 'References projects.id (no FK for sharding)';
+COMMENT ON COLUMN webhooks.secret IS 'HMAC-SHA256 signing key, never returned after creation';
+COMMENT ON COLUMN webhooks.events IS 'JSON array of event filters ("board.created", "board.*", "*"); empty means all events';
+COMMENT ON COLUMN webhooks.created_by IS 'References users.id (no FK for microservice isolation)';
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    webhook_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    event_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    response_status INTEGER,
+    response_body TEXT,
+    last_error TEXT,
+    duration_ms BIGINT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP,
+    redelivery_of UUID,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_id ON webhook_deliveries(webhook_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_pending ON webhook_deliveries(status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_created_at ON webhook_deliveries(created_at);
+
+COMMENT ON TABLE webhook_deliveries IS 'Delivery log of webhook requests (one row per event and webhook)';
+COMMENT ON COLUMN webhook_deliveries.webhook_id IS 'References webhooks.id (no FK, log is kept after the webhook is deleted)';
+COMMENT ON COLUMN webhook_deliveries.project_id IS 'References projects.id (no FK for sharding)';
+COMMENT ON COLUMN webhook_deliveries.status IS 'pending, succeeded, failed (gave up after max attempts)';
+COMMENT ON COLUMN webhook_deliveries.response_body IS 'First 1KB of the receiver response';
+COMMENT ON COLUMN webhook_deliveries.next_attempt_at IS 'Earliest time of the next attempt (exponential backoff, pushed forward while claimed)';
+COMMENT ON COLUMN webhook_deliveries.redelivery_of IS 'References webhook_deliveries.id of the delivery that was manually redelivered';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016150000', 'Add webhooks and webhook_deliveries tables');
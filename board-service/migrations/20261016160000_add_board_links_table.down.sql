@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS board_links CASCADE;
+DELETE FROM schema_versions WHERE version = '20261016160000';
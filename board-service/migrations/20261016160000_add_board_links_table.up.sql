@@ -0,0 +1,34 @@
+-- ============================================
+-- Board Links (dependencies between boards)
+-- ============================================
+-- Links are stored in one direction only: "B is_blocked_by A" is saved as
+-- "A blocks B" and read back from B's side. Blocking links never form a cycle
+-- (checked by the application while holding a lock on the project row).
+
+CREATE TABLE IF NOT EXISTS board_links (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL,
+    source_board_id UUID NOT NULL,
+    target_board_id UUID NOT NULL,
+    link_type VARCHAR(20) NOT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT FALSE,
+    CONSTRAINT chk_board_links_type CHECK (link_type IN ('blocks', 'relates_to', 'duplicates')),
+    CONSTRAINT chk_board_links_not_self CHECK (source_board_id <> target_board_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_board_link_pair ON board_links(source_board_id, target_board_id, link_type);
+CREATE INDEX IF NOT EXISTS idx_board_links_target_board_id ON board_links(target_board_id);
+CREATE INDEX IF NOT EXISTS idx_board_links_project_id ON board_links(project_id);
+
+COMMENT ON TABLE board_links IS 'Typed links between boards of the same project';
+COMMENT ON COLUMN board_links.project_id IS 'References projects.id (no FK for sharding)';
+COMMENT ON COLUMN board_links.source_board_id IS 'References boards.id (no FK, links are removed when a board is deleted)';
+COMMENT ON COLUMN board_links.target_board_id IS 'References boards.id (no FK, links are removed when a board is deleted)';
+COMMENT ON COLUMN board_links.link_type IS 'blocks, relates_to, duplicates (is_blocked_by / is_duplicated_by are the inverse view)';
+COMMENT ON COLUMN board_links.created_by IS 'References users.id (no FK for microservice isolation)';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016160000', 'Add board_links table');
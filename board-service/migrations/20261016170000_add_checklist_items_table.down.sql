@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS checklist_items CASCADE;
+DELETE FROM schema_versions WHERE version = '20261016170000';
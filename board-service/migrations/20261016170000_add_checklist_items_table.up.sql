@@ -0,0 +1,36 @@
+-- ============================================
+-- Checklist Items (subtasks inside a board card)
+-- ============================================
+-- Items are ordered by a fractional position string (same scheme as
+-- user_board_order), so reordering only updates the moved row.
+
+CREATE TABLE IF NOT EXISTS checklist_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    title VARCHAR(500) NOT NULL,
+    is_done BOOLEAN NOT NULL DEFAULT FALSE,
+    assignee_id UUID,
+    position VARCHAR(255) NOT NULL,
+    created_by UUID NOT NULL,
+    completed_at TIMESTAMP,
+    completed_by UUID,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_board_position ON checklist_items(board_id, position);
+CREATE INDEX IF NOT EXISTS idx_checklist_items_project_id ON checklist_items(project_id);
+CREATE INDEX IF NOT EXISTS idx_checklist_items_assignee_id ON checklist_items(assignee_id);
+
+COMMENT ON TABLE checklist_items IS 'Checklist items (subtasks) of a board';
+COMMENT ON COLUMN checklist_items.board_id IS 'References boards.id (no FK for sharding)';
+COMMENT ON COLUMN checklist_items.project_id IS 'References projects.id (no FK for sharding)';
+COMMENT ON COLUMN checklist_items.assignee_id IS 'References users.id (no FK for microservice isolation)';
+COMMENT ON COLUMN checklist_items.position IS 'Fractional index within the board checklist';
+COMMENT ON COLUMN checklist_items.created_by IS 'References users.id (no FK for microservice isolation)';
+COMMENT ON COLUMN checklist_items.completed_by IS 'References users.id (no FK for microservice isolation)';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016170000', 'Add checklist_items table');
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS attachments CASCADE;
+DELETE FROM schema_versions WHERE version = '20261016180000';
@@ -0,0 +1,36 @@
+-- ============================================
+-- Attachments (files on boards and comments)
+-- ============================================
+-- Only metadata is stored here; the content lives in object storage
+-- (local filesystem or S3-compatible) under storage_key.
+
+CREATE TABLE IF NOT EXISTS attachments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL,
+    board_id UUID NOT NULL,
+    comment_id UUID,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(500) NOT NULL,
+    uploaded_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attachments_storage_key ON attachments(storage_key);
+CREATE INDEX IF NOT EXISTS idx_attachments_board_id ON attachments(board_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_comment_id ON attachments(comment_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_project_id ON attachments(project_id);
+
+COMMENT ON TABLE attachments IS 'Metadata of files attached to boards and comments';
+COMMENT ON COLUMN attachments.project_id IS 'References projects.id (no FK for sharding)';
+COMMENT ON COLUMN attachments.board_id IS 'References boards.id (no FK for sharding)';
+COMMENT ON COLUMN attachments.comment_id IS 'References comments.id (no FK), NULL when attached to the board itself';
+COMMENT ON COLUMN attachments.content_type IS 'MIME type detected from the content at upload';
+COMMENT ON COLUMN attachments.storage_key IS 'Object key in the configured storage (projects/<id>/boards/<id>/<attachment id>)';
+COMMENT ON COLUMN attachments.uploaded_by IS 'References users.id (no FK for microservice isolation)';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016180000', 'Add attachments table');
@@ -0,0 +1,2 @@
+ALTER TABLE saved_views DROP COLUMN IF EXISTS sorts;
+DELETE FROM schema_versions WHERE version = '20261016190000';
@@ -0,0 +1,12 @@
+-- ============================================
+-- Multi-key sorting for saved views
+-- ============================================
+-- sorts holds the ordered sort keys as JSON ([{"field": ..., "direction": ...}]).
+-- Views saved before this migration keep using sort_by/sort_direction until they are updated.
+
+ALTER TABLE saved_views ADD COLUMN IF NOT EXISTS sorts TEXT NOT NULL DEFAULT '[]';
+
+COMMENT ON COLUMN saved_views.sorts IS 'JSON array of sort keys: built-in key, custom field ID or "position" (manual order); sort_by/sort_direction mirror the first key';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016190000', 'Add sorts to saved_views for multi-key sorting');
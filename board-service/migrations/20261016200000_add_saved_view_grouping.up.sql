@@ -0,0 +1,14 @@
+-- ============================================
+-- Grouping saved views by any key
+-- ============================================
+-- group_by is a custom field ID or a built-in key (assignee, author, due_date, created_at, updated_at).
+-- Views saved before this migration keep using group_by_field_id until they are updated.
+
+ALTER TABLE saved_views ADD COLUMN IF NOT EXISTS group_by VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE saved_views ADD COLUMN IF NOT EXISTS group_by_bucket VARCHAR(10) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN saved_views.group_by IS 'Group key: custom field ID or built-in key; group_by_field_id mirrors custom field keys';
+COMMENT ON COLUMN saved_views.group_by_bucket IS 'Date bucket for date grouping: day, week or month';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016200000', 'Add group_by and group_by_bucket to saved_views');
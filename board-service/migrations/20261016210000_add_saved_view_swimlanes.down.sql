@@ -0,0 +1,3 @@
+ALTER TABLE saved_views DROP COLUMN IF EXISTS swimlane_bucket;
+ALTER TABLE saved_views DROP COLUMN IF EXISTS swimlane_by;
+DELETE FROM schema_versions WHERE version = '20261016210000';
@@ -0,0 +1,14 @@
+-- ============================================
+-- Swimlanes for saved views
+-- ============================================
+-- swimlane_by groups the boards of a grouped view a second time into rows
+-- (custom field ID or built-in key, like group_by).
+
+ALTER TABLE saved_views ADD COLUMN IF NOT EXISTS swimlane_by VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE saved_views ADD COLUMN IF NOT EXISTS swimlane_bucket VARCHAR(10) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN saved_views.swimlane_by IS 'Swimlane (row) key: custom field ID or built-in key; requires group_by';
+COMMENT ON COLUMN saved_views.swimlane_bucket IS 'Date bucket for date swimlanes: day, week or month';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016210000', 'Add swimlane_by and swimlane_bucket to saved_views');
@@ -0,0 +1,3 @@
+ALTER TABLE saved_views DROP COLUMN IF EXISTS calendar_start;
+ALTER TABLE saved_views DROP COLUMN IF EXISTS calendar_field;
+DELETE FROM schema_versions WHERE version = '20261016220000';
@@ -0,0 +1,14 @@
+-- ============================================
+-- Calendar settings for saved views
+-- ============================================
+-- calendar_field is the date a calendar places boards on ('' for boards.due_date);
+-- calendar_start makes boards span every day from the start field to that date.
+
+ALTER TABLE saved_views ADD COLUMN IF NOT EXISTS calendar_field VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE saved_views ADD COLUMN IF NOT EXISTS calendar_start VARCHAR(64) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN saved_views.calendar_field IS 'Calendar date: date/datetime field ID, or empty for due_date';
+COMMENT ON COLUMN saved_views.calendar_start IS 'Date/datetime field ID where multi-day calendar spans start (optional)';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016220000', 'Add calendar_field and calendar_start to saved_views');
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_boards_start_date;
+ALTER TABLE boards DROP COLUMN IF EXISTS start_date;
+DELETE FROM schema_versions WHERE version = '20261016230000';
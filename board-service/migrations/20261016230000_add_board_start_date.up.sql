@@ -0,0 +1,14 @@
+-- ============================================
+-- Start dates for boards
+-- ============================================
+-- Boards with a start and a due date span a period on the timeline;
+-- boards with only a due date are milestones.
+
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS start_date TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_boards_start_date ON boards(start_date);
+
+COMMENT ON COLUMN boards.start_date IS 'When work on the board starts (optional, not after due_date)';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016230000', 'Add start_date to boards');
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS saved_view_user_settings CASCADE;
+ALTER TABLE saved_views DROP COLUMN IF EXISTS table_settings;
+ALTER TABLE saved_views DROP COLUMN IF EXISTS view_type;
+DELETE FROM schema_versions WHERE version = '20261017000000';
@@ -0,0 +1,31 @@
+-- ============================================
+-- View types and table settings
+-- ============================================
+-- A view is shown as a kanban, table, calendar or timeline. Table views keep
+-- their columns, widths, frozen columns and density; each member may save
+-- their own table settings, which override the view's for them only.
+
+ALTER TABLE saved_views ADD COLUMN IF NOT EXISTS view_type VARCHAR(16) NOT NULL DEFAULT 'kanban';
+ALTER TABLE saved_views ADD COLUMN IF NOT EXISTS table_settings TEXT NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN saved_views.view_type IS 'Layout: kanban, table, calendar or timeline';
+COMMENT ON COLUMN saved_views.table_settings IS 'JSON {columns: [{key, width}], frozen_columns, density}; columns are the visible ones in order';
+
+CREATE TABLE IF NOT EXISTS saved_view_user_settings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    view_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    table_settings TEXT NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_view_user_settings ON saved_view_user_settings(view_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_saved_view_user_settings_view_id ON saved_view_user_settings(view_id);
+
+COMMENT ON TABLE saved_view_user_settings IS 'Per-user overrides of the table settings of saved views';
+COMMENT ON COLUMN saved_view_user_settings.view_id IS 'References saved_views.id (no FK for sharding)';
+COMMENT ON COLUMN saved_view_user_settings.user_id IS 'References users.id (no FK for microservice isolation)';
+COMMENT ON COLUMN saved_view_user_settings.table_settings IS 'JSON table settings; only the parts set override the view';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261017000000', 'Add view types and table settings');
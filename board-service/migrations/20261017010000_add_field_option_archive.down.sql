@@ -0,0 +1,2 @@
+ALTER TABLE field_options DROP COLUMN IF EXISTS is_archived;
+DELETE FROM schema_versions WHERE version = '20261017010000';
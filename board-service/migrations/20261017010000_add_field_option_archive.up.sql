@@ -0,0 +1,12 @@
+-- ============================================
+-- Archived field options
+-- ============================================
+-- An archived option is hidden from pickers and kanban columns and cannot be
+-- set as a new value, but boards keep it and history still resolves it.
+
+ALTER TABLE field_options ADD COLUMN IF NOT EXISTS is_archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN field_options.is_archived IS 'Hidden from pickers and kanban columns; existing values keep it';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261017010000', 'Add field option archiving');
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_webhook_deliveries_event;
+DELETE FROM schema_versions WHERE version = '20261017020000';
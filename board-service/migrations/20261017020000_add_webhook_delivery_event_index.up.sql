@@ -0,0 +1,14 @@
+-- ============================================
+-- One delivery per webhook and event
+-- ============================================
+-- Deliveries are created by the outbox relay, which may publish an event
+-- more than once. Manual redeliveries (redelivery_of set) are not limited.
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_deliveries_event
+    ON webhook_deliveries(webhook_id, event_id)
+    WHERE redelivery_of IS NULL;
+
+COMMENT ON INDEX idx_webhook_deliveries_event IS 'Dedupes deliveries of an outbox event published again by the relay';
+
+INSERT INTO schema_versions (version, description)
+VALUES ('20261017020000', 'Add unique webhook delivery per event');
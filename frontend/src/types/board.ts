@@ -198,7 +198,7 @@ export interface CustomImportanceResponse extends BaseFieldOption {
 export interface MoveBoardRequest {
   viewId: string;
   groupByFieldId: string;
-  newFieldValue: string; // 새로운 필드 옵션 ID (예: Stage ID)
+  newFieldValue: string; // 새로운 필드 옵션 ID (예: Stage ID), 빈 문자열이면 "(none)" 열
   beforePosition?: string;
   afterPosition?: string;
 }